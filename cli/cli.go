@@ -13,6 +13,10 @@ import (
 
 func init() {
 	RootCmd.AddCommand(initiator.StartDKG)
+	RootCmd.AddCommand(initiator.StartReshare)
+	RootCmd.AddCommand(initiator.StartResign)
+	RootCmd.AddCommand(initiator.StartExit)
+	RootCmd.AddCommand(initiator.StartBatch)
 	RootCmd.AddCommand(operator.StartDKGOperator)
 	RootCmd.AddCommand(initiator.HealthCheck)
 	RootCmd.AddCommand(verify.Verify)
@@ -32,6 +36,10 @@ func Execute(appName, version string) {
 	RootCmd.Version = version
 	initiator.HealthCheck.Version = version
 	initiator.StartDKG.Version = version
+	initiator.StartReshare.Version = version
+	initiator.StartResign.Version = version
+	initiator.StartExit.Version = version
+	initiator.StartBatch.Version = version
 	operator.StartDKGOperator.Version = version
 	if err := RootCmd.Execute(); err != nil {
 		log.Fatal("failed to execute root command", zap.Error(err))
@@ -2,6 +2,7 @@ package flags
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -9,6 +10,7 @@ import (
 // Flag names.
 const (
 	withdrawAddress   = "withdrawAddress"
+	withdrawPubKey    = "withdrawPubKey"
 	operatorIDs       = "operatorIDs"
 	operatorsInfo     = "operatorsInfo"
 	operatorsInfoPath = "operatorsInfoPath"
@@ -29,6 +31,39 @@ const (
 	clientCACertPath  = "clientCACertPath"
 	serverTLSCertPath = "serverTLSCertPath"
 	serverTLSKeyPath  = "serverTLSKeyPath"
+	newOperatorIDs    = "newOperatorIDs"
+	proofsFilePath    = "proofsFilePath"
+	ethKeystorePath   = "ethKeystorePath"
+	ethKeystorePass   = "ethKeystorePass"
+	ethEndpointURL    = "ethEndpointURL"
+	validatorIndex    = "validatorIndex"
+	exitEpoch         = "exitEpoch"
+	thresholdPolicy   = "thresholdPolicy"
+	threshold         = "threshold"
+	resume            = "resume"
+	partialSuccess    = "partialSuccess"
+	policyFilePath    = "policyFilePath"
+	statusTokenPath   = "statusTokenPath"
+	metricsTokenPath  = "metricsTokenPath"
+	metricsAddress    = "metricsAddress"
+	shutdownTimeout   = "shutdownTimeout"
+	phaseTimeout      = "phaseTimeout"
+	ceremonyTimeout   = "ceremonyTimeout"
+	operatorsAPIURL   = "operatorsAPIURL"
+	operatorsSnapshot = "operatorsSnapshotPath"
+	snapshotMaxAge    = "operatorsSnapshotMaxAge"
+	keysEthEndpoint   = "operatorsKeysEthEndpointURL"
+	ssvContract       = "ssvContractAddress"
+	keysSnapshot      = "operatorsKeysSnapshotPath"
+	keysSigner        = "operatorsKeysSnapshotSigner"
+	compounding       = "compounding"
+	depositAmount     = "depositAmount"
+	networkConfigPath = "networkConfigPath"
+	manifestPath      = "manifestPath"
+	maxPerOperator    = "maxConcurrencyPerOperator"
+	phaser            = "phaser"
+	phaserTimeout     = "phaserTimeout"
+	standbyOperators  = "standbyOperatorIDs"
 )
 
 // WithdrawAddressFlag  adds withdraw address flag to the command
@@ -36,6 +71,11 @@ func WithdrawAddressFlag(c *cobra.Command) {
 	AddPersistentStringFlag(c, withdrawAddress, "", "Withdrawal address", false)
 }
 
+// WithdrawPubKeyFlag adds BLS withdrawal public key flag to the command
+func WithdrawPubKeyFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, withdrawPubKey, "", "Hex encoded BLS withdrawal public key of validators with 0x00 withdrawal credentials, instead of withdrawal address", false)
+}
+
 // operatorIDsFlag adds operators IDs flag to the command
 func OperatorIDsFlag(c *cobra.Command) {
 	AddPersistentStringSliceFlag(c, operatorIDs, []string{"1", "2", "3"}, "Operator IDs", false)
@@ -51,6 +91,41 @@ func OperatorsInfoPathFlag(c *cobra.Command) {
 	AddPersistentStringFlag(c, operatorsInfoPath, "", "Path to a file containing operators' public keys, IDs and IPs file e.g. { 1: { publicKey: XXX, id: 1, ip: 10.0.0.1:3033 }", false)
 }
 
+// OperatorsAPIURLFlag adds the ssv network API URL flag to resolve operators by IDs to the command
+func OperatorsAPIURLFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, operatorsAPIURL, "", "URL of the ssv network API to resolve operators' public keys and DKG endpoints by IDs, e.g. https://api.ssv.network/api/v4", false)
+}
+
+// OperatorsSnapshotPathFlag adds path to a snapshot of operators resolved at the API flag to the command
+func OperatorsSnapshotPathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, operatorsSnapshot, "", "Path to a snapshot file of operators resolved at the operators API, reused by later runs", false)
+}
+
+// OperatorsSnapshotMaxAgeFlag adds age of the operators snapshot refreshed from the API flag to the command
+func OperatorsSnapshotMaxAgeFlag(c *cobra.Command) {
+	AddPersistentDurationFlag(c, snapshotMaxAge, 24*time.Hour, "Age of the operators snapshot after which operators are fetched from the API again", false)
+}
+
+// OperatorsKeysEthEndpointURLFlag adds ethereum node endpoint to verify operator keys at the SSV contract flag to the command
+func OperatorsKeysEthEndpointURLFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, keysEthEndpoint, "", "Ethereum node endpoint to verify operators' public keys against the SSV network contract before a ceremony", false)
+}
+
+// SSVContractAddressFlag adds SSV network contract address flag to the command
+func SSVContractAddressFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, ssvContract, "", "SSV network contract address to verify operators' public keys, known for mainnet and holesky", false)
+}
+
+// OperatorsKeysSnapshotPathFlag adds path to a signed snapshot of operator keys flag to the command
+func OperatorsKeysSnapshotPathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, keysSnapshot, "", "Path to a signed snapshot of operators' public keys registered at the SSV network to verify operators' public keys before a ceremony", false)
+}
+
+// OperatorsKeysSnapshotSignerFlag adds signer of the operator keys snapshot flag to the command
+func OperatorsKeysSnapshotSignerFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, keysSigner, "", "Ethereum address of the trusted signer of the operators' public keys snapshot", false)
+}
+
 // OwnerAddressFlag  adds owner address flag to the command
 func OwnerAddressFlag(c *cobra.Command) {
 	AddPersistentStringFlag(c, owner, "", "Owner address", false)
@@ -63,7 +138,12 @@ func NonceFlag(c *cobra.Command) {
 
 // NetworkFlag  adds the fork version of the network flag to the command
 func NetworkFlag(c *cobra.Command) {
-	AddPersistentStringFlag(c, network, "mainnet", "Network name: mainnet, prater, holesky", false)
+	AddPersistentStringFlag(c, network, "mainnet", "Network name: mainnet, prater, holesky or a custom network of the network config file", false)
+}
+
+// NetworkConfigPathFlag adds path to a custom network config file flag to the command
+func NetworkConfigPathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, networkConfigPath, "", "Path to a JSON config file of a custom network, e.g. a devnet: name, genesis fork version, genesis validators root and deposit contract", false)
 }
 
 // OperatorPrivateKeyFlag  adds private key flag to the command
@@ -136,6 +216,131 @@ func OperatorIDFlag(c *cobra.Command) {
 	AddPersistentIntFlag(c, operatorID, 0, "Operator ID", false)
 }
 
+// NewOperatorIDsFlag adds new operators IDs flag to the command
+func NewOperatorIDsFlag(c *cobra.Command) {
+	AddPersistentStringSliceFlag(c, newOperatorIDs, []string{}, "New operator IDs for resharing", false)
+}
+
+// StandbyOperatorIDsFlag adds standby operator IDs flag to the command
+func StandbyOperatorIDsFlag(c *cobra.Command) {
+	AddPersistentStringSliceFlag(c, standbyOperators, []string{}, "Standby operator IDs in order of preference, replacing operators which dont respond to the init message", false)
+}
+
+// ProofsFilePathFlag adds path to proofs of the previous ceremony flag to the command
+func ProofsFilePathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, proofsFilePath, "", "Path to proofs.json file of the previous ceremony", false)
+}
+
+// EthKeystorePathFlag adds path to owner's ethereum keystore flag to the command
+func EthKeystorePathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, ethKeystorePath, "", "Path to owner's ethereum keystore file to sign reshare message", false)
+}
+
+// EthKeystorePassFlag adds path to owner's ethereum keystore password flag to the command
+func EthKeystorePassFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, ethKeystorePass, "", "Path to password file to decrypt owner's ethereum keystore", false)
+}
+
+// EthEndpointURLFlag adds ethereum node endpoint flag to the command
+func EthEndpointURLFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, ethEndpointURL, "", "Ethereum node endpoint to verify smart contract owner signatures (EIP-1271)", false)
+}
+
+// PolicyFilePathFlag adds path to operator policy file flag to the command
+func PolicyFilePathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, policyFilePath, "", "Path to JSON policy file restricting owners, withdrawal addresses, initiators, networks and validators per owner per day of incoming init, reshare, resign and exit requests", false)
+}
+
+// MetricsTokenPathFlag adds path to a file with the bearer token of operator metrics flag to the command
+func MetricsTokenPathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, metricsTokenPath, "", "Path to file with the bearer token authorizing requests to /metrics of the operator", false)
+}
+
+// MetricsAddressFlag adds the address of a separate metrics listener flag to the command
+func MetricsAddressFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, metricsAddress, "", "Address to serve /metrics over plain HTTP, i.e. 127.0.0.1:9090. Requests are authorized with the metrics token if set", false)
+}
+
+// StatusTokenPathFlag adds path to a file with the bearer token of operator ceremonies status API flag to the command
+func StatusTokenPathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, statusTokenPath, "", "Path to file with the bearer token authorizing requests to ceremonies status API of operators", false)
+}
+
+// ValidatorIndexFlag adds validator index at the beacon chain flag to the command
+func ValidatorIndexFlag(c *cobra.Command) {
+	AddPersistentIntFlag(c, validatorIndex, 0, "Validator index at the beacon chain", false)
+}
+
+// ExitEpochFlag adds voluntary exit epoch flag to the command
+func ExitEpochFlag(c *cobra.Command) {
+	AddPersistentIntFlag(c, exitEpoch, 0, "Earliest epoch when voluntary exit can be processed", false)
+}
+
+// ThresholdPolicyFlag adds threshold policy flag to the command
+func ThresholdPolicyFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, thresholdPolicy, "ssv", "Accepted number of operators and threshold: ssv (4, 7, 10, 13 operators) or custom (2 to 64 operators, threshold above half of operators)", false)
+}
+
+// ThresholdFlag adds DKG threshold flag to the command
+func ThresholdFlag(c *cobra.Command) {
+	AddPersistentIntFlag(c, threshold, 0, "DKG threshold at custom threshold policy, computed following 3f+1 tolerance if not set", false)
+}
+
+// CompoundingFlag adds a flag to create compounding validators with 0x02 withdrawal credentials to the command
+func CompoundingFlag(c *cobra.Command) {
+	AddPersistentBoolFlag(c, compounding, false, "Create compounding validators with 0x02 withdrawal credentials instead of 0x01", false)
+}
+
+// DepositAmountFlag adds deposit amount flag to the command
+func DepositAmountFlag(c *cobra.Command) {
+	AddPersistentIntFlag(c, depositAmount, 32000000000, "Deposit amount of each validator in Gwei: 1 to 32 ETH, or up to 2048 ETH for compounding validators", false)
+}
+
+// ResumeFlag adds a flag to resume an interrupted batch of ceremonies from the journal at output path
+func ResumeFlag(c *cobra.Command) {
+	AddPersistentBoolFlag(c, resume, false, "Resume an interrupted batch of ceremonies: run only ceremonies which aren't completed at the journal of the output path", false)
+}
+
+// PartialSuccessFlag adds a flag to write results of successful ceremonies of a batch when some of them fail
+func PartialSuccessFlag(c *cobra.Command) {
+	AddPersistentBoolFlag(c, partialSuccess, false, "Write results of successful ceremonies of a batch and list failed nonces at the summary file instead of failing the whole batch", false)
+}
+
+// ShutdownTimeoutFlag adds a deadline of active ceremonies to finish at operator shutdown flag to the command
+func ShutdownTimeoutFlag(c *cobra.Command) {
+	AddPersistentDurationFlag(c, shutdownTimeout, 5*time.Minute, "Time for active ceremonies to finish at operator shutdown, the default is the maximum lifetime of a ceremony instance", false)
+}
+
+// ManifestPathFlag adds path to a manifest of ceremonies flag to the command
+func ManifestPathFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, manifestPath, "", "Path to a .csv or .json manifest of ceremonies: operator IDs, owner, withdrawal address, nonce and number of validators of each row", true)
+}
+
+// MaxConcurrencyPerOperatorFlag adds a limit of concurrent ceremonies of each operator flag to the command
+func MaxConcurrencyPerOperatorFlag(c *cobra.Command) {
+	AddPersistentIntFlag(c, maxPerOperator, 20, "Maximum number of ceremonies each operator runs concurrently across all rows of the manifest", false)
+}
+
+// PhaseTimeoutFlag adds a deadline of each ceremony phase flag to the command
+func PhaseTimeoutFlag(c *cobra.Command) {
+	AddPersistentDurationFlag(c, phaseTimeout, 0, "Deadline of each ceremony phase including resends to operators, e.g. 30s, no deadline if not set", false)
+}
+
+// CeremonyTimeoutFlag adds a deadline of the whole ceremony flag to the command
+func CeremonyTimeoutFlag(c *cobra.Command) {
+	AddPersistentDurationFlag(c, ceremonyTimeout, 0, "Deadline of the whole ceremony, e.g. 5m, no deadline if not set", false)
+}
+
+// PhaserFlag adds the phaser of the kyber protocol flag to the command
+func PhaserFlag(c *cobra.Command) {
+	AddPersistentStringFlag(c, phaser, "event", "Phaser of the DKG protocol: event (moves to the next phase when all bundles of the phase are received) or time (fixed phase timers)", false)
+}
+
+// PhaserTimeoutFlag adds a timeout of each phase of the kyber protocol flag to the command
+func PhaserTimeoutFlag(c *cobra.Command) {
+	AddPersistentDurationFlag(c, phaserTimeout, time.Minute, "Timeout of each phase of the DKG protocol, operators with late bundles are complained about after it", false)
+}
+
 // AddPersistentStringFlag adds a string flag to the command
 func AddPersistentStringFlag(c *cobra.Command, flag, value, description string, isRequired bool) {
 	req := ""
@@ -177,3 +382,31 @@ func AddPersistentStringSliceFlag(c *cobra.Command, flag string, value []string,
 		_ = c.MarkPersistentFlagRequired(flag)
 	}
 }
+
+// AddPersistentBoolFlag adds a bool flag to the command
+func AddPersistentBoolFlag(c *cobra.Command, flag string, value bool, description string, isRequired bool) {
+	req := ""
+	if isRequired {
+		req = " (required)"
+	}
+
+	c.PersistentFlags().Bool(flag, value, fmt.Sprintf("%s%s", description, req))
+
+	if isRequired {
+		_ = c.MarkPersistentFlagRequired(flag)
+	}
+}
+
+// AddPersistentDurationFlag adds a duration flag to the command
+func AddPersistentDurationFlag(c *cobra.Command, flag string, value time.Duration, description string, isRequired bool) {
+	req := ""
+	if isRequired {
+		req = " (required)"
+	}
+
+	c.PersistentFlags().Duration(flag, value, fmt.Sprintf("%s%s", description, req))
+
+	if isRequired {
+		_ = c.MarkPersistentFlagRequired(flag)
+	}
+}
@@ -3,18 +3,23 @@ package initiator
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"syscall"
 
-	"github.com/sourcegraph/conc/pool"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
 	e2m_core "github.com/bloxapp/eth2-key-manager/core"
 	cli_utils "github.com/bloxapp/ssv-dkg/cli/utils"
-	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
 	"github.com/bloxapp/ssv-dkg/pkgs/initiator"
-	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/pkgs/sdk"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
 )
 
 const (
@@ -53,90 +58,105 @@ var StartDKG = &cobra.Command{
 			}
 		}()
 		logger.Info("🪛 Initiator`s", zap.String("Version", cmd.Version))
-		// Load operators TODO: add more sources.
+		// Load operators from raw JSON, a file or the operators API
 		operatorIDs, err := cli_utils.StingSliceToUintArray(cli_utils.OperatorIDs)
 		if err != nil {
 			logger.Fatal("😥 Failed to load participants: ", zap.Error(err))
 		}
-		opMap, err := cli_utils.LoadOperators(logger)
+		// Ctrl+C aborts in-flight requests to the operators API and operators
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+		defer stop()
+		opMap, err := cli_utils.LoadOperators(ctx, logger, append(slices.Clone(operatorIDs), cli_utils.StandbyOperators...))
 		if err != nil {
 			logger.Fatal("😥 Failed to load operators: ", zap.Error(err))
 		}
 		logger.Info("🔑 opening initiator RSA private key file")
 		ethnetwork := e2m_core.MainNetwork
 		if cli_utils.Network != "now_test_network" {
-			ethnetwork = e2m_core.NetworkFromString(cli_utils.Network)
-		}
-		// start the ceremony
-		ctx := context.Background()
-		pool := pool.NewWithResults[*Result]().WithContext(ctx).WithFirstError().WithMaxGoroutines(maxConcurrency)
-		for i := 0; i < int(cli_utils.Validators); i++ {
-			i := i
-			pool.Go(func(ctx context.Context) (*Result, error) {
-				// Create new DKG initiator
-				dkgInitiator, err := initiator.New(opMap.Clone(), logger, cmd.Version, cli_utils.ClientCACertPath)
-				if err != nil {
-					return nil, err
-				}
-				// Create a new ID.
-				id := crypto.NewID()
-				nonce := cli_utils.Nonce + uint64(i)
-				// Perform the ceremony.
-				depositData, keyShares, proofs, err := dkgInitiator.StartDKG(id, cli_utils.WithdrawAddress.Bytes(), operatorIDs, ethnetwork, cli_utils.OwnerAddress, nonce)
-				if err != nil {
-					return nil, err
-				}
-				logger.Debug("DKG ceremony completed",
-					zap.String("id", hex.EncodeToString(id[:])),
-					zap.Uint64("nonce", nonce),
-					zap.String("pubkey", depositData.PubKey),
-				)
-				return &Result{
-					id:          id,
-					depositData: depositData,
-					keyShares:   keyShares,
-					nonce:       nonce,
-					proof:       proofs,
-				}, nil
-			})
-		}
-		results, err := pool.Wait()
+			ethnetwork, err = utils.GetNetworkByName(cli_utils.Network)
+			if err != nil {
+				logger.Fatal("😥 Cant recognize eth network: ", zap.Error(err))
+			}
+		}
+		// Open the journal of the batch. Completed ceremonies are recorded there, so that an interrupted batch can be resumed
+		journalParams := initiator.JournalParams{
+			OperatorIDs:      operatorIDs,
+			Owner:            cli_utils.OwnerAddress,
+			WithdrawAddress:  cli_utils.WithdrawAddress,
+			WithdrawPubKey:   cli_utils.WithdrawPubKey,
+			Network:          string(ethnetwork),
+			Nonce:            cli_utils.Nonce,
+			Validators:       uint64(cli_utils.Validators),
+			WithdrawalPrefix: cli_utils.WithdrawalPrefix,
+			DepositAmount:    uint64(cli_utils.DepositAmount),
+			ThresholdPolicy:  cli_utils.ThresholdPolicy,
+			Threshold:        cli_utils.Threshold,
+		}
+		var journal *initiator.Journal
+		if cli_utils.Resume {
+			logger.Info("📖 resuming ceremonies from the journal", zap.String("path", filepath.Join(cli_utils.OutputPath, initiator.JournalFile)))
+			journal, err = initiator.OpenJournal(cli_utils.OutputPath, journalParams)
+		} else {
+			journal, err = initiator.NewJournal(cli_utils.OutputPath, journalParams, false)
+		}
+		if err != nil {
+			logger.Fatal("😥 Failed to open ceremony journal: ", zap.Error(err))
+		}
+		keysRegistry, err := cli_utils.LoadOperatorKeysRegistry(logger)
+		if err != nil {
+			logger.Fatal("😥 Failed to open SSV network registry to verify operator keys: ", zap.Error(err))
+		}
+		opts := sdk.Options{
+			OperatorIDs:      operatorIDs,
+			Owner:            cli_utils.OwnerAddress,
+			WithdrawAddress:  cli_utils.WithdrawAddress,
+			WithdrawPubKey:   cli_utils.WithdrawPubKey,
+			Network:          ethnetwork,
+			Nonce:            cli_utils.Nonce,
+			Validators:       int(cli_utils.Validators),
+			ThresholdPolicy:  cli_utils.ThresholdPolicy,
+			Threshold:        cli_utils.Threshold,
+			WithdrawalPrefix: cli_utils.WithdrawalPrefix,
+			DepositAmount:    cli_utils.DepositAmount,
+			PartialSuccess:   cli_utils.PartialSuccess,
+			StandbyOperators: cli_utils.StandbyOperators,
+			MaxConcurrency:   maxConcurrency,
+			PhaseTimeout:     cli_utils.PhaseTimeout,
+			CeremonyTimeout:  cli_utils.CeremonyTimeout,
+			CACertPaths:      cli_utils.ClientCACertPath,
+			Version:          cmd.Version,
+			Journal:          journal,
+			KeysRegistry:     keysRegistry,
+			Logger:           logger,
+		}
+		batch, err := sdk.Run(ctx, sdk.StaticRegistry(opMap), opts, &sdk.DirSink{Dir: cli_utils.OutputPath, Logger: logger})
+		if batch == nil && err != nil {
+			cli_utils.WriteBlameReports(logger, cli_utils.OutputPath, err)
+			logger.Fatal("😥 Failed to initiate DKG ceremony, completed ceremonies are saved at the journal, run again with --resume to finish the batch: ", zap.Error(err))
+		}
+		for _, sub := range batch.Substitutions() {
+			logger.Info("🔄 offline operator was replaced by a standby operator", zap.Uint64("nonce", sub.Nonce), zap.String("id", sub.RequestID), zap.Uint64("offline", sub.Offline), zap.Uint64("standby", sub.Standby), zap.Uint64s("operators", sub.OperatorIDs))
+		}
+		for _, res := range batch.Failed() {
+			logger.Error("😥 DKG ceremony failed", zap.Uint64("nonce", res.Nonce), zap.String("id", hex.EncodeToString(res.RequestID[:])), zap.Error(res.Err))
+			cli_utils.WriteBlameReports(logger, cli_utils.OutputPath, res.Err)
+		}
+		if errors.Is(err, sdk.ErrAllFailed) {
+			logger.Fatal("😥 All DKG ceremonies failed")
+		}
 		if err != nil {
-			logger.Fatal("😥 Failed to initiate DKG ceremony: ", zap.Error(err))
-		}
-		var depositDataArr []*wire.DepositDataCLI
-		var keySharesArr []*wire.KeySharesCLI
-		var proofs [][]*wire.SignedProof
-		for _, res := range results {
-			depositDataArr = append(depositDataArr, res.depositData)
-			keySharesArr = append(keySharesArr, res.keyShares)
-			proofs = append(proofs, res.proof)
-		}
-		// Save results
-		logger.Info("🎯 All data is validated.")
-		if err := cli_utils.WriteResults(
-			logger,
-			depositDataArr,
-			keySharesArr,
-			proofs,
-			false,
-			int(cli_utils.Validators),
-			cli_utils.OwnerAddress,
-			cli_utils.Nonce,
-			cli_utils.WithdrawAddress,
-			cli_utils.OutputPath,
-		); err != nil {
 			logger.Fatal("Could not save results", zap.Error(err))
 		}
+		if failed := batch.Failed(); len(failed) > 0 {
+			// the journal is kept, so that failed nonces can be run again with --resume
+			logger.Warn("⚠️ Some of DKG ceremonies failed, results are written only for successful nonces before the first failed nonce. Validators have to be registered in order of nonces: run again with --resume to create validators for failed and pending nonces",
+				zap.Int("failed", len(failed)),
+				zap.Int("written", len(batch.Contiguous())),
+				zap.Int("pending", len(batch.Pending())),
+			)
+			return nil
+		}
 		logger.Info("🚀 DKG ceremony completed")
 		return nil
 	},
 }
-
-type Result struct {
-	id          [24]byte
-	nonce       uint64
-	depositData *wire.DepositDataCLI
-	keyShares   *wire.KeySharesCLI
-	proof       []*wire.SignedProof
-}
@@ -0,0 +1,151 @@
+package initiator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+
+	"github.com/aquasecurity/table"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	cli_utils "github.com/bloxapp/ssv-dkg/cli/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/sdk"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+)
+
+func init() {
+	cli_utils.SetBatchFlags(StartBatch)
+}
+
+var StartBatch = &cobra.Command{
+	Use:   "batch",
+	Short: "Initiates DKG ceremonies of a manifest",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cli_utils.SetViperConfig(cmd); err != nil {
+			return err
+		}
+		if err := cli_utils.BindBatchFlags(cmd); err != nil {
+			return err
+		}
+		logger, err := cli_utils.SetGlobalLogger(cmd, "dkg-initiator")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := cli_utils.Sync(logger); err != nil {
+				log.Printf("Failed to sync logger: %v", err)
+			}
+		}()
+		logger.Info("🪛 Initiator`s", zap.String("Version", cmd.Version))
+		entries, err := sdk.LoadManifest(cli_utils.ManifestPath)
+		if err != nil {
+			logger.Fatal("😥 Failed to load manifest: ", zap.Error(err))
+		}
+		ethnetwork, err := utils.GetNetworkByName(cli_utils.Network)
+		if err != nil {
+			logger.Fatal("😥 Cant recognize eth network: ", zap.Error(err))
+		}
+		// Operators of all rows are resolved at once
+		var operatorIDs []uint64
+		for _, entry := range entries {
+			for _, id := range entry.OperatorIDs {
+				if !slices.Contains(operatorIDs, id) {
+					operatorIDs = append(operatorIDs, id)
+				}
+			}
+		}
+		// Ctrl+C aborts in-flight requests to the operators API and operators
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+		defer stop()
+		opMap, err := cli_utils.LoadOperators(ctx, logger, operatorIDs)
+		if err != nil {
+			logger.Fatal("😥 Failed to load operators: ", zap.Error(err))
+		}
+		keysRegistry, err := cli_utils.LoadOperatorKeysRegistry(logger)
+		if err != nil {
+			logger.Fatal("😥 Failed to open SSV network registry to verify operator keys: ", zap.Error(err))
+		}
+		opts := sdk.Options{
+			Network:          ethnetwork,
+			ThresholdPolicy:  cli_utils.ThresholdPolicy,
+			WithdrawalPrefix: cli_utils.WithdrawalPrefix,
+			DepositAmount:    cli_utils.DepositAmount,
+			PartialSuccess:   cli_utils.PartialSuccess,
+			MaxConcurrency:   maxConcurrency,
+			OperatorLimiter:  sdk.NewOperatorLimiter(cli_utils.MaxConcurrencyPerOperator),
+			PhaseTimeout:     cli_utils.PhaseTimeout,
+			CeremonyTimeout:  cli_utils.CeremonyTimeout,
+			CACertPaths:      cli_utils.ClientCACertPath,
+			Version:          cmd.Version,
+			KeysRegistry:     keysRegistry,
+			Logger:           logger,
+		}
+		// Each row writes its ceremony directory to a directory of its own
+		dirs := make(map[int]string, len(entries))
+		sinks := func(row int, entry sdk.ManifestEntry) ([]sdk.Sink, error) {
+			dir := filepath.Join(cli_utils.OutputPath, fmt.Sprintf("row-%03d", row))
+			if err := os.MkdirAll(dir, 0o750); err != nil {
+				return nil, fmt.Errorf("failed to create output directory of the row: %w", err)
+			}
+			dirs[row] = dir
+			return []sdk.Sink{&sdk.DirSink{Dir: dir, Logger: logger.With(zap.Int("row", row))}}, nil
+		}
+		logger.Info("🚀 running DKG ceremonies of the manifest", zap.String("path", cli_utils.ManifestPath), zap.Int("rows", len(entries)))
+		results, err := sdk.RunManifest(ctx, sdk.StaticRegistry(opMap), entries, opts, sinks)
+		if err != nil {
+			logger.Fatal("😥 Failed to run the manifest: ", zap.Error(err))
+		}
+		// Blame reports of ceremonies failed because of faulty operators are written to directories of their rows
+		for i, res := range results {
+			dir, ok := dirs[i+1]
+			if !ok {
+				dir = cli_utils.OutputPath
+			}
+			cli_utils.WriteBlameReports(logger, dir, res.Err)
+			if res.Batch != nil {
+				for _, failed := range res.Batch.Failed() {
+					cli_utils.WriteBlameReports(logger, dir, failed.Err)
+				}
+			}
+		}
+		report := sdk.NewManifestReport(results, dirs)
+		reportPath := filepath.Join(cli_utils.OutputPath, sdk.ReportFile)
+		if err := utils.WriteJSON(reportPath, report); err != nil {
+			logger.Fatal("😥 Failed to write the manifest report: ", zap.Error(err))
+		}
+		tbl := table.New(os.Stdout)
+		tbl.SetHeaders("Row", "Owner Address", "Operator IDs", "Nonces", "Failed", "Error")
+		for _, entry := range report.Entries {
+			failed := make([]uint64, 0, len(entry.Failed))
+			for _, f := range entry.Failed {
+				failed = append(failed, f.Nonce)
+			}
+			tbl.AddRow(
+				fmt.Sprintf("%d", entry.Row),
+				entry.Owner.String(),
+				strings.Trim(fmt.Sprint(entry.OperatorIDs), "[]"),
+				strings.Trim(fmt.Sprint(entry.Nonces), "[]"),
+				strings.Trim(fmt.Sprint(failed), "[]"),
+				entry.Error,
+			)
+		}
+		tbl.Render()
+		if report.Failed > 0 {
+			logger.Warn("⚠️ Some of DKG ceremonies of the manifest failed, see the report for nonces used by each row",
+				zap.String("report", reportPath),
+				zap.Int("failed", report.Failed),
+				zap.Int("succeeded", report.Succeeded),
+			)
+			return nil
+		}
+		logger.Info("🚀 DKG ceremonies of the manifest completed", zap.String("report", reportPath), zap.Int("succeeded", report.Succeeded))
+		return nil
+	},
+}
@@ -43,6 +43,19 @@ var HealthCheck = &cobra.Command{
 		if err != nil {
 			logger.Fatal("😥 Error: ", zap.Error(err))
 		}
+		statusTokenPath, err := cmd.Flags().GetString("statusTokenPath")
+		if err != nil {
+			logger.Fatal("😥", zap.Error(err))
+		}
+		if statusTokenPath != "" {
+			token, err := cli_utils.ReadTokenFile(statusTokenPath)
+			if err != nil {
+				logger.Fatal("😥 Failed to load status token: ", zap.Error(err))
+			}
+			if err := dkgInitiator.CeremoniesStatus(ips, token); err != nil {
+				logger.Fatal("😥 Error: ", zap.Error(err))
+			}
+		}
 		return nil
 	},
 }
@@ -0,0 +1,134 @@
+package initiator
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	cli_utils "github.com/bloxapp/ssv-dkg/cli/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/initiator"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+func init() {
+	cli_utils.SetReshareFlags(StartReshare)
+}
+
+var StartReshare = &cobra.Command{
+	Use:   "reshare",
+	Short: "Reshares an existing validator key to a new set of operators",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Println(`
+		█████╗ ██╗  ██╗ ██████╗     ██████╗ ███████╗███████╗██╗  ██╗ █████╗ ██████╗ ███████╗
+		██╔══██╗██║ ██╔╝██╔════╝     ██╔══██╗██╔════╝██╔════╝██║  ██║██╔══██╗██╔══██╗██╔════╝
+		██║  ██║█████╔╝ ██║  ███╗    ██████╔╝█████╗  ███████╗███████║███████║██████╔╝█████╗
+		██║  ██║██╔═██╗ ██║   ██║    ██╔══██╗██╔══╝  ╚════██║██╔══██║██╔══██║██╔══██╗██╔══╝
+		██████╔╝██║  ██╗╚██████╔╝    ██║  ██║███████╗███████║██║  ██║██║  ██║██║  ██║███████╗
+		╚═════╝ ╚═╝  ╚═╝ ╚═════╝     ╚═╝  ╚═╝╚══════╝╚══════╝╚═╝  ╚═╝╚═╝  ╚═╝╚═╝  ╚═╝╚══════╝`)
+		if err := cli_utils.SetViperConfig(cmd); err != nil {
+			return err
+		}
+		if err := cli_utils.BindReshareFlags(cmd); err != nil {
+			return err
+		}
+		logger, err := cli_utils.SetGlobalLogger(cmd, "dkg-initiator")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := cli_utils.Sync(logger); err != nil {
+				log.Printf("Failed to sync logger: %v", err)
+			}
+		}()
+		logger.Info("🪛 Initiator`s", zap.String("Version", cmd.Version))
+		oldOperatorIDs, err := cli_utils.StingSliceToUintArray(cli_utils.OperatorIDs)
+		if err != nil {
+			logger.Fatal("😥 Failed to load old participants: ", zap.Error(err))
+		}
+		newOperatorIDs, err := cli_utils.StingSliceToUintArray(cli_utils.NewOperatorIDs)
+		if err != nil {
+			logger.Fatal("😥 Failed to load new participants: ", zap.Error(err))
+		}
+		// Ctrl+C aborts in-flight requests to the operators API and operators
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+		defer stop()
+		opMap, err := cli_utils.LoadOperators(ctx, logger, append(oldOperatorIDs, newOperatorIDs...))
+		if err != nil {
+			logger.Fatal("😥 Failed to load operators: ", zap.Error(err))
+		}
+		proofs, err := cli_utils.LoadProofs(cli_utils.ProofsFilePath)
+		if err != nil {
+			logger.Fatal("😥 Failed to load proofs of the previous ceremony: ", zap.Error(err))
+		}
+		if len(proofs) == 0 {
+			logger.Fatal("😥 Proofs file of the previous ceremony is empty")
+		}
+		logger.Info("🔑 opening owner ethereum keystore file")
+		ownerKey, err := cli_utils.OpenEthKeystore(cli_utils.EthKeystorePass, cli_utils.EthKeystorePath)
+		if err != nil {
+			logger.Fatal("😥 Failed to load owner ethereum key: ", zap.Error(err))
+		}
+		if eth_crypto.PubkeyToAddress(ownerKey.PublicKey) != cli_utils.OwnerAddress {
+			logger.Fatal("😥 Ethereum keystore doesnt belong to the owner", zap.String("owner", cli_utils.OwnerAddress.Hex()))
+		}
+		ethnetwork, err := utils.GetNetworkByName(cli_utils.Network)
+		if err != nil {
+			logger.Fatal("😥 Cant recognize eth network: ", zap.Error(err))
+		}
+		dkgInitiator, err := initiator.New(opMap.Clone(), logger, cmd.Version, cli_utils.ClientCACertPath)
+		if err != nil {
+			logger.Fatal("😥 Failed to create initiator: ", zap.Error(err))
+		}
+		dkgInitiator.ThresholdPolicy = cli_utils.ThresholdPolicy
+		dkgInitiator.WithdrawalPrefix = cli_utils.WithdrawalPrefix
+		dkgInitiator.DepositAmount = cli_utils.DepositAmount
+		dkgInitiator.Threshold = cli_utils.Threshold
+		dkgInitiator.PhaseTimeout = cli_utils.PhaseTimeout
+		dkgInitiator.CeremonyTimeout = cli_utils.CeremonyTimeout
+		dkgInitiator.KeysRegistry, err = cli_utils.LoadOperatorKeysRegistry(logger)
+		if err != nil {
+			logger.Fatal("😥 Failed to open SSV network registry to verify operator keys: ", zap.Error(err))
+		}
+		reshare, err := dkgInitiator.ConstructReshareMessage(oldOperatorIDs, newOperatorIDs, proofs[0].Proof.ValidatorPubKey, proofs, cli_utils.OwnerAddress, cli_utils.Nonce)
+		if err != nil {
+			logger.Fatal("😥 Failed to construct reshare message: ", zap.Error(err))
+		}
+		// Sign reshare message by the owner
+		hash, err := reshare.SigningRoot()
+		if err != nil {
+			logger.Fatal("😥 Failed to hash reshare message: ", zap.Error(err))
+		}
+		ownerSig, err := eth_crypto.Sign(hash[:], ownerKey)
+		if err != nil {
+			logger.Fatal("😥 Failed to sign reshare message: ", zap.Error(err))
+		}
+		id := crypto.NewID()
+		keyShares, newProofs, err := dkgInitiator.StartResharingWithContext(ctx, id, &wire.SignedReshare{Reshare: *reshare, Signature: ownerSig}, proofs, cli_utils.WithdrawAddress.Bytes(), ethnetwork)
+		if err != nil {
+			cli_utils.WriteBlameReports(logger, cli_utils.OutputPath, err)
+			logger.Fatal("😥 Failed to reshare validator key: ", zap.Error(err))
+		}
+		logger.Debug("Resharing ceremony completed",
+			zap.String("id", hex.EncodeToString(id[:])),
+			zap.Uint64("nonce", cli_utils.Nonce),
+			zap.String("pubkey", keyShares.Shares[0].PublicKey),
+		)
+		// Save results
+		logger.Info("🎯 All data is validated.")
+		if err := cli_utils.WriteReshareResults(logger, keyShares, newProofs, cli_utils.OutputPath); err != nil {
+			logger.Fatal("Could not save results", zap.Error(err))
+		}
+		logger.Info("🚀 Resharing ceremony completed")
+		return nil
+	},
+}
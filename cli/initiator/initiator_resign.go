@@ -0,0 +1,132 @@
+package initiator
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	cli_utils "github.com/bloxapp/ssv-dkg/cli/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/initiator"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+func init() {
+	cli_utils.SetResignFlags(StartResign)
+}
+
+var StartResign = &cobra.Command{
+	Use:   "resign",
+	Short: "Signs existing key shares for a new owner and nonce",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Println(`
+		█████╗ ██╗  ██╗ ██████╗     ██████╗ ███████╗███████╗██╗ ██████╗ ███╗   ██╗
+		██╔══██╗██║ ██╔╝██╔════╝     ██╔══██╗██╔════╝██╔════╝██║██╔════╝ ████╗  ██║
+		██║  ██║█████╔╝ ██║  ███╗    ██████╔╝█████╗  ███████╗██║██║  ███╗██╔██╗ ██║
+		██║  ██║██╔═██╗ ██║   ██║    ██╔══██╗██╔══╝  ╚════██║██║██║   ██║██║╚██╗██║
+		██████╔╝██║  ██╗╚██████╔╝    ██║  ██║███████╗███████║██║╚██████╔╝██║ ╚████║
+		╚═════╝ ╚═╝  ╚═╝ ╚═════╝     ╚═╝  ╚═╝╚══════╝╚══════╝╚═╝ ╚═════╝ ╚═╝  ╚═══╝`)
+		if err := cli_utils.SetViperConfig(cmd); err != nil {
+			return err
+		}
+		if err := cli_utils.BindResignFlags(cmd); err != nil {
+			return err
+		}
+		logger, err := cli_utils.SetGlobalLogger(cmd, "dkg-initiator")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := cli_utils.Sync(logger); err != nil {
+				log.Printf("Failed to sync logger: %v", err)
+			}
+		}()
+		logger.Info("🪛 Initiator`s", zap.String("Version", cmd.Version))
+		operatorIDs, err := cli_utils.StingSliceToUintArray(cli_utils.OperatorIDs)
+		if err != nil {
+			logger.Fatal("😥 Failed to load participants: ", zap.Error(err))
+		}
+		// Ctrl+C aborts in-flight requests to the operators API and operators
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+		defer stop()
+		opMap, err := cli_utils.LoadOperators(ctx, logger, operatorIDs)
+		if err != nil {
+			logger.Fatal("😥 Failed to load operators: ", zap.Error(err))
+		}
+		proofs, err := cli_utils.LoadProofs(cli_utils.ProofsFilePath)
+		if err != nil {
+			logger.Fatal("😥 Failed to load proofs of the previous ceremony: ", zap.Error(err))
+		}
+		if len(proofs) == 0 {
+			logger.Fatal("😥 Proofs file of the previous ceremony is empty")
+		}
+		logger.Info("🔑 opening owner ethereum keystore file")
+		ownerKey, err := cli_utils.OpenEthKeystore(cli_utils.EthKeystorePass, cli_utils.EthKeystorePath)
+		if err != nil {
+			logger.Fatal("😥 Failed to load owner ethereum key: ", zap.Error(err))
+		}
+		// Resign request has to be signed by the current owner of the validator
+		currentOwner := proofs[0].Proof.Owner
+		if eth_crypto.PubkeyToAddress(ownerKey.PublicKey) != currentOwner {
+			logger.Fatal("😥 Ethereum keystore doesnt belong to the current owner", zap.String("owner", hex.EncodeToString(currentOwner[:])))
+		}
+		ethnetwork, err := utils.GetNetworkByName(cli_utils.Network)
+		if err != nil {
+			logger.Fatal("😥 Cant recognize eth network: ", zap.Error(err))
+		}
+		dkgInitiator, err := initiator.New(opMap.Clone(), logger, cmd.Version, cli_utils.ClientCACertPath)
+		if err != nil {
+			logger.Fatal("😥 Failed to create initiator: ", zap.Error(err))
+		}
+		dkgInitiator.ThresholdPolicy = cli_utils.ThresholdPolicy
+		dkgInitiator.WithdrawalPrefix = cli_utils.WithdrawalPrefix
+		dkgInitiator.DepositAmount = cli_utils.DepositAmount
+		dkgInitiator.PhaseTimeout = cli_utils.PhaseTimeout
+		dkgInitiator.CeremonyTimeout = cli_utils.CeremonyTimeout
+		dkgInitiator.KeysRegistry, err = cli_utils.LoadOperatorKeysRegistry(logger)
+		if err != nil {
+			logger.Fatal("😥 Failed to open SSV network registry to verify operator keys: ", zap.Error(err))
+		}
+		resign, err := dkgInitiator.ConstructResignMessage(operatorIDs, proofs[0].Proof.ValidatorPubKey, cli_utils.OwnerAddress, cli_utils.Nonce)
+		if err != nil {
+			logger.Fatal("😥 Failed to construct resign message: ", zap.Error(err))
+		}
+		// Sign resign message by the current owner
+		hash, err := resign.SigningRoot()
+		if err != nil {
+			logger.Fatal("😥 Failed to hash resign message: ", zap.Error(err))
+		}
+		ownerSig, err := eth_crypto.Sign(hash[:], ownerKey)
+		if err != nil {
+			logger.Fatal("😥 Failed to sign resign message: ", zap.Error(err))
+		}
+		id := crypto.NewID()
+		keyShares, newProofs, err := dkgInitiator.StartResigningWithContext(ctx, id, &wire.SignedResign{Resign: *resign, Signature: ownerSig}, proofs, cli_utils.WithdrawAddress.Bytes(), ethnetwork)
+		if err != nil {
+			cli_utils.WriteBlameReports(logger, cli_utils.OutputPath, err)
+			logger.Fatal("😥 Failed to resign key shares: ", zap.Error(err))
+		}
+		logger.Debug("Resign completed",
+			zap.String("id", hex.EncodeToString(id[:])),
+			zap.String("owner", cli_utils.OwnerAddress.Hex()),
+			zap.Uint64("nonce", cli_utils.Nonce),
+			zap.String("pubkey", keyShares.Shares[0].PublicKey),
+		)
+		// Save results
+		logger.Info("🎯 All data is validated.")
+		if err := cli_utils.WriteResignResults(logger, keyShares, newProofs, cli_utils.OutputPath); err != nil {
+			logger.Fatal("Could not save results", zap.Error(err))
+		}
+		logger.Info("🚀 Resign completed")
+		return nil
+	},
+}
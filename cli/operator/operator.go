@@ -1,9 +1,14 @@
 package operator
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
@@ -51,10 +56,72 @@ var StartDKGOperator = &cobra.Command{
 		if err != nil {
 			logger.Fatal("😥 Failed to create new operator instance: ", zap.Error(err))
 		}
-		logger.Info("🚀 Starting DKG operator", zap.Uint64("at port", cli_utils.Port))
-		if err := srv.Start(uint16(cli_utils.Port), cli_utils.ServerTLSCertPath, cli_utils.ServerTLSKeyPath); err != nil {
+		srv.State.ThresholdPolicy = cli_utils.ThresholdPolicy
+		srv.State.Phaser = cli_utils.Phaser
+		if cli_utils.EthEndpointURL != "" {
+			logger.Info("🔗 connecting to ethereum node to verify owner signatures", zap.String("endpoint", cli_utils.EthEndpointURL))
+			ethClient, err := ethclient.Dial(cli_utils.EthEndpointURL)
+			if err != nil {
+				logger.Fatal("😥 Failed to connect to ethereum node: ", zap.Error(err))
+			}
+			srv.State.EthClient = ethClient
+		}
+		if cli_utils.PolicyFilePath != "" {
+			logger.Info("📜 loading init requests policy", zap.String("path", cli_utils.PolicyFilePath))
+			policy, err := operator.LoadPolicy(cli_utils.PolicyFilePath)
+			if err != nil {
+				logger.Fatal("😥 Failed to load policy: ", zap.Error(err))
+			}
+			srv.State.Policy = policy
+		}
+		if cli_utils.StatusTokenPath != "" {
+			logger.Info("📋 enabling ceremonies status API")
+			srv.StatusToken, err = cli_utils.ReadTokenFile(cli_utils.StatusTokenPath)
+			if err != nil {
+				logger.Fatal("😥 Failed to load status token: ", zap.Error(err))
+			}
+		}
+		if cli_utils.MetricsTokenPath != "" {
+			logger.Info("📈 enabling metrics")
+			srv.MetricsToken, err = cli_utils.ReadTokenFile(cli_utils.MetricsTokenPath)
+			if err != nil {
+				logger.Fatal("😥 Failed to load metrics token: ", zap.Error(err))
+			}
+		}
+		if cli_utils.MetricsAddress != "" {
+			go func() {
+				if err := srv.StartMetrics(cli_utils.MetricsAddress); err != nil {
+					logger.Error("😥 Failed to serve metrics: ", zap.Error(err))
+				}
+			}()
+		}
+		logger.Info("🚀 Starting DKG operator", zap.Uint64("at port", cli_utils.Port), zap.String("phaser", string(cli_utils.Phaser.Type)), zap.Duration("phaser timeout", cli_utils.Phaser.Timeout))
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+		defer stop()
+		errC := make(chan error, 1)
+		go func() {
+			errC <- srv.Start(uint16(cli_utils.Port), cli_utils.ServerTLSCertPath, cli_utils.ServerTLSKeyPath)
+		}()
+		select {
+		case err := <-errC:
+			if err != nil {
+				log.Fatalf("Error in operator %v", err)
+			}
+			return nil
+		case <-ctx.Done():
+		}
+		// a second signal terminates the operator immediately
+		stop()
+		logger.Info("🛑 Shutting down DKG operator: new ceremonies are rejected, waiting for active instances", zap.Duration("timeout", cli_utils.ShutdownTimeout))
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cli_utils.ShutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			logger.Error("😥 Failed to shut down http server: ", zap.Error(err))
+		}
+		if err := <-errC; err != nil {
 			log.Fatalf("Error in operator %v", err)
 		}
+		logger.Info("👋 DKG operator stopped")
 		return nil
 	},
 }
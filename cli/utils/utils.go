@@ -1,22 +1,27 @@
 package utils
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -24,33 +29,67 @@ import (
 	"github.com/bloxapp/ssv-dkg/cli/flags"
 	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
 	"github.com/bloxapp/ssv-dkg/pkgs/initiator"
+	"github.com/bloxapp/ssv-dkg/pkgs/sdk"
 	"github.com/bloxapp/ssv-dkg/pkgs/utils"
 	"github.com/bloxapp/ssv-dkg/pkgs/validator"
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
 	"github.com/bloxapp/ssv/logging"
 )
 
 // global base flags
 var (
-	ConfigPath     string
-	OutputPath     string
-	LogLevel       string
-	LogFormat      string
-	LogLevelFormat string
-	LogFilePath    string
+	ConfigPath        string
+	OutputPath        string
+	LogLevel          string
+	LogFormat         string
+	LogLevelFormat    string
+	LogFilePath       string
+	NetworkConfigPath string
 )
 
 // init flags
 var (
 	OperatorsInfo     string
 	OperatorsInfoPath string
+	OperatorsAPIURL   string
+	SnapshotPath      string
+	SnapshotMaxAge    time.Duration
+	KeysEthEndpoint   string
+	SSVContract       common.Address
+	KeysSnapshotPath  string
+	KeysSigner        common.Address
 	OperatorIDs       []string
+	StandbyOperators  []uint64
 	WithdrawAddress   common.Address
+	WithdrawPubKey    []byte
 	Network           string
 	OwnerAddress      common.Address
 	Nonce             uint64
 	Validators        uint
 	ClientCACertPath  []string
+	ThresholdPolicy   spec.ThresholdPolicy
+	Threshold         uint64
+	WithdrawalPrefix  byte
+	DepositAmount     phase0.Gwei
+	Resume            bool
+	PartialSuccess    bool
+	PhaseTimeout      time.Duration
+	CeremonyTimeout   time.Duration
+)
+
+// reshare flags
+var (
+	NewOperatorIDs  []string
+	ProofsFilePath  string
+	EthKeystorePath string
+	EthKeystorePass string
+)
+
+// exit flags
+var (
+	ValidatorIndex uint64
+	ExitEpoch      uint64
 )
 
 // operator flags
@@ -61,11 +100,25 @@ var (
 	OperatorID        uint64
 	ServerTLSCertPath string
 	ServerTLSKeyPath  string
+	EthEndpointURL    string
+	PolicyFilePath    string
+	StatusTokenPath   string
+	MetricsTokenPath  string
+	MetricsAddress    string
+	ShutdownTimeout   time.Duration
+	Phaser            wire.PhaserConfig
+)
+
+// batch flags
+var (
+	ManifestPath              string
+	MaxConcurrencyPerOperator int
 )
 
 // verify flags
 var (
 	CeremonyDir string
+	Nonces      []uint64
 )
 
 // SetViperConfig reads a yaml config file if provided
@@ -130,6 +183,18 @@ func OpenPrivateKey(passwordFilePath, privKeyPath string) (*rsa.PrivateKey, erro
 	return privateKey, nil
 }
 
+// ReadTokenFile reads a bearer token from file
+func ReadTokenFile(path string) (string, error) {
+	token, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("😥 Error reading token file: %s", err)
+	}
+	if len(strings.TrimSpace(string(token))) == 0 {
+		return "", fmt.Errorf("😥 Token file is empty")
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
 // ReadOperatorsInfoFile reads operators data from path
 func ReadOperatorsInfoFile(operatorsInfoPath string, logger *zap.Logger) (wire.OperatorsCLI, error) {
 	fmt.Printf("📖 looking operators info 'operators_info.json' file: %s \n", operatorsInfoPath)
@@ -157,20 +222,117 @@ func SetBaseFlags(cmd *cobra.Command) {
 	flags.LogFormatFlag(cmd)
 	flags.LogLevelFormatFlag(cmd)
 	flags.LogFilePathFlag(cmd)
-
+	flags.NetworkConfigPathFlag(cmd)
 }
 
 func SetInitFlags(cmd *cobra.Command) {
 	SetBaseFlags(cmd)
 	flags.OperatorsInfoFlag(cmd)
 	flags.OperatorsInfoPathFlag(cmd)
+	flags.OperatorsAPIURLFlag(cmd)
+	flags.OperatorsSnapshotPathFlag(cmd)
+	flags.OperatorsSnapshotMaxAgeFlag(cmd)
+	flags.OperatorsKeysEthEndpointURLFlag(cmd)
+	flags.SSVContractAddressFlag(cmd)
+	flags.OperatorsKeysSnapshotPathFlag(cmd)
+	flags.OperatorsKeysSnapshotSignerFlag(cmd)
 	flags.OperatorIDsFlag(cmd)
 	flags.OwnerAddressFlag(cmd)
 	flags.NonceFlag(cmd)
 	flags.NetworkFlag(cmd)
 	flags.WithdrawAddressFlag(cmd)
+	flags.WithdrawPubKeyFlag(cmd)
 	flags.ValidatorsFlag(cmd)
 	flags.ClientCACertPathFlag(cmd)
+	flags.ThresholdPolicyFlag(cmd)
+	flags.ThresholdFlag(cmd)
+	flags.CompoundingFlag(cmd)
+	flags.DepositAmountFlag(cmd)
+	flags.ResumeFlag(cmd)
+	flags.PartialSuccessFlag(cmd)
+	flags.PhaseTimeoutFlag(cmd)
+	flags.CeremonyTimeoutFlag(cmd)
+	flags.StandbyOperatorIDsFlag(cmd)
+}
+
+func SetBatchFlags(cmd *cobra.Command) {
+	SetBaseFlags(cmd)
+	flags.OperatorsInfoFlag(cmd)
+	flags.OperatorsInfoPathFlag(cmd)
+	flags.OperatorsAPIURLFlag(cmd)
+	flags.OperatorsSnapshotPathFlag(cmd)
+	flags.OperatorsSnapshotMaxAgeFlag(cmd)
+	flags.OperatorsKeysEthEndpointURLFlag(cmd)
+	flags.SSVContractAddressFlag(cmd)
+	flags.OperatorsKeysSnapshotPathFlag(cmd)
+	flags.OperatorsKeysSnapshotSignerFlag(cmd)
+	flags.ManifestPathFlag(cmd)
+	flags.NetworkFlag(cmd)
+	flags.ClientCACertPathFlag(cmd)
+	flags.ThresholdPolicyFlag(cmd)
+	flags.CompoundingFlag(cmd)
+	flags.DepositAmountFlag(cmd)
+	flags.PartialSuccessFlag(cmd)
+	flags.MaxConcurrencyPerOperatorFlag(cmd)
+	flags.PhaseTimeoutFlag(cmd)
+	flags.CeremonyTimeoutFlag(cmd)
+}
+
+func SetReshareFlags(cmd *cobra.Command) {
+	SetResignFlags(cmd)
+	flags.NewOperatorIDsFlag(cmd)
+	flags.ThresholdFlag(cmd)
+}
+
+func SetResignFlags(cmd *cobra.Command) {
+	SetBaseFlags(cmd)
+	flags.OperatorsInfoFlag(cmd)
+	flags.OperatorsInfoPathFlag(cmd)
+	flags.OperatorsAPIURLFlag(cmd)
+	flags.OperatorsSnapshotPathFlag(cmd)
+	flags.OperatorsSnapshotMaxAgeFlag(cmd)
+	flags.OperatorsKeysEthEndpointURLFlag(cmd)
+	flags.SSVContractAddressFlag(cmd)
+	flags.OperatorsKeysSnapshotPathFlag(cmd)
+	flags.OperatorsKeysSnapshotSignerFlag(cmd)
+	flags.OperatorIDsFlag(cmd)
+	flags.OwnerAddressFlag(cmd)
+	flags.NonceFlag(cmd)
+	flags.NetworkFlag(cmd)
+	flags.WithdrawAddressFlag(cmd)
+	flags.ProofsFilePathFlag(cmd)
+	flags.EthKeystorePathFlag(cmd)
+	flags.EthKeystorePassFlag(cmd)
+	flags.ClientCACertPathFlag(cmd)
+	flags.ThresholdPolicyFlag(cmd)
+	flags.CompoundingFlag(cmd)
+	flags.DepositAmountFlag(cmd)
+	flags.PhaseTimeoutFlag(cmd)
+	flags.CeremonyTimeoutFlag(cmd)
+}
+
+func SetExitFlags(cmd *cobra.Command) {
+	SetBaseFlags(cmd)
+	flags.OperatorsInfoFlag(cmd)
+	flags.OperatorsInfoPathFlag(cmd)
+	flags.OperatorsAPIURLFlag(cmd)
+	flags.OperatorsSnapshotPathFlag(cmd)
+	flags.OperatorsSnapshotMaxAgeFlag(cmd)
+	flags.OperatorsKeysEthEndpointURLFlag(cmd)
+	flags.SSVContractAddressFlag(cmd)
+	flags.OperatorsKeysSnapshotPathFlag(cmd)
+	flags.OperatorsKeysSnapshotSignerFlag(cmd)
+	flags.OperatorIDsFlag(cmd)
+	flags.NetworkFlag(cmd)
+	flags.ProofsFilePathFlag(cmd)
+	flags.EthKeystorePathFlag(cmd)
+	flags.EthKeystorePassFlag(cmd)
+	flags.ValidatorIndexFlag(cmd)
+	flags.ExitEpochFlag(cmd)
+	flags.ClientCACertPathFlag(cmd)
+	flags.ThresholdPolicyFlag(cmd)
+	flags.PhaseTimeoutFlag(cmd)
+	flags.CeremonyTimeoutFlag(cmd)
 }
 
 func SetOperatorFlags(cmd *cobra.Command) {
@@ -181,18 +343,31 @@ func SetOperatorFlags(cmd *cobra.Command) {
 	flags.OperatorIDFlag(cmd)
 	flags.ServerTLSCertPath(cmd)
 	flags.ServerTLSKeyPath(cmd)
+	flags.EthEndpointURLFlag(cmd)
+	flags.ThresholdPolicyFlag(cmd)
+	flags.PolicyFilePathFlag(cmd)
+	flags.StatusTokenPathFlag(cmd)
+	flags.MetricsTokenPathFlag(cmd)
+	flags.MetricsAddressFlag(cmd)
+	flags.ShutdownTimeoutFlag(cmd)
+	flags.PhaserFlag(cmd)
+	flags.PhaserTimeoutFlag(cmd)
 }
 
 func SetVerifyFlags(cmd *cobra.Command) {
 	flags.AddPersistentStringFlag(cmd, "ceremonyDir", "", "Path to the ceremony directory", true)
 	flags.AddPersistentIntFlag(cmd, "validators", 1, "Number of validators", true)
-	flags.AddPersistentStringFlag(cmd, "withdrawAddress", "", "Withdrawal address", true)
-	flags.AddPersistentIntFlag(cmd, "nonce", 0, "Owner nonce", true)
+	flags.AddPersistentStringFlag(cmd, "withdrawAddress", "", "Withdrawal address", false)
+	flags.WithdrawPubKeyFlag(cmd)
+	flags.AddPersistentIntFlag(cmd, "nonce", 0, "Owner nonce, required without --nonces", false)
 	flags.AddPersistentStringFlag(cmd, "owner", "", "Owner address", true)
+	flags.AddPersistentStringSliceFlag(cmd, "nonces", []string{}, "Owner nonces of validators at the ceremony directory of a partially successful batch", false)
+	flags.NetworkConfigPathFlag(cmd)
 }
 
 func SetHealthCheckFlags(cmd *cobra.Command) {
 	flags.AddPersistentStringSliceFlag(cmd, "ip", []string{}, "Operator ip:port", true)
+	flags.StatusTokenPathFlag(cmd)
 }
 
 // BindFlags binds flags to yaml config parameters
@@ -229,6 +404,24 @@ func BindBaseFlags(cmd *cobra.Command) error {
 	if strings.Contains(LogFilePath, "../") {
 		return fmt.Errorf("😥 logFilePath should not contain traversal")
 	}
+	return bindNetworkConfigFlag(cmd)
+}
+
+// bindNetworkConfigFlag binds path to a custom network config file and registers the network
+func bindNetworkConfigFlag(cmd *cobra.Command) error {
+	if err := viper.BindPFlag("networkConfigPath", cmd.PersistentFlags().Lookup("networkConfigPath")); err != nil {
+		return err
+	}
+	NetworkConfigPath = viper.GetString("networkConfigPath")
+	if NetworkConfigPath == "" {
+		return nil
+	}
+	if strings.Contains(NetworkConfigPath, "../") {
+		return fmt.Errorf("😥 networkConfigPath should not contain traversal")
+	}
+	if _, err := utils.LoadNetworkConfig(NetworkConfigPath); err != nil {
+		return fmt.Errorf("😥 Failed to load network config: %s", err)
+	}
 	return nil
 }
 
@@ -241,18 +434,12 @@ func BindInitiatorBaseFlags(cmd *cobra.Command) error {
 	if err := viper.BindPFlag("operatorIDs", cmd.PersistentFlags().Lookup("operatorIDs")); err != nil {
 		return err
 	}
-	if err := viper.BindPFlag("operatorsInfo", cmd.PersistentFlags().Lookup("operatorsInfo")); err != nil {
-		return err
-	}
 	if err := viper.BindPFlag("owner", cmd.PersistentFlags().Lookup("owner")); err != nil {
 		return err
 	}
 	if err := viper.BindPFlag("nonce", cmd.PersistentFlags().Lookup("nonce")); err != nil {
 		return err
 	}
-	if err := viper.BindPFlag("operatorsInfoPath", cmd.PersistentFlags().Lookup("operatorsInfoPath")); err != nil {
-		return err
-	}
 	if err := viper.BindPFlag("clientCACertPath", cmd.PersistentFlags().Lookup("clientCACertPath")); err != nil {
 		return err
 	}
@@ -260,16 +447,8 @@ func BindInitiatorBaseFlags(cmd *cobra.Command) error {
 	if len(OperatorIDs) == 0 {
 		return fmt.Errorf("😥 Operator IDs flag cant be empty")
 	}
-	OperatorsInfoPath = viper.GetString("operatorsInfoPath")
-	if strings.Contains(OperatorsInfoPath, "../") {
-		return fmt.Errorf("😥 operatorsInfoPath flag should not contain traversal")
-	}
-	OperatorsInfo = viper.GetString("operatorsInfo")
-	if OperatorsInfoPath != "" && OperatorsInfo != "" {
-		return fmt.Errorf("😥 operators info can be provided either as a raw JSON string, or path to a file, not both")
-	}
-	if OperatorsInfoPath == "" && OperatorsInfo == "" {
-		return fmt.Errorf("😥 operators info should be provided either as a raw JSON string, or path to a file")
+	if err := bindOperatorsFlags(cmd); err != nil {
+		return err
 	}
 	owner := viper.GetString("owner")
 	if owner == "" {
@@ -286,7 +465,10 @@ func BindInitiatorBaseFlags(cmd *cobra.Command) error {
 			return fmt.Errorf("😥 clientCACertPath flag should not contain traversal")
 		}
 	}
-	return nil
+	if err := bindTimeoutFlags(cmd); err != nil {
+		return err
+	}
+	return bindThresholdPolicyFlag(cmd)
 }
 
 // BindInitFlags binds flags to yaml config parameters for the initial DKG
@@ -294,15 +476,139 @@ func BindInitFlags(cmd *cobra.Command) error {
 	if err := BindInitiatorBaseFlags(cmd); err != nil {
 		return err
 	}
-	if err := viper.BindPFlag("withdrawAddress", cmd.PersistentFlags().Lookup("withdrawAddress")); err != nil {
-		return err
-	}
 	if err := viper.BindPFlag("network", cmd.Flags().Lookup("network")); err != nil {
 		return err
 	}
 	if err := viper.BindPFlag("validators", cmd.Flags().Lookup("validators")); err != nil {
 		return err
 	}
+	if err := bindWithdrawFlags(cmd); err != nil {
+		return err
+	}
+	Network = viper.GetString("network")
+	if Network == "" {
+		return fmt.Errorf("😥 Failed to get fork version flag value")
+	}
+	Validators = viper.GetUint("validators")
+	if Validators > 100 || Validators == 0 {
+		return fmt.Errorf("🚨 Amount of generated validators should be 1 to 100")
+	}
+	if err := bindThresholdFlag(cmd); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("compounding", cmd.PersistentFlags().Lookup("compounding")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("depositAmount", cmd.PersistentFlags().Lookup("depositAmount")); err != nil {
+		return err
+	}
+	WithdrawalPrefix = crypto.ETH1WithdrawalPrefixByte
+	if len(WithdrawPubKey) != 0 {
+		WithdrawalPrefix = crypto.BLSWithdrawalPrefixByte
+	}
+	if viper.GetBool("compounding") {
+		if len(WithdrawPubKey) != 0 {
+			return fmt.Errorf("😥 compounding validators require a withdrawal address")
+		}
+		WithdrawalPrefix = crypto.CompoundingWithdrawalPrefixByte
+	}
+	DepositAmount = phase0.Gwei(viper.GetUint64("depositAmount"))
+	if err := spec.ValidateDepositParams(WithdrawalPrefix, DepositAmount); err != nil {
+		return fmt.Errorf("😥 %s", err.Error())
+	}
+	if err := viper.BindPFlag("resume", cmd.PersistentFlags().Lookup("resume")); err != nil {
+		return err
+	}
+	Resume = viper.GetBool("resume")
+	if err := viper.BindPFlag("partialSuccess", cmd.PersistentFlags().Lookup("partialSuccess")); err != nil {
+		return err
+	}
+	PartialSuccess = viper.GetBool("partialSuccess")
+	if err := viper.BindPFlag("standbyOperatorIDs", cmd.PersistentFlags().Lookup("standbyOperatorIDs")); err != nil {
+		return err
+	}
+	// standby operators keep the order of preference
+	StandbyOperators = nil
+	for _, id := range viper.GetStringSlice("standbyOperatorIDs") {
+		opid, err := strconv.ParseUint(id, 10, strconv.IntSize)
+		if err != nil {
+			return fmt.Errorf("😥 Failed to parse standby operator ID %s: %s", id, err)
+		}
+		StandbyOperators = append(StandbyOperators, opid)
+	}
+	return nil
+}
+
+// BindBatchFlags binds flags to yaml config parameters for a manifest of DKG ceremonies
+func BindBatchFlags(cmd *cobra.Command) error {
+	if err := BindBaseFlags(cmd); err != nil {
+		return err
+	}
+	for _, flag := range []string{"manifestPath", "network", "clientCACertPath", "compounding", "depositAmount", "partialSuccess", "maxConcurrencyPerOperator"} {
+		if err := viper.BindPFlag(flag, cmd.PersistentFlags().Lookup(flag)); err != nil {
+			return err
+		}
+	}
+	ManifestPath = viper.GetString("manifestPath")
+	if ManifestPath == "" {
+		return fmt.Errorf("😥 Failed to get manifest path flag value")
+	}
+	if strings.Contains(ManifestPath, "../") {
+		return fmt.Errorf("😥 manifestPath flag should not contain traversal")
+	}
+	Network = viper.GetString("network")
+	if Network == "" {
+		return fmt.Errorf("😥 Failed to get fork version flag value")
+	}
+	if err := bindOperatorsFlags(cmd); err != nil {
+		return err
+	}
+	ClientCACertPath = viper.GetStringSlice("clientCACertPath")
+	for _, certPath := range ClientCACertPath {
+		if strings.Contains(certPath, "../") {
+			return fmt.Errorf("😥 clientCACertPath flag should not contain traversal")
+		}
+	}
+	if err := bindAddressDepositFlags(cmd); err != nil {
+		return err
+	}
+	PartialSuccess = viper.GetBool("partialSuccess")
+	MaxConcurrencyPerOperator = viper.GetInt("maxConcurrencyPerOperator")
+	if MaxConcurrencyPerOperator < 1 {
+		return fmt.Errorf("😥 maxConcurrencyPerOperator should be at least 1")
+	}
+	if err := bindTimeoutFlags(cmd); err != nil {
+		return err
+	}
+	return bindThresholdPolicyFlag(cmd)
+}
+
+// BindReshareFlags binds flags to yaml config parameters for the resharing ceremony
+func BindReshareFlags(cmd *cobra.Command) error {
+	if err := BindResignFlags(cmd); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("newOperatorIDs", cmd.PersistentFlags().Lookup("newOperatorIDs")); err != nil {
+		return err
+	}
+	NewOperatorIDs = viper.GetStringSlice("newOperatorIDs")
+	if len(NewOperatorIDs) == 0 {
+		return fmt.Errorf("😥 New operator IDs flag cant be empty")
+	}
+	return bindThresholdFlag(cmd)
+}
+
+// BindResignFlags binds flags to yaml config parameters for resigning owner and nonce
+func BindResignFlags(cmd *cobra.Command) error {
+	if err := BindInitiatorBaseFlags(cmd); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("withdrawAddress", cmd.PersistentFlags().Lookup("withdrawAddress")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("network", cmd.PersistentFlags().Lookup("network")); err != nil {
+		return err
+	}
 	withdrawAddr := viper.GetString("withdrawAddress")
 	if withdrawAddr == "" {
 		return fmt.Errorf("😥 Failed to get withdrawal address flag value")
@@ -316,9 +622,223 @@ func BindInitFlags(cmd *cobra.Command) error {
 	if Network == "" {
 		return fmt.Errorf("😥 Failed to get fork version flag value")
 	}
-	Validators = viper.GetUint("validators")
-	if Validators > 100 || Validators == 0 {
-		return fmt.Errorf("🚨 Amount of generated validators should be 1 to 100")
+	if err := bindAddressDepositFlags(cmd); err != nil {
+		return err
+	}
+	return bindProofsAndKeystoreFlags(cmd)
+}
+
+// bindAddressDepositFlags binds withdrawal credentials type and deposit amount of validators with a withdrawal address
+func bindAddressDepositFlags(cmd *cobra.Command) error {
+	for _, flag := range []string{"compounding", "depositAmount"} {
+		if err := viper.BindPFlag(flag, cmd.PersistentFlags().Lookup(flag)); err != nil {
+			return err
+		}
+	}
+	WithdrawalPrefix = crypto.ETH1WithdrawalPrefixByte
+	if viper.GetBool("compounding") {
+		WithdrawalPrefix = crypto.CompoundingWithdrawalPrefixByte
+	}
+	DepositAmount = phase0.Gwei(viper.GetUint64("depositAmount"))
+	if err := spec.ValidateDepositParams(WithdrawalPrefix, DepositAmount); err != nil {
+		return fmt.Errorf("😥 %s", err.Error())
+	}
+	return nil
+}
+
+// BindExitFlags binds flags to yaml config parameters for voluntary exit signing
+func BindExitFlags(cmd *cobra.Command) error {
+	if err := BindBaseFlags(cmd); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("operatorIDs", cmd.PersistentFlags().Lookup("operatorIDs")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("clientCACertPath", cmd.PersistentFlags().Lookup("clientCACertPath")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("network", cmd.PersistentFlags().Lookup("network")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("validatorIndex", cmd.PersistentFlags().Lookup("validatorIndex")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("exitEpoch", cmd.PersistentFlags().Lookup("exitEpoch")); err != nil {
+		return err
+	}
+	OperatorIDs = viper.GetStringSlice("operatorIDs")
+	if len(OperatorIDs) == 0 {
+		return fmt.Errorf("😥 Operator IDs flag cant be empty")
+	}
+	if err := bindOperatorsFlags(cmd); err != nil {
+		return err
+	}
+	ClientCACertPath = viper.GetStringSlice("clientCACertPath")
+	for _, certPath := range ClientCACertPath {
+		if strings.Contains(certPath, "../") {
+			return fmt.Errorf("😥 clientCACertPath flag should not contain traversal")
+		}
+	}
+	Network = viper.GetString("network")
+	if Network == "" {
+		return fmt.Errorf("😥 Failed to get fork version flag value")
+	}
+	ValidatorIndex = viper.GetUint64("validatorIndex")
+	ExitEpoch = viper.GetUint64("exitEpoch")
+	if err := bindThresholdPolicyFlag(cmd); err != nil {
+		return err
+	}
+	if err := bindTimeoutFlags(cmd); err != nil {
+		return err
+	}
+	return bindProofsAndKeystoreFlags(cmd)
+}
+
+// bindThresholdFlag binds DKG threshold flag, the threshold can be set only at custom threshold policy
+func bindThresholdFlag(cmd *cobra.Command) error {
+	if err := viper.BindPFlag("threshold", cmd.PersistentFlags().Lookup("threshold")); err != nil {
+		return err
+	}
+	Threshold = viper.GetUint64("threshold")
+	if Threshold != 0 && ThresholdPolicy != spec.CustomThresholdPolicy {
+		return fmt.Errorf("😥 threshold can be set only at custom threshold policy")
+	}
+	return nil
+}
+
+// bindThresholdPolicyFlag binds accepted number of operators and threshold flag
+func bindThresholdPolicyFlag(cmd *cobra.Command) error {
+	if err := viper.BindPFlag("thresholdPolicy", cmd.PersistentFlags().Lookup("thresholdPolicy")); err != nil {
+		return err
+	}
+	var err error
+	ThresholdPolicy, err = spec.ParseThresholdPolicy(viper.GetString("thresholdPolicy"))
+	if err != nil {
+		return fmt.Errorf("😥 Failed to parse threshold policy: %s", err)
+	}
+	return nil
+}
+
+// bindOperatorsFlags binds the source of operators information: raw JSON, a file or the operators API
+func bindOperatorsFlags(cmd *cobra.Command) error {
+	for _, flag := range []string{"operatorsInfo", "operatorsInfoPath", "operatorsAPIURL", "operatorsSnapshotPath", "operatorsSnapshotMaxAge"} {
+		if err := viper.BindPFlag(flag, cmd.PersistentFlags().Lookup(flag)); err != nil {
+			return err
+		}
+	}
+	OperatorsInfoPath = viper.GetString("operatorsInfoPath")
+	if strings.Contains(OperatorsInfoPath, "../") {
+		return fmt.Errorf("😥 operatorsInfoPath flag should not contain traversal")
+	}
+	OperatorsInfo = viper.GetString("operatorsInfo")
+	OperatorsAPIURL = viper.GetString("operatorsAPIURL")
+	sources := 0
+	for _, source := range []string{OperatorsInfo, OperatorsInfoPath, OperatorsAPIURL} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return fmt.Errorf("😥 operators info can be provided either as a raw JSON string, path to a file or operators API URL, not several")
+	}
+	if sources == 0 {
+		return fmt.Errorf("😥 operators info should be provided either as a raw JSON string, path to a file or operators API URL")
+	}
+	SnapshotPath = viper.GetString("operatorsSnapshotPath")
+	if strings.Contains(SnapshotPath, "../") {
+		return fmt.Errorf("😥 operatorsSnapshotPath flag should not contain traversal")
+	}
+	if SnapshotPath != "" && OperatorsAPIURL == "" {
+		return fmt.Errorf("😥 operators snapshot can be used only with operators API URL")
+	}
+	SnapshotMaxAge = viper.GetDuration("operatorsSnapshotMaxAge")
+	if SnapshotMaxAge < 0 {
+		return fmt.Errorf("😥 operators snapshot age cant be negative")
+	}
+	return bindOperatorKeysFlags(cmd)
+}
+
+// bindOperatorKeysFlags binds the source of operator keys registered at the SSV network: the contract or a signed snapshot
+func bindOperatorKeysFlags(cmd *cobra.Command) error {
+	for _, flag := range []string{"operatorsKeysEthEndpointURL", "ssvContractAddress", "operatorsKeysSnapshotPath", "operatorsKeysSnapshotSigner"} {
+		if err := viper.BindPFlag(flag, cmd.PersistentFlags().Lookup(flag)); err != nil {
+			return err
+		}
+	}
+	KeysEthEndpoint = viper.GetString("operatorsKeysEthEndpointURL")
+	KeysSnapshotPath = viper.GetString("operatorsKeysSnapshotPath")
+	if strings.Contains(KeysSnapshotPath, "../") {
+		return fmt.Errorf("😥 operatorsKeysSnapshotPath flag should not contain traversal")
+	}
+	if KeysEthEndpoint != "" && KeysSnapshotPath != "" {
+		return fmt.Errorf("😥 operator keys can be verified either at the SSV contract, or with a snapshot, not both")
+	}
+	var err error
+	if contract := viper.GetString("ssvContractAddress"); contract != "" {
+		SSVContract, err = utils.HexToAddress(contract)
+		if err != nil {
+			return fmt.Errorf("😥 Failed to parse SSV contract address: %s", err)
+		}
+	}
+	if signer := viper.GetString("operatorsKeysSnapshotSigner"); signer != "" {
+		KeysSigner, err = utils.HexToAddress(signer)
+		if err != nil {
+			return fmt.Errorf("😥 Failed to parse operator keys snapshot signer: %s", err)
+		}
+	}
+	if KeysSnapshotPath != "" && KeysSigner == (common.Address{}) {
+		return fmt.Errorf("😥 operator keys snapshot signer should be provided to verify the snapshot")
+	}
+	return nil
+}
+
+// bindTimeoutFlags binds deadlines of ceremony phases and the whole ceremony
+func bindTimeoutFlags(cmd *cobra.Command) error {
+	if err := viper.BindPFlag("phaseTimeout", cmd.PersistentFlags().Lookup("phaseTimeout")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("ceremonyTimeout", cmd.PersistentFlags().Lookup("ceremonyTimeout")); err != nil {
+		return err
+	}
+	PhaseTimeout = viper.GetDuration("phaseTimeout")
+	CeremonyTimeout = viper.GetDuration("ceremonyTimeout")
+	if PhaseTimeout < 0 || CeremonyTimeout < 0 {
+		return fmt.Errorf("😥 ceremony deadlines cant be negative")
+	}
+	return nil
+}
+
+// bindProofsAndKeystoreFlags binds proofs of the previous ceremony and owner's ethereum keystore flags
+func bindProofsAndKeystoreFlags(cmd *cobra.Command) error {
+	if err := viper.BindPFlag("proofsFilePath", cmd.PersistentFlags().Lookup("proofsFilePath")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("ethKeystorePath", cmd.PersistentFlags().Lookup("ethKeystorePath")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("ethKeystorePass", cmd.PersistentFlags().Lookup("ethKeystorePass")); err != nil {
+		return err
+	}
+	ProofsFilePath = viper.GetString("proofsFilePath")
+	if ProofsFilePath == "" {
+		return fmt.Errorf("😥 Failed to get proofs file path flag value")
+	}
+	if strings.Contains(ProofsFilePath, "../") {
+		return fmt.Errorf("😥 proofsFilePath flag should not contain traversal")
+	}
+	EthKeystorePath = viper.GetString("ethKeystorePath")
+	if EthKeystorePath == "" {
+		return fmt.Errorf("😥 Failed to get ethereum keystore path flag value")
+	}
+	if strings.Contains(EthKeystorePath, "../") {
+		return fmt.Errorf("😥 ethKeystorePath flag should not contain traversal")
+	}
+	EthKeystorePass = viper.GetString("ethKeystorePass")
+	if EthKeystorePass == "" {
+		return fmt.Errorf("😥 Failed to get ethereum keystore password flag value")
+	}
+	if strings.Contains(EthKeystorePass, "../") {
+		return fmt.Errorf("😥 ethKeystorePass flag should not contain traversal")
 	}
 	return nil
 }
@@ -346,6 +866,30 @@ func BindOperatorFlags(cmd *cobra.Command) error {
 	if err := viper.BindPFlag("serverTLSKeyPath", cmd.PersistentFlags().Lookup("serverTLSKeyPath")); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("ethEndpointURL", cmd.PersistentFlags().Lookup("ethEndpointURL")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("policyFilePath", cmd.PersistentFlags().Lookup("policyFilePath")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("statusTokenPath", cmd.PersistentFlags().Lookup("statusTokenPath")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("metricsTokenPath", cmd.PersistentFlags().Lookup("metricsTokenPath")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("metricsAddress", cmd.PersistentFlags().Lookup("metricsAddress")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("shutdownTimeout", cmd.PersistentFlags().Lookup("shutdownTimeout")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("phaser", cmd.PersistentFlags().Lookup("phaser")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("phaserTimeout", cmd.PersistentFlags().Lookup("phaserTimeout")); err != nil {
+		return err
+	}
 	PrivKey = viper.GetString("privKey")
 	PrivKeyPassword = viper.GetString("privKeyPassword")
 	if PrivKey == "" {
@@ -376,9 +920,81 @@ func BindOperatorFlags(cmd *cobra.Command) error {
 	if strings.Contains(ServerTLSKeyPath, "../") {
 		return fmt.Errorf("😥 serverTLSKeyPath flag should not contain traversal")
 	}
+	EthEndpointURL = viper.GetString("ethEndpointURL")
+	PolicyFilePath = viper.GetString("policyFilePath")
+	if strings.Contains(PolicyFilePath, "../") {
+		return fmt.Errorf("😥 policyFilePath flag should not contain traversal")
+	}
+	StatusTokenPath = viper.GetString("statusTokenPath")
+	if strings.Contains(StatusTokenPath, "../") {
+		return fmt.Errorf("😥 statusTokenPath flag should not contain traversal")
+	}
+	MetricsTokenPath = viper.GetString("metricsTokenPath")
+	if strings.Contains(MetricsTokenPath, "../") {
+		return fmt.Errorf("😥 metricsTokenPath flag should not contain traversal")
+	}
+	MetricsAddress = viper.GetString("metricsAddress")
+	ShutdownTimeout = viper.GetDuration("shutdownTimeout")
+	if ShutdownTimeout < 0 {
+		return fmt.Errorf("😥 shutdownTimeout shouldnt be negative")
+	}
+	var err error
+	Phaser.Type, err = wire.ParsePhaserType(viper.GetString("phaser"))
+	if err != nil {
+		return fmt.Errorf("😥 Failed to parse phaser: %s", err)
+	}
+	Phaser.Timeout = viper.GetDuration("phaserTimeout")
+	if Phaser.Timeout <= 0 {
+		return fmt.Errorf("😥 phaserTimeout should be positive")
+	}
+	return bindThresholdPolicyFlag(cmd)
+}
+
+// bindWithdrawFlags binds withdrawal address and BLS withdrawal public key flags, exactly one of them should be set
+func bindWithdrawFlags(cmd *cobra.Command) error {
+	if err := viper.BindPFlag("withdrawAddress", cmd.PersistentFlags().Lookup("withdrawAddress")); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("withdrawPubKey", cmd.PersistentFlags().Lookup("withdrawPubKey")); err != nil {
+		return err
+	}
+	withdrawAddr := viper.GetString("withdrawAddress")
+	withdrawPubKey := viper.GetString("withdrawPubKey")
+	if withdrawAddr != "" && withdrawPubKey != "" {
+		return fmt.Errorf("😥 withdrawal address and BLS withdrawal public key cant be set together")
+	}
+	WithdrawAddress = common.Address{}
+	WithdrawPubKey = nil
+	if withdrawPubKey != "" {
+		pk, err := hex.DecodeString(strings.TrimPrefix(withdrawPubKey, "0x"))
+		if err != nil {
+			return fmt.Errorf("😥 Failed to parse BLS withdrawal public key: %s", err)
+		}
+		if err := crypto.ValidateBLSWithdrawalPubKey(pk); err != nil {
+			return fmt.Errorf("😥 Failed to parse BLS withdrawal public key: %s", err)
+		}
+		WithdrawPubKey = pk
+		return nil
+	}
+	if withdrawAddr == "" {
+		return fmt.Errorf("😥 Failed to get withdrawal address flag value")
+	}
+	var err error
+	WithdrawAddress, err = utils.HexToAddress(withdrawAddr)
+	if err != nil {
+		return fmt.Errorf("😥 Failed to parse withdraw address: %s", err.Error())
+	}
 	return nil
 }
 
+// Withdraw returns the BLS withdrawal public key if set, or the withdrawal address
+func Withdraw() []byte {
+	if len(WithdrawPubKey) != 0 {
+		return WithdrawPubKey
+	}
+	return WithdrawAddress.Bytes()
+}
+
 // BindVerifyFlags binds flags to yaml config parameters for the verification
 func BindVerifyFlags(cmd *cobra.Command) error {
 	if err := viper.BindPFlag("ceremonyDir", cmd.PersistentFlags().Lookup("ceremonyDir")); err != nil {
@@ -387,15 +1003,15 @@ func BindVerifyFlags(cmd *cobra.Command) error {
 	if err := viper.BindPFlag("validators", cmd.Flags().Lookup("validators")); err != nil {
 		return err
 	}
-	if err := viper.BindPFlag("withdrawAddress", cmd.PersistentFlags().Lookup("withdrawAddress")); err != nil {
-		return err
-	}
 	if err := viper.BindPFlag("nonce", cmd.PersistentFlags().Lookup("nonce")); err != nil {
 		return err
 	}
 	if err := viper.BindPFlag("owner", cmd.PersistentFlags().Lookup("owner")); err != nil {
 		return err
 	}
+	if err := bindNetworkConfigFlag(cmd); err != nil {
+		return err
+	}
 	CeremonyDir = viper.GetString("ceremonyDir")
 	if CeremonyDir == "" {
 		return fmt.Errorf("😥 Failed to get ceremony directory flag value")
@@ -413,14 +1029,26 @@ func BindVerifyFlags(cmd *cobra.Command) error {
 		return fmt.Errorf("😥 Failed to parse owner address: %s", err)
 	}
 	Nonce = viper.GetUint64("nonce")
-	WithdrawAddress, err = utils.HexToAddress(viper.GetString("withdrawAddress"))
-	if err != nil {
-		return fmt.Errorf("😥 Failed to parse withdraw address: %s", err)
+	if err := bindWithdrawFlags(cmd); err != nil {
+		return err
 	}
 	Validators = viper.GetUint("validators")
 	if Validators == 0 {
 		return fmt.Errorf("😥 Failed to get validators flag value")
 	}
+	if err := viper.BindPFlag("nonces", cmd.PersistentFlags().Lookup("nonces")); err != nil {
+		return err
+	}
+	Nonces, err = StingSliceToUintArray(viper.GetStringSlice("nonces"))
+	if err != nil {
+		return fmt.Errorf("😥 Failed to parse nonces: %s", err)
+	}
+	if len(Nonces) != 0 && len(Nonces) != int(Validators) {
+		return fmt.Errorf("😥 Number of nonces doesnt match validators flag value")
+	}
+	if len(Nonces) == 0 && !viper.IsSet("nonce") {
+		return fmt.Errorf("😥 Failed to get nonce flag value, set --nonce or --nonces")
+	}
 	return nil
 }
 
@@ -447,29 +1075,71 @@ func StingSliceToUintArray(flagdata []string) ([]uint64, error) {
 	return partsarr, nil
 }
 
-// LoadOperators loads operators data from raw json or file path
-func LoadOperators(logger *zap.Logger) (wire.OperatorsCLI, error) {
-	var operators wire.OperatorsCLI
+// LoadOperators resolves operators by IDs from raw json, file path or the operators API
+func LoadOperators(ctx context.Context, logger *zap.Logger, ids []uint64) (wire.OperatorsCLI, error) {
+	var registry sdk.OperatorRegistry
 	var err error
-	if OperatorsInfo != "" {
-		err = json.Unmarshal([]byte(OperatorsInfo), &operators)
-		if err != nil {
-			return nil, err
-		}
-	} else {
+	switch {
+	case OperatorsInfo != "":
+		registry, err = sdk.LoadOperatorsJSON([]byte(OperatorsInfo))
+	case OperatorsInfoPath != "":
+		var operators wire.OperatorsCLI
 		operators, err = ReadOperatorsInfoFile(OperatorsInfoPath, logger)
 		if err != nil {
 			return nil, err
 		}
+		registry, err = sdk.NewStaticRegistry(operators)
+	default:
+		logger.Info("🌐 resolving operators at the operators API", zap.String("url", OperatorsAPIURL), zap.String("network", Network))
+		registry = &sdk.APIRegistry{URL: OperatorsAPIURL, Network: Network}
+		if SnapshotPath != "" {
+			registry = &sdk.SnapshotRegistry{Source: registry, Path: SnapshotPath, MaxAge: SnapshotMaxAge}
+		}
 	}
-	if operators == nil {
-		return nil, fmt.Errorf("no information about operators is provided. Please use or raw JSON, or file")
-	}
-	// check that we use https
-	if err := checkIfOperatorHTTPS(operators); err != nil {
+	if err != nil {
 		return nil, err
 	}
-	return operators, nil
+	// operators participating in both old and new clusters of resharing are resolved once
+	unique := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	return registry.Operators(ctx, unique)
+}
+
+// LoadOperatorKeysRegistry opens the SSV network registry to verify operator keys, nil if verification is not requested
+func LoadOperatorKeysRegistry(logger *zap.Logger) (initiator.OperatorKeysRegistry, error) {
+	switch {
+	case KeysSnapshotPath != "":
+		logger.Info("📖 loading operator keys snapshot", zap.String("path", KeysSnapshotPath), zap.String("signer", KeysSigner.Hex()))
+		snapshot, err := initiator.LoadKeysSnapshot(KeysSnapshotPath, KeysSigner)
+		if err != nil {
+			return nil, err
+		}
+		// operator IDs of different networks refer to different operators
+		if snapshot.Network != Network {
+			return nil, fmt.Errorf("operator keys snapshot is of network %s, expected network %s", snapshot.Network, Network)
+		}
+		return snapshot, nil
+	case KeysEthEndpoint != "":
+		contract := initiator.SSVContract{Address: SSVContract}
+		if SSVContract == (common.Address{}) {
+			known, ok := initiator.SSVContracts[Network]
+			if !ok {
+				return nil, fmt.Errorf("SSV contract address of network %s is unknown, please provide it", Network)
+			}
+			contract = known
+		}
+		logger.Info("🔗 connecting to ethereum node to verify operator keys", zap.String("endpoint", KeysEthEndpoint), zap.String("contract", contract.Address.Hex()))
+		ethClient, err := ethclient.Dial(KeysEthEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to ethereum node: %w", err)
+		}
+		return &initiator.ContractKeysRegistry{Client: ethClient, Contract: contract.Address, FromBlock: contract.DeployBlock}, nil
+	}
+	return nil, nil
 }
 
 func WriteResults(
@@ -481,206 +1151,120 @@ func WriteResults(
 	expectedValidatorCount int,
 	expectedOwnerAddress common.Address,
 	expectedOwnerNonce uint64,
-	expectedWithdrawAddress common.Address,
+	expectedWithdraw []byte,
 	outputPath string,
-) (err error) {
+) error {
 	if expectedValidatorCount == 0 {
 		return fmt.Errorf("expectedValidatorCount is 0")
 	}
-	if len(depositDataArr) != len(keySharesArr) || len(depositDataArr) != len(proofs) {
-		return fmt.Errorf("Incoming result arrays have inconsistent length")
-	}
-	if len(depositDataArr) == 0 {
-		return fmt.Errorf("no results to write")
-	}
-	if len(depositDataArr) != int(expectedValidatorCount) {
-		return fmt.Errorf("expectedValidatorCount is not equal to the length of given results")
-	}
-
-	// order the keyshares by nonce
-	sort.SliceStable(keySharesArr, func(i, j int) bool {
-		return keySharesArr[i].Shares[0].OwnerNonce < keySharesArr[j].Shares[0].OwnerNonce
-	})
-	sorted := sort.SliceIsSorted(keySharesArr, func(p, q int) bool {
-		return keySharesArr[p].Shares[0].OwnerNonce < keySharesArr[q].Shares[0].OwnerNonce
-	})
-	if !sorted {
-		return fmt.Errorf("slice is not sorted")
-	}
+	return sdk.WriteCeremonyDir(
+		logger,
+		depositDataArr,
+		keySharesArr,
+		proofs,
+		nil,
+		nil,
+		nil,
+		withRandomness,
+		validator.ContiguousNonces(expectedOwnerNonce, expectedValidatorCount),
+		expectedOwnerAddress,
+		expectedWithdraw,
+		outputPath,
+	)
+}
 
-	// check if public keys are unique
-	for i := 0; i < len(keySharesArr)-1; i++ {
-		pk1 := keySharesArr[i].Shares[0].Payload.PublicKey
-		pk2 := keySharesArr[i+1].Shares[0].Payload.PublicKey
-		if pk1 == pk2 {
-			return fmt.Errorf("public key %s is not unique", keySharesArr[i].Shares[0].Payload.PublicKey)
-		}
-	}
+// WriteReshareResults writes keyshares and proofs of the resharing ceremony. Resharing doesnt produce deposit data
+func WriteReshareResults(logger *zap.Logger, keyShares *wire.KeySharesCLI, proofs []*wire.SignedProof, outputPath string) error {
+	return writeKeysharesAndProofs(logger, "reshare", keyShares, proofs, outputPath)
+}
 
-	// order deposit data and proofs to match keyshares order
-	sortedDepositData := make([]*wire.DepositDataCLI, len(depositDataArr))
-	sortedProofs := make([][]*wire.SignedProof, len(depositDataArr))
-	for i, keyshare := range keySharesArr {
-		pk := strings.TrimPrefix(keyshare.Shares[0].Payload.PublicKey, "0x")
-		for _, deposit := range depositDataArr {
-			if deposit.PubKey == pk {
-				sortedDepositData[i] = deposit
-				break
-			}
-		}
-		if sortedDepositData[i] == nil {
-			return fmt.Errorf("failed to match deposit data with keyshares")
-		}
-		for _, proof := range proofs {
-			if hex.EncodeToString(proof[0].Proof.ValidatorPubKey) == pk {
-				sortedProofs[i] = proof
-				break
-			}
+// WriteBlameReports writes blame reports of ceremonies which failed because of faulty operators to the output directory
+func WriteBlameReports(logger *zap.Logger, outputPath string, errs ...error) {
+	for _, err := range errs {
+		var blameErr *initiator.BlameError
+		if !errors.As(err, &blameErr) {
+			continue
 		}
-		if sortedProofs[i] == nil {
-			return fmt.Errorf("failed to match proofs with keyshares")
+		path, err := initiator.WriteBlameReport(outputPath, blameErr.Report)
+		if err != nil {
+			logger.Error("😥 Failed to write blame report: ", zap.Error(err))
+			continue
 		}
+		logger.Warn("⚠️ Ceremony failed because of faulty operators, see the blame report",
+			zap.String("phase", blameErr.Report.Phase),
+			zap.Uint64s("offenders", blameErr.Report.Offenders),
+			zap.String("report", path),
+		)
 	}
-	depositDataArr = sortedDepositData
-	proofs = sortedProofs
+}
 
-	// Validate the results.
-	aggregatedKeyshares := &wire.KeySharesCLI{
-		Version:   keySharesArr[0].Version,
-		CreatedAt: keySharesArr[0].CreatedAt,
-	}
-	for i := 0; i < len(keySharesArr); i++ {
-		aggregatedKeyshares.Shares = append(aggregatedKeyshares.Shares, keySharesArr[i].Shares...)
-	}
-	if err := validator.ValidateResults(depositDataArr, aggregatedKeyshares, proofs, expectedValidatorCount, expectedOwnerAddress, expectedOwnerNonce, expectedWithdrawAddress); err != nil {
-		return err
-	}
+// WriteResignResults writes keyshares and proofs signed for a new owner and nonce
+func WriteResignResults(logger *zap.Logger, keyShares *wire.KeySharesCLI, proofs []*wire.SignedProof, outputPath string) error {
+	return writeKeysharesAndProofs(logger, "resign", keyShares, proofs, outputPath)
+}
 
-	// Create the ceremony directory.
+func writeKeysharesAndProofs(logger *zap.Logger, prefix string, keyShares *wire.KeySharesCLI, proofs []*wire.SignedProof, outputPath string) error {
 	timestamp := time.Now().UTC().Format("2006-01-02--15-04-05.000")
-	dirName := fmt.Sprintf("ceremony-%s", timestamp)
-	if withRandomness {
-		randomness := make([]byte, 4)
-		if _, err := rand.Read(randomness); err != nil {
-			return fmt.Errorf("failed to generate randomness: %w", err)
-		}
-		dirName = fmt.Sprintf("%s--%x", dirName, randomness)
+	randomness := make([]byte, 4)
+	if _, err := rand.Read(randomness); err != nil {
+		return fmt.Errorf("failed to generate randomness: %w", err)
 	}
-	dir := filepath.Join(outputPath, dirName)
-	err = os.Mkdir(dir, os.ModePerm)
-	if os.IsExist(err) {
-		return fmt.Errorf("ceremony directory already exists: %w", err)
+	dir := filepath.Join(outputPath, fmt.Sprintf("%s-%s--%x", prefix, timestamp, randomness))
+	nestedDir := fmt.Sprintf("%s/%06d-%s", dir, keyShares.Shares[0].OwnerNonce, keyShares.Shares[0].PublicKey)
+	if err := os.MkdirAll(nestedDir, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create a validator key directory: %w", err)
 	}
-	if err != nil {
-		return fmt.Errorf("failed to create a ceremony directory: %w", err)
+	logger.Info("💾 Writing keyshares payload to file", zap.String("path", nestedDir))
+	if err := sdk.WriteKeysharesResult(keyShares, nestedDir); err != nil {
+		return err
 	}
+	logger.Info("💾 Writing proofs to file", zap.String("path", nestedDir))
+	return sdk.WriteProofs(proofs, nestedDir)
+}
 
-	// If saving fails, create a "FAILED" file under the ceremony directory.
-	defer func() {
-		if err != nil {
-			if err := os.WriteFile(filepath.Join(dir, "FAILED"), []byte(err.Error()), 0o600); err != nil {
-				logger.Error("failed to write error file", zap.Error(err))
-			}
-		}
-	}()
-
-	for i := 0; i < len(depositDataArr); i++ {
-		nestedDir := fmt.Sprintf("%s/%06d-0x%s", dir, keySharesArr[i].Shares[0].OwnerNonce, depositDataArr[i].PubKey)
-		err := os.Mkdir(nestedDir, os.ModePerm)
-		if err != nil {
-			return fmt.Errorf("failed to create a validator key directory: %w", err)
-		}
-		logger.Info("💾 Writing deposit data json", zap.String("path", nestedDir))
-		err = WriteDepositResult(depositDataArr[i], nestedDir)
-		if err != nil {
-			logger.Error("Failed writing deposit data file: ", zap.Error(err), zap.String("path", nestedDir), zap.Any("deposit", depositDataArr[i]))
-			return fmt.Errorf("failed writing deposit data file: %w", err)
-		}
-		logger.Info("💾 Writing keyshares payload to file", zap.String("path", nestedDir))
-		err = WriteKeysharesResult(keySharesArr[i], nestedDir)
-		if err != nil {
-			logger.Error("Failed writing keyshares file: ", zap.Error(err), zap.String("path", nestedDir), zap.Any("deposit", keySharesArr[i]))
-			return fmt.Errorf("failed writing keyshares file: %w", err)
-		}
-		logger.Info("💾 Writing proofs to file", zap.String("path", nestedDir))
-		err = WriteProofs(proofs[i], nestedDir)
-		if err != nil {
-			logger.Error("Failed writing proofs file: ", zap.Error(err), zap.String("path", nestedDir), zap.Any("proof", proofs[i]))
-			return fmt.Errorf("failed writing proofs file: %w", err)
-		}
-	}
-	// if there is only one Validator, do not create summary files
-	if expectedValidatorCount > 1 {
-		err := WriteAggregatedInitResults(dir, depositDataArr, keySharesArr, proofs, logger)
-		if err != nil {
-			return fmt.Errorf("failed writing aggregated results: %w", err)
-		}
+// WriteExitResult writes the signed voluntary exit in the format accepted by beacon node API
+func WriteExitResult(logger *zap.Logger, signedExit *phase0.SignedVoluntaryExit, validatorPubKey []byte, outputPath string) error {
+	timestamp := time.Now().UTC().Format("2006-01-02--15-04-05.000")
+	dir := filepath.Join(outputPath, fmt.Sprintf("exit-%s", timestamp))
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create exit directory: %w", err)
 	}
-
-	err = validator.ValidateResultsDir(dir, expectedValidatorCount, expectedOwnerAddress, expectedOwnerNonce, expectedWithdrawAddress)
-	if err != nil {
-		return fmt.Errorf("failed validating results dir: %w", err)
+	path := filepath.Join(dir, fmt.Sprintf("signed_exit-0x%x.json", validatorPubKey))
+	logger.Info("💾 Writing signed voluntary exit to file", zap.String("path", path))
+	if err := utils.WriteJSON(path, signedExit); err != nil {
+		return fmt.Errorf("failed writing signed voluntary exit file: %w", err)
 	}
-
 	return nil
 }
 
-func WriteAggregatedInitResults(dir string, depositDataArr []*wire.DepositDataCLI, keySharesArr []*wire.KeySharesCLI, proofs [][]*wire.SignedProof, logger *zap.Logger) error {
-	// Write all to one JSON file
-	depositFinalPath := fmt.Sprintf("%s/deposit_data.json", dir)
-	logger.Info("💾 Writing deposit data json to file", zap.String("path", depositFinalPath))
-	err := utils.WriteJSON(depositFinalPath, depositDataArr)
+// LoadProofs reads proofs of a ceremony from a proofs.json file
+func LoadProofs(path string) ([]*wire.SignedProof, error) {
+	proofsJSON, err := os.ReadFile(filepath.Clean(path))
 	if err != nil {
-		logger.Error("Failed writing deposit data file: ", zap.Error(err), zap.String("path", depositFinalPath), zap.Any("deposits", depositDataArr))
-		return err
+		return nil, fmt.Errorf("😥 Failed to read proofs file: %s", err)
 	}
-	keysharesFinalPath := fmt.Sprintf("%s/keyshares.json", dir)
-	logger.Info("💾 Writing keyshares payload to file", zap.String("path", keysharesFinalPath))
-	aggrKeySharesArr, err := initiator.GenerateAggregatesKeyshares(keySharesArr)
-	if err != nil {
-		return err
-	}
-	err = utils.WriteJSON(keysharesFinalPath, aggrKeySharesArr)
-	if err != nil {
-		logger.Error("Failed writing keyshares to file: ", zap.Error(err), zap.String("path", keysharesFinalPath), zap.Any("keyshares", keySharesArr))
-		return err
-	}
-	proofsFinalPath := fmt.Sprintf("%s/proofs.json", dir)
-	err = utils.WriteJSON(proofsFinalPath, proofs)
-	if err != nil {
-		logger.Error("Failed writing ceremony sig file: ", zap.Error(err), zap.String("path", proofsFinalPath), zap.Any("proofs", proofs))
-		return err
+	var proofs []*wire.SignedProof
+	if err := json.Unmarshal(proofsJSON, &proofs); err != nil {
+		return nil, fmt.Errorf("😥 Failed to load proofs: %s", err)
 	}
-
-	return nil
+	return proofs, nil
 }
 
-func WriteKeysharesResult(keyShares *wire.KeySharesCLI, dir string) error {
-	keysharesFinalPath := fmt.Sprintf("%s/keyshares.json", dir)
-	err := utils.WriteJSON(keysharesFinalPath, keyShares)
+// OpenEthKeystore decrypts owner's ethereum keystore with a password read from file
+func OpenEthKeystore(passwordFilePath, keystorePath string) (*ecdsa.PrivateKey, error) {
+	keystoreJSON, err := os.ReadFile(filepath.Clean(keystorePath))
 	if err != nil {
-		return fmt.Errorf("failed writing keyshares file: %w, %v", err, keyShares)
+		return nil, fmt.Errorf("😥 Cant read ethereum keystore file: %s", err)
 	}
-	return nil
-}
-
-func WriteDepositResult(depositData *wire.DepositDataCLI, dir string) error {
-	depositFinalPath := fmt.Sprintf("%s/deposit_data.json", dir)
-	err := utils.WriteJSON(depositFinalPath, []*wire.DepositDataCLI{depositData})
-
+	password, err := os.ReadFile(filepath.Clean(passwordFilePath))
 	if err != nil {
-		return fmt.Errorf("failed writing deposit data file: %w, %v", err, depositData)
+		return nil, fmt.Errorf("😥 Error reading password file: %s", err)
 	}
-	return nil
-}
-
-func WriteProofs(proofs []*wire.SignedProof, dir string) error {
-	finalPath := fmt.Sprintf("%s/proofs.json", dir)
-	err := utils.WriteJSON(finalPath, proofs)
+	key, err := keystore.DecryptKey(keystoreJSON, strings.TrimSpace(string(password)))
 	if err != nil {
-		return fmt.Errorf("failed writing data file: %w, %v", err, proofs)
+		return nil, fmt.Errorf("😥 Error decrypting ethereum keystore: %s", err)
 	}
-	return nil
+	return key.PrivateKey, nil
 }
 
 func createDirIfNotExist(path string) error {
@@ -709,16 +1293,3 @@ func Sync(logger *zap.Logger) error {
 	}
 	return nil
 }
-
-func checkIfOperatorHTTPS(ops []wire.OperatorCLI) error {
-	for _, op := range ops {
-		url, err := url.Parse(op.Addr)
-		if err != nil {
-			return fmt.Errorf("parsing IP address: %s, err: %w", op.Addr, err)
-		}
-		if url.Scheme != "https" {
-			return fmt.Errorf("only HTTPS scheme is allowed at operator address %s, got: %s", op.Addr, url.Scheme)
-		}
-	}
-	return nil
-}
@@ -1,9 +1,11 @@
 package verify
 
 import (
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -24,13 +26,26 @@ var Verify = &cobra.Command{
 			return err
 		}
 
-		err := validator.ValidateResultsDir(
-			cli_utils.CeremonyDir,
-			int(cli_utils.Validators),
-			cli_utils.OwnerAddress,
-			cli_utils.Nonce,
-			cli_utils.WithdrawAddress,
-		)
+		var err error
+		nonces := fmt.Sprintf("%d", cli_utils.Nonce)
+		if len(cli_utils.Nonces) != 0 {
+			// explicit list of nonces, i.e. of a directory written with gaps at nonces of failed ceremonies
+			nonces = strings.Trim(fmt.Sprint(cli_utils.Nonces), "[]")
+			err = validator.ValidateResultsDirWithNonces(
+				cli_utils.CeremonyDir,
+				cli_utils.Nonces,
+				cli_utils.OwnerAddress,
+				cli_utils.Withdraw(),
+			)
+		} else {
+			err = validator.ValidateResultsDir(
+				cli_utils.CeremonyDir,
+				int(cli_utils.Validators),
+				cli_utils.OwnerAddress,
+				cli_utils.Nonce,
+				cli_utils.Withdraw(),
+			)
+		}
 		if err != nil {
 			log.Printf("Failed to validate ceremony directory: %v", err)
 			return err
@@ -38,12 +53,16 @@ var Verify = &cobra.Command{
 
 		log.Printf("Ceremony is valid.")
 
+		withdrawHeader, withdraw := "Withdrawal Address", cli_utils.WithdrawAddress.String()
+		if len(cli_utils.WithdrawPubKey) != 0 {
+			withdrawHeader, withdraw = "BLS Withdrawal Public Key", "0x"+hex.EncodeToString(cli_utils.WithdrawPubKey)
+		}
 		tbl := table.New(os.Stdout)
-		tbl.SetHeaders("Directory", "Withdrawal Address", "Nonce", "Owner Address", "Validators")
+		tbl.SetHeaders("Directory", withdrawHeader, "Nonce", "Owner Address", "Validators")
 		tbl.AddRow(
 			cli_utils.CeremonyDir,
-			cli_utils.WithdrawAddress.String(),
-			fmt.Sprintf("%d", cli_utils.Nonce),
+			withdraw,
+			nonces,
 			cli_utils.OwnerAddress.String(),
 			fmt.Sprintf("%d", cli_utils.Validators),
 		)
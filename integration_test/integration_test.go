@@ -7,8 +7,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 	"unsafe"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -561,6 +564,44 @@ func TestLargeOperatorIDs(t *testing.T) {
 	srv13.HttpSrv.Close()
 }
 
+func TestLateOperator(t *testing.T) {
+	err := logging.SetGlobalLogger("info", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("integration-tests")
+	version := "test.version"
+	var servers []*test_utils.TestOperator
+	ops := wire.OperatorsCLI{}
+	for _, id := range []uint64{1, 2, 3, 4} {
+		srv := test_utils.CreateTestOperator(t, id, version, operatorCert, operatorKey)
+		ops = append(ops, wire.OperatorCLI{Addr: srv.HttpSrv.URL, ID: id, PubKey: &srv.PrivKey.PublicKey})
+		servers = append(servers, srv)
+	}
+	// operator 4 receives deals later than a phase of the protocol used to last,
+	// other operators wait for its response bundle instead of complaining about it
+	late := servers[3].HttpSrv.Config.Handler
+	var dkgRequests atomic.Int32
+	servers[3].HttpSrv.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/dkg" && dkgRequests.Add(1) == 2 {
+			time.Sleep(12 * time.Second)
+		}
+		late.ServeHTTP(writer, request)
+	})
+	clnt, err := initiator.New(ops, logger, version, rootCert)
+	require.NoError(t, err)
+	withdraw := newEthAddress(t)
+	owner := newEthAddress(t)
+	depositData, ks, _, err := clnt.StartDKG(crypto.NewID(), withdraw.Bytes(), []uint64{1, 2, 3, 4}, "holesky", owner, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), dkgRequests.Load())
+	err = crypto.ValidateDepositDataCLI(depositData, withdraw)
+	require.NoError(t, err)
+	err = test_utils.VerifySharesData([]uint64{1, 2, 3, 4}, []*rsa.PrivateKey{servers[0].PrivKey, servers[1].PrivKey, servers[2].PrivKey, servers[3].PrivKey}, ks, owner, 0)
+	require.NoError(t, err)
+	for _, srv := range servers {
+		srv.HttpSrv.Close()
+	}
+}
+
 func TestWrongInitiatorVersion(t *testing.T) {
 	err := logging.SetGlobalLogger("info", "capital", "console", nil)
 	require.NoError(t, err)
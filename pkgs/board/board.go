@@ -43,15 +43,7 @@ func (b *Board) PushDeals(bundle *dkg.DealBundle) {
 		b.logger.Error(err.Error())
 		return
 	}
-	msg := &wire2.KyberMessage{
-		Type: wire2.KyberDealBundleMessageType,
-		Data: byts,
-	}
-
-	if err := b.broadcastF(msg); err != nil {
-		b.logger.Error(err.Error())
-		return
-	}
+	b.push(wire2.KyberDealBundleMessageType, byts)
 }
 
 // IncomingDeal implements a kyber DKG Board interface function
@@ -61,7 +53,14 @@ func (b *Board) IncomingDeal() <-chan dkg.DealBundle {
 
 // PushResponses implements a kyber DKG Board interface to broadcast responses
 func (b *Board) PushResponses(bundle *dkg.ResponseBundle) {
-	// dont push responses to nodes, allowing them to finish with error
+	b.logger.Debug("Pushing response bundle: ", zap.Int("num of responses", len(bundle.Responses)))
+
+	byts, err := wire2.EncodeResponseBundle(bundle)
+	if err != nil {
+		b.logger.Error(err.Error())
+		return
+	}
+	b.push(wire2.KyberResponseBundleMessageType, byts)
 }
 
 // IncomingResponse implements a kyber DKG Board interface function
@@ -71,10 +70,28 @@ func (b *Board) IncomingResponse() <-chan dkg.ResponseBundle {
 
 // PushJustifications implements a kyber DKG interface to broadcast justifications
 func (b *Board) PushJustifications(bundle *dkg.JustificationBundle) {
-	// dont push justifications to nodes, allowing them to finish with error
+	b.logger.Debug("Pushing justification bundle: ", zap.Int("num of justifications", len(bundle.Justifications)))
+
+	byts, err := wire2.EncodeJustificationBundle(bundle)
+	if err != nil {
+		b.logger.Error(err.Error())
+		return
+	}
+	b.push(wire2.KyberJustificationBundleMessageType, byts)
 }
 
 // IncomingJustification implements a kyber DKG Board interface function
 func (b *Board) IncomingJustification() <-chan dkg.JustificationBundle {
 	return b.JustificationC
 }
+
+// push broadcasts an encoded bundle, initiator relays it to all operators
+func (b *Board) push(t wire2.TransportType, byts []byte) {
+	msg := &wire2.KyberMessage{
+		Type: t,
+		Data: byts,
+	}
+	if err := b.broadcastF(msg); err != nil {
+		b.logger.Error(err.Error())
+	}
+}
@@ -9,14 +9,30 @@ import (
 	"github.com/herumi/bls-eth-go-binary/bls"
 	types "github.com/wealdtech/go-eth2-types/v2"
 	util "github.com/wealdtech/go-eth2-util"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
 )
 
 const (
 	// BLSWithdrawalPrefixByte is the BLS withdrawal prefix
 	BLSWithdrawalPrefixByte  = byte(0)
 	ETH1WithdrawalPrefixByte = byte(1)
+	// CompoundingWithdrawalPrefixByte is the withdrawal prefix of compounding validators (EIP-7251)
+	CompoundingWithdrawalPrefixByte = byte(2)
 )
 
+// ValidateBLSWithdrawalPubKey returns nil if the key is a valid BLS public key
+func ValidateBLSWithdrawalPubKey(withdrawalPubKey []byte) error {
+	if len(withdrawalPubKey) != phase0.PublicKeyLength {
+		return fmt.Errorf("BLS withdrawal public key must be %d bytes", phase0.PublicKeyLength)
+	}
+	pk := &bls.PublicKey{}
+	if err := pk.Deserialize(withdrawalPubKey); err != nil {
+		return fmt.Errorf("invalid BLS withdrawal public key: %w", err)
+	}
+	return nil
+}
+
 // withdrawalCredentialsHash forms a 32 byte hash of the withdrawal public
 // address.
 //
@@ -32,8 +48,14 @@ func BLSWithdrawalCredentials(withdrawalPubKey []byte) []byte {
 }
 
 func ETH1WithdrawalCredentials(withdrawalAddr []byte) []byte {
+	return WithdrawalCredentials(ETH1WithdrawalPrefixByte, withdrawalAddr)
+}
+
+// WithdrawalCredentials forms withdrawal credentials of the execution layer withdrawal address
+// with the prefix, 0x01 or 0x02 for compounding validators
+func WithdrawalCredentials(prefix byte, withdrawalAddr []byte) []byte {
 	withdrawalCredentials := make([]byte, 32)
-	copy(withdrawalCredentials[:1], []byte{ETH1WithdrawalPrefixByte})
+	copy(withdrawalCredentials[:1], []byte{prefix})
 	// withdrawalCredentials[1:12] == b'\x00' * 11 // this is not needed since cells are zeroed anyway
 	copy(withdrawalCredentials[12:], withdrawalAddr)
 	return withdrawalCredentials
@@ -43,8 +65,33 @@ func ParseWithdrawalCredentials(withdrawalCredentials []byte) (prefix byte, addr
 	return withdrawalCredentials[0], withdrawalCredentials[12:]
 }
 
+// MaxDepositAmount returns the max deposit amount of a validator with the withdrawal prefix,
+// which is its max effective balance
+func MaxDepositAmount(prefix byte) (phase0.Gwei, error) {
+	switch prefix {
+	case BLSWithdrawalPrefixByte, ETH1WithdrawalPrefixByte:
+		return MaxEffectiveBalanceInGwei, nil
+	case CompoundingWithdrawalPrefixByte:
+		return MaxEffectiveBalanceElectraInGwei, nil
+	default:
+		return 0, fmt.Errorf("unsupported withdrawal prefix %#x", prefix)
+	}
+}
+
+// ValidateDepositAmount returns nil if the amount is a valid deposit of a validator with the withdrawal prefix
+func ValidateDepositAmount(prefix byte, amount phase0.Gwei) error {
+	maxAmount, err := MaxDepositAmount(prefix)
+	if err != nil {
+		return err
+	}
+	if amount < MinDepositAmountInGwei || amount > maxAmount {
+		return fmt.Errorf("deposit amount %d is out of range [%d, %d] for withdrawal prefix %#x", amount, MinDepositAmountInGwei, maxAmount, prefix)
+	}
+	return nil
+}
+
 func ComputeDepositMessageSigningRoot(network e2m_core.Network, message *phase0.DepositMessage) (phase0.Root, error) {
-	if !e2m_deposit.IsSupportedDepositNetwork(network) {
+	if _, custom := utils.CustomNetwork(network); !custom && !e2m_deposit.IsSupportedDepositNetwork(network) {
 		return phase0.Root{}, fmt.Errorf("network %s is not supported", network)
 	}
 	if len(message.WithdrawalCredentials) != 32 {
@@ -56,7 +103,7 @@ func ComputeDepositMessageSigningRoot(network e2m_core.Network, message *phase0.
 	if err != nil {
 		return phase0.Root{}, fmt.Errorf("failed to determine the root hash of deposit data: %s", err)
 	}
-	genesisForkVersion := network.GenesisForkVersion()
+	genesisForkVersion := utils.GenesisForkVersion(network)
 	domain, err := types.ComputeDomain(types.DomainDeposit, genesisForkVersion[:], types.ZeroGenesisValidatorsRoot)
 	if err != nil {
 		return phase0.Root{}, fmt.Errorf("failed to calculate domain: %s", err)
@@ -125,3 +172,69 @@ func VerifyDepositData(network e2m_core.Network, depositData *phase0.DepositData
 	}
 	return nil
 }
+
+// ExitForkVersion returns the fork version for voluntary exit signatures. Since Deneb (EIP-7044)
+// voluntary exits are always signed with the Capella fork version of the network.
+func ExitForkVersion(network e2m_core.Network) (phase0.Version, error) {
+	switch network {
+	case e2m_core.MainNetwork:
+		return phase0.Version{0x03, 0x00, 0x00, 0x00}, nil
+	case e2m_core.PraterNetwork:
+		return phase0.Version{0x03, 0x00, 0x10, 0x20}, nil
+	case e2m_core.HoleskyNetwork:
+		return phase0.Version{0x04, 0x01, 0x70, 0x00}, nil
+	default:
+		if cfg, ok := utils.CustomNetwork(network); ok && len(cfg.CapellaForkVersion) != 0 {
+			return phase0.Version(cfg.CapellaForkVersion), nil
+		}
+		return phase0.Version{}, fmt.Errorf("network %s is not supported", network)
+	}
+}
+
+// ComputeVoluntaryExitSigningRoot computes signing root of the voluntary exit message at the network
+func ComputeVoluntaryExitSigningRoot(network e2m_core.Network, message *phase0.VoluntaryExit) (phase0.Root, error) {
+	forkVersion, err := ExitForkVersion(network)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	exitRoot, err := message.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, fmt.Errorf("failed to determine the root hash of voluntary exit: %s", err)
+	}
+	genesisValidatorsRoot := utils.GenesisValidatorsRoot(network)
+	domain, err := types.ComputeDomain(types.DomainVoluntaryExit, forkVersion[:], genesisValidatorsRoot[:])
+	if err != nil {
+		return phase0.Root{}, fmt.Errorf("failed to calculate domain: %s", err)
+	}
+	container := &phase0.SigningData{
+		ObjectRoot: exitRoot,
+		Domain:     phase0.Domain(domain),
+	}
+	signingRoot, err := container.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, fmt.Errorf("failed to determine the root hash of signing container: %s", err)
+	}
+	return signingRoot, nil
+}
+
+// VerifyVoluntaryExit checks BLS signature of the signed voluntary exit by the validator public key
+func VerifyVoluntaryExit(network e2m_core.Network, validatorPubKey []byte, signedExit *phase0.SignedVoluntaryExit) error {
+	signingRoot, err := ComputeVoluntaryExitSigningRoot(network, signedExit.Message)
+	if err != nil {
+		return fmt.Errorf("failed to compute signing root: %s", err)
+	}
+	pubkey, err := types.BLSPublicKeyFromBytes(validatorPubKey)
+	if err != nil {
+		return fmt.Errorf("failed to parse public key: %s", err)
+	}
+	sigCpy := make([]byte, len(signedExit.Signature))
+	copy(sigCpy, signedExit.Signature[:])
+	sig, err := types.BLSSignatureFromBytes(sigCpy)
+	if err != nil {
+		return fmt.Errorf("failed to parse signature: %s", err)
+	}
+	if !sig.Verify(signingRoot[:], pubkey) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/drand/kyber"
 	"github.com/drand/kyber/share"
 	drand_dkg "github.com/drand/kyber/share/dkg"
 	"github.com/ethereum/go-ethereum/common"
@@ -26,6 +27,10 @@ const (
 	SignatureLength = 256
 	// MaxEffectiveBalanceInGwei is the max effective balance
 	MaxEffectiveBalanceInGwei phase0.Gwei = 32000000000
+	// MaxEffectiveBalanceElectraInGwei is the max effective balance of compounding validators
+	MaxEffectiveBalanceElectraInGwei phase0.Gwei = 2048000000000
+	// MinDepositAmountInGwei is the min deposit amount
+	MinDepositAmountInGwei phase0.Gwei = 1000000000
 )
 
 func init() {
@@ -71,6 +76,152 @@ func ResultToValidatorPK(result *drand_dkg.DistKeyShare, suite drand_dkg.Suite)
 	return pk, nil
 }
 
+// ShareSecretKeyToPriShare converts github.com/herumi/bls-eth-go-binary/bls private key of an operator to a kyber private share.
+// Share index is the operator ID - 1, the same index operators use as DKG nodes
+func ShareSecretKeyToPriShare(id uint64, sk *bls.SecretKey, suite drand_dkg.Suite) (*share.PriShare, error) {
+	v := suite.Scalar()
+	if err := v.UnmarshalBinary(sk.Serialize()); err != nil {
+		return nil, err
+	}
+	return &share.PriShare{I: int(id - 1), V: v}, nil
+}
+
+// SharePubKeysToCommits recovers coefficients of the public polynomial of a distributed key from operators share public keys
+func SharePubKeysToCommits(ids []uint64, sharePks []*bls.PublicKey, t int, suite drand_dkg.Suite) ([]kyber.Point, error) {
+	pubShares, err := sharePubKeysToPubShares(ids, sharePks, suite)
+	if err != nil {
+		return nil, err
+	}
+	return pubSharesToCommits(pubShares, t, suite)
+}
+
+// RecoverCommits recovers the public polynomial of the lowest degree all share public keys of operators are on,
+// the number of its coefficients is the threshold of the distributed key
+func RecoverCommits(ids []uint64, sharePks []*bls.PublicKey, suite drand_dkg.Suite) ([]kyber.Point, error) {
+	pubShares, err := sharePubKeysToPubShares(ids, sharePks, suite)
+	if err != nil {
+		return nil, err
+	}
+	for t := 1; t <= len(pubShares); t++ {
+		// shares on a polynomial of t coefficients include the next share, interpolating it from the first t shares
+		// is much cheaper than recovering the polynomial, so that thresholds below the lowest one are skipped fast
+		if t < len(pubShares) {
+			next, err := interpolatePubShares(pubShares[:t], pubShares[t].I, suite)
+			if err != nil {
+				return nil, err
+			}
+			if !next.Equal(pubShares[t].V) {
+				continue
+			}
+		}
+		var commits []kyber.Point
+		commits, err = pubSharesToCommits(pubShares, t, suite)
+		if err == nil {
+			return commits, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to recover public polynomial from share public keys: %w", err)
+}
+
+// sharePubKeysToPubShares converts share public keys of operators to kyber public shares at indexes of operator IDs - 1
+func sharePubKeysToPubShares(ids []uint64, sharePks []*bls.PublicKey, suite drand_dkg.Suite) ([]*share.PubShare, error) {
+	if len(ids) != len(sharePks) {
+		return nil, fmt.Errorf("inconsistent IDs len")
+	}
+	pubShares := make([]*share.PubShare, 0, len(ids))
+	for i, id := range ids {
+		p := suite.Point()
+		if err := p.UnmarshalBinary(sharePks[i].Serialize()); err != nil {
+			return nil, err
+		}
+		pubShares = append(pubShares, &share.PubShare{I: int(id - 1), V: p})
+	}
+	return pubShares, nil
+}
+
+// pubSharesToCommits recovers the public polynomial of t coefficients from public shares
+func pubSharesToCommits(pubShares []*share.PubShare, t int, suite drand_dkg.Suite) ([]kyber.Point, error) {
+	pubPoly, err := share.RecoverPubPoly(suite, pubShares, t, len(pubShares))
+	if err != nil {
+		return nil, err
+	}
+	// every share should be on the recovered polynomial, not only the first t of them
+	for _, s := range pubShares {
+		if !pubPoly.Eval(s.I).V.Equal(s.V) {
+			return nil, fmt.Errorf("share public key of operator %d doesnt match public polynomial", s.I+1)
+		}
+	}
+	_, commits := pubPoly.Info()
+	return commits, nil
+}
+
+// interpolatePubShares evaluates the polynomial through the public shares at the index i by Lagrange interpolation
+func interpolatePubShares(pubShares []*share.PubShare, i int, suite drand_dkg.Suite) (kyber.Point, error) {
+	x := suite.Scalar().SetInt64(int64(i + 1))
+	acc := suite.Point().Null()
+	for j, sj := range pubShares {
+		xj := suite.Scalar().SetInt64(int64(sj.I + 1))
+		num := suite.Scalar().One()
+		den := suite.Scalar().One()
+		for m, sm := range pubShares {
+			if m == j {
+				continue
+			}
+			xm := suite.Scalar().SetInt64(int64(sm.I + 1))
+			num.Mul(num, suite.Scalar().Sub(x, xm))
+			den.Mul(den, suite.Scalar().Sub(xj, xm))
+		}
+		if den.Equal(suite.Scalar().Zero()) {
+			return nil, fmt.Errorf("duplicate share index %d", sj.I)
+		}
+		acc.Add(acc, suite.Point().Mul(num.Div(num, den), sj.V))
+	}
+	return acc, nil
+}
+
+// EncodeCommits concatenates compressed points of the public polynomial coefficients
+func EncodeCommits(commits []kyber.Point) ([]byte, error) {
+	var data []byte
+	for _, c := range commits {
+		b, err := c.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b...)
+	}
+	return data, nil
+}
+
+// DecodeCommits decodes coefficients of the public polynomial encoded by EncodeCommits
+func DecodeCommits(data []byte, suite drand_dkg.Suite) ([]kyber.Point, error) {
+	size := suite.Point().MarshalSize()
+	if len(data) == 0 || len(data)%size != 0 {
+		return nil, fmt.Errorf("invalid commits length %d", len(data))
+	}
+	commits := make([]kyber.Point, 0, len(data)/size)
+	for i := 0; i < len(data); i += size {
+		p := suite.Point()
+		if err := p.UnmarshalBinary(data[i : i+size]); err != nil {
+			return nil, err
+		}
+		commits = append(commits, p)
+	}
+	return commits, nil
+}
+
+// VerifySharePubKey checks that the share public key of the operator is the public polynomial evaluated at the operator index
+func VerifySharePubKey(commits []kyber.Point, id uint64, sharePk *bls.PublicKey, suite drand_dkg.Suite) error {
+	p := suite.Point()
+	if err := p.UnmarshalBinary(sharePk.Serialize()); err != nil {
+		return err
+	}
+	pubPoly := share.NewPubPoly(suite, suite.Point().Base(), commits)
+	if !pubPoly.Eval(int(id - 1)).V.Equal(p) {
+		return fmt.Errorf("share public key of operator %d doesnt match public polynomial", id)
+	}
+	return nil
+}
+
 // VerifyOwnerNonceSignature check that owner + nonce correctly signed
 func VerifyOwnerNonceSignature(sig []byte, owner common.Address, pubKey []byte, nonce uint16) error {
 	data := fmt.Sprintf("%s:%d", owner.String(), nonce)
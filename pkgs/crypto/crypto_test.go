@@ -68,6 +68,25 @@ func testResults(t *testing.T, suite pairing.Suite, thr, n int, results []*dkg.R
 		expShare := suite.G1().Point().Mul(share.V, nil)
 		require.True(t, pubShare.V.Equal(expShare), "share %s give pub %s vs exp %s", share.V.String(), pubShare.V.String(), expShare.String())
 	}
+	// test if commits recovered from share public keys match commits of the result
+	encoded, err := EncodeCommits(results[0].Key.Commitments())
+	require.NoError(t, err)
+	commits, err := DecodeCommits(encoded, suite.G1().(dkg.Suite))
+	require.NoError(t, err)
+	require.Len(t, commits, thr)
+	var ids []uint64
+	var sharePks []*bls.PublicKey
+	for id, sk := range sharesBLS {
+		require.NoError(t, VerifySharePubKey(commits, id, sk.GetPublicKey(), suite.G1().(dkg.Suite)))
+		ids = append(ids, id)
+		sharePks = append(sharePks, sk.GetPublicKey())
+	}
+	recovered, err := RecoverCommits(ids, sharePks, suite.G1().(dkg.Suite))
+	require.NoError(t, err)
+	require.True(t, share.NewPubPoly(suite.G1(), nil, recovered).Equal(exp))
+	require.Error(t, VerifySharePubKey(commits, ids[0], sharePks[1], suite.G1().(dkg.Suite)))
+	_, err = DecodeCommits(encoded[1:], suite.G1().(dkg.Suite))
+	require.ErrorContains(t, err, "invalid commits length")
 
 	secretPoly, err := share.RecoverPriPoly(suite.G1(), shares, thr, n)
 	coefs := secretPoly.Coefficients()
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/common"
@@ -18,7 +19,7 @@ import (
 func BuildDepositDataCLI(network core.Network, depositData *phase0.DepositData, depositCLIVersion string) (*wire.DepositDataCLI, error) {
 	depositMsg := &phase0.DepositMessage{
 		WithdrawalCredentials: depositData.WithdrawalCredentials,
-		Amount:                MaxEffectiveBalanceInGwei,
+		Amount:                depositData.Amount,
 	}
 	copy(depositMsg.PublicKey[:], depositData.PublicKey[:])
 	depositMsgRoot, err := depositMsg.HashTreeRoot()
@@ -32,14 +33,17 @@ func BuildDepositDataCLI(network core.Network, depositData *phase0.DepositData,
 	}
 
 	// Final checks of prepared deposit data
-	if !(MaxEffectiveBalanceInGwei == depositData.Amount) {
-		return nil, fmt.Errorf("deposit data is invalid. Wrong amount %d", depositData.Amount)
+	if len(depositData.WithdrawalCredentials) != 32 {
+		return nil, fmt.Errorf("deposit data is invalid. Wrong withdrawal credentials length %d", len(depositData.WithdrawalCredentials))
 	}
-	forkbytes := network.GenesisForkVersion()
+	if err := ValidateDepositAmount(depositData.WithdrawalCredentials[0], depositData.Amount); err != nil {
+		return nil, fmt.Errorf("deposit data is invalid. Wrong amount: %w", err)
+	}
+	forkbytes := utils.GenesisForkVersion(network)
 	depositDataJson := &wire.DepositDataCLI{
 		PubKey:                hex.EncodeToString(depositData.PublicKey[:]),
 		WithdrawalCredentials: hex.EncodeToString(depositData.WithdrawalCredentials),
-		Amount:                MaxEffectiveBalanceInGwei,
+		Amount:                depositData.Amount,
 		Signature:             hex.EncodeToString(depositData.Signature[:]),
 		DepositMessageRoot:    hex.EncodeToString(depositMsgRoot[:]),
 		DepositDataRoot:       hex.EncodeToString(depositDataRoot[:]),
@@ -50,15 +54,41 @@ func BuildDepositDataCLI(network core.Network, depositData *phase0.DepositData,
 	return depositDataJson, nil
 }
 
+// ValidateDepositDataCLI validates deposit data json withdrawing to the address,
+// both 0x01 and 0x02 compounding withdrawal credentials of the address are accepted
 func ValidateDepositDataCLI(d *wire.DepositDataCLI, expectedWithdrawalAddress common.Address) error {
-	return validateDepositDataCLI(d, ETH1WithdrawalCredentials(expectedWithdrawalAddress.Bytes()))
+	return validateDepositDataCLI(d, 0,
+		ETH1WithdrawalCredentials(expectedWithdrawalAddress.Bytes()),
+		WithdrawalCredentials(CompoundingWithdrawalPrefixByte, expectedWithdrawalAddress.Bytes()))
+}
+
+// ValidateDepositDataCLIWithCredentials validates deposit data json with the exact withdrawal credentials and deposit amount
+func ValidateDepositDataCLIWithCredentials(d *wire.DepositDataCLI, expectedWithdrawalCredentials []byte, expectedAmount phase0.Gwei) error {
+	return validateDepositDataCLI(d, expectedAmount, expectedWithdrawalCredentials)
 }
 
 func ValidateDepositDataCLIBLS(d *wire.DepositDataCLI, expectedWithdrawalPubKey []byte) error {
-	return validateDepositDataCLI(d, BLSWithdrawalCredentials(expectedWithdrawalPubKey))
+	return validateDepositDataCLI(d, 0, BLSWithdrawalCredentials(expectedWithdrawalPubKey))
+}
+
+// ValidateDepositDataCLIWithdrawal validates deposit data json withdrawing to the withdrawal address (20 bytes),
+// BLS withdrawal public key (48 bytes) or with the exact withdrawal credentials (32 bytes)
+func ValidateDepositDataCLIWithdrawal(d *wire.DepositDataCLI, expectedWithdrawal []byte) error {
+	switch len(expectedWithdrawal) {
+	case common.AddressLength:
+		return ValidateDepositDataCLI(d, common.BytesToAddress(expectedWithdrawal))
+	case phase0.PublicKeyLength:
+		return ValidateDepositDataCLIBLS(d, expectedWithdrawal)
+	case 32:
+		return validateDepositDataCLI(d, 0, expectedWithdrawal)
+	default:
+		return fmt.Errorf("invalid withdrawal length %d", len(expectedWithdrawal))
+	}
 }
 
-func validateDepositDataCLI(d *wire.DepositDataCLI, expectedWithdrawalCredentials []byte) error {
+// validateDepositDataCLI validates deposit data json with one of the expected withdrawal credentials,
+// expected amount is checked if it isnt zero
+func validateDepositDataCLI(d *wire.DepositDataCLI, expectedAmount phase0.Gwei, expectedWithdrawalCredentials ...[]byte) error {
 	// Re-encode and re-decode the deposit data json to ensure encoding is valid.
 	b, err := json.Marshal(d)
 	if err != nil {
@@ -82,8 +112,12 @@ func validateDepositDataCLI(d *wire.DepositDataCLI, expectedWithdrawalCredential
 		return fmt.Errorf("failed to verify deposit roots: %v", err)
 	}
 	// 3. Verify withdrawal address
-	if d.WithdrawalCredentials != hex.EncodeToString(expectedWithdrawalCredentials) {
-		return fmt.Errorf("failed to verify withdrawal address (%s != %x)", d.WithdrawalCredentials, expectedWithdrawalCredentials)
+	if !slices.ContainsFunc(expectedWithdrawalCredentials, func(creds []byte) bool { return d.WithdrawalCredentials == hex.EncodeToString(creds) }) {
+		return fmt.Errorf("failed to verify withdrawal address (%s != %x)", d.WithdrawalCredentials, expectedWithdrawalCredentials[0])
+	}
+	// 4. Verify deposit amount
+	if expectedAmount != 0 && d.Amount != expectedAmount {
+		return fmt.Errorf("failed to verify deposit amount (%d != %d)", d.Amount, expectedAmount)
 	}
 	return nil
 }
@@ -120,9 +154,13 @@ func validateFieldFormatting(d *wire.DepositDataCLI) error {
 		len(d.ForkVersion) != 8 {
 		return fmt.Errorf("resulting deposit data json has wrong fields length")
 	}
-	// check the deposit amount
-	if d.Amount != 32000000000 {
-		return fmt.Errorf("resulting deposit data json has wrong amount")
+	// check the deposit amount is valid for the withdrawal credentials type
+	prefix, err := hex.DecodeString(d.WithdrawalCredentials[:2])
+	if err != nil {
+		return fmt.Errorf("failed to decode withdrawal credentials: %v", err)
+	}
+	if err := ValidateDepositAmount(prefix[0], d.Amount); err != nil {
+		return fmt.Errorf("resulting deposit data json has wrong amount: %v", err)
 	}
 	v, err := version.NewVersion(d.DepositCliVersion)
 	if err != nil {
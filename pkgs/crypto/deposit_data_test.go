@@ -0,0 +1,115 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+func TestDepositDataWithdrawalCredentials(t *testing.T) {
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	sk := &bls.SecretKey{}
+	sk.SetByCSPRNG()
+	depositData := func(prefix byte, amount phase0.Gwei) (*phase0.DepositData, error) {
+		msg := &phase0.DepositMessage{
+			WithdrawalCredentials: WithdrawalCredentials(prefix, withdraw.Bytes()),
+			Amount:                amount,
+		}
+		copy(msg.PublicKey[:], sk.GetPublicKey().Serialize())
+		return SignDepositMessage(core.HoleskyNetwork, sk, msg)
+	}
+	t.Run("test compounding validator with custom amount", func(t *testing.T) {
+		deposit, err := depositData(CompoundingWithdrawalPrefixByte, 100000000000)
+		require.NoError(t, err)
+		depositCLI, err := BuildDepositDataCLI(core.HoleskyNetwork, deposit, wire.DepositCliVersion)
+		require.NoError(t, err)
+		require.Equal(t, phase0.Gwei(100000000000), depositCLI.Amount)
+		require.Equal(t, "02", depositCLI.WithdrawalCredentials[:2])
+		require.NoError(t, ValidateDepositDataCLI(depositCLI, withdraw))
+		require.NoError(t, ValidateDepositDataCLIWithCredentials(depositCLI, WithdrawalCredentials(CompoundingWithdrawalPrefixByte, withdraw.Bytes()), 100000000000))
+		err = ValidateDepositDataCLIWithCredentials(depositCLI, WithdrawalCredentials(CompoundingWithdrawalPrefixByte, withdraw.Bytes()), MaxEffectiveBalanceInGwei)
+		require.ErrorContains(t, err, "failed to verify deposit amount")
+		err = ValidateDepositDataCLIWithCredentials(depositCLI, ETH1WithdrawalCredentials(withdraw.Bytes()), 100000000000)
+		require.ErrorContains(t, err, "failed to verify withdrawal address")
+	})
+	t.Run("test 0x01 validator with partial deposit", func(t *testing.T) {
+		deposit, err := depositData(ETH1WithdrawalPrefixByte, 1000000000)
+		require.NoError(t, err)
+		depositCLI, err := BuildDepositDataCLI(core.HoleskyNetwork, deposit, wire.DepositCliVersion)
+		require.NoError(t, err)
+		require.NoError(t, ValidateDepositDataCLI(depositCLI, withdraw))
+	})
+	t.Run("test 0x01 validator above max effective balance", func(t *testing.T) {
+		deposit, err := depositData(ETH1WithdrawalPrefixByte, 64000000000)
+		require.NoError(t, err)
+		_, err = BuildDepositDataCLI(core.HoleskyNetwork, deposit, wire.DepositCliVersion)
+		require.ErrorContains(t, err, "deposit data is invalid. Wrong amount")
+	})
+	t.Run("test compounding validator above max effective balance", func(t *testing.T) {
+		deposit, err := depositData(CompoundingWithdrawalPrefixByte, 2049000000000)
+		require.NoError(t, err)
+		_, err = BuildDepositDataCLI(core.HoleskyNetwork, deposit, wire.DepositCliVersion)
+		require.ErrorContains(t, err, "deposit data is invalid. Wrong amount")
+	})
+	t.Run("test BLS withdrawal credentials", func(t *testing.T) {
+		withdrawSK := &bls.SecretKey{}
+		withdrawSK.SetByCSPRNG()
+		withdrawPubKey := withdrawSK.GetPublicKey().Serialize()
+		msg := &phase0.DepositMessage{
+			WithdrawalCredentials: BLSWithdrawalCredentials(withdrawPubKey),
+			Amount:                MaxEffectiveBalanceInGwei,
+		}
+		copy(msg.PublicKey[:], sk.GetPublicKey().Serialize())
+		deposit, err := SignDepositMessage(core.HoleskyNetwork, sk, msg)
+		require.NoError(t, err)
+		depositCLI, err := BuildDepositDataCLI(core.HoleskyNetwork, deposit, wire.DepositCliVersion)
+		require.NoError(t, err)
+		require.Equal(t, "00", depositCLI.WithdrawalCredentials[:2])
+		require.NoError(t, ValidateDepositDataCLIWithdrawal(depositCLI, withdrawPubKey))
+		require.NoError(t, ValidateDepositDataCLIWithdrawal(depositCLI, BLSWithdrawalCredentials(withdrawPubKey)))
+		require.ErrorContains(t, ValidateDepositDataCLIWithdrawal(depositCLI, withdraw.Bytes()), "failed to verify withdrawal address")
+		require.ErrorContains(t, ValidateDepositDataCLIWithdrawal(depositCLI, withdrawPubKey[:10]), "invalid withdrawal length 10")
+	})
+	t.Run("test tampered amount", func(t *testing.T) {
+		deposit, err := depositData(CompoundingWithdrawalPrefixByte, 100000000000)
+		require.NoError(t, err)
+		depositCLI, err := BuildDepositDataCLI(core.HoleskyNetwork, deposit, wire.DepositCliVersion)
+		require.NoError(t, err)
+		depositCLI.Amount = 200000000000
+		require.ErrorContains(t, ValidateDepositDataCLI(depositCLI, withdraw), "failed to verify deposit roots")
+	})
+}
+
+func TestDepositDataCustomNetwork(t *testing.T) {
+	require.NoError(t, utils.RegisterNetwork(&utils.NetworkConfig{
+		Name:                  "crypto-devnet",
+		GenesisForkVersion:    []byte{0x10, 0x00, 0x00, 0x50},
+		GenesisValidatorsRoot: make([]byte, 32),
+	}))
+	network, err := utils.GetNetworkByName("crypto-devnet")
+	require.NoError(t, err)
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	sk := &bls.SecretKey{}
+	sk.SetByCSPRNG()
+	msg := &phase0.DepositMessage{
+		WithdrawalCredentials: ETH1WithdrawalCredentials(withdraw.Bytes()),
+		Amount:                MaxEffectiveBalanceInGwei,
+	}
+	copy(msg.PublicKey[:], sk.GetPublicKey().Serialize())
+	deposit, err := SignDepositMessage(network, sk, msg)
+	require.NoError(t, err)
+	depositCLI, err := BuildDepositDataCLI(network, deposit, wire.DepositCliVersion)
+	require.NoError(t, err)
+	require.Equal(t, "10000050", depositCLI.ForkVersion)
+	require.Equal(t, "crypto-devnet", depositCLI.NetworkName)
+	require.NoError(t, ValidateDepositDataCLI(depositCLI, withdraw))
+	// deposit signed for the devnet isnt valid at mainnet
+	require.ErrorIs(t, VerifyDepositData(core.MainNetwork, deposit), ErrInvalidSignature)
+}
@@ -56,7 +56,11 @@ func ParseRSAPublicKey(pk []byte) (*rsa.PublicKey, error) {
 	if err != nil {
 		return nil, err
 	}
-	return pbkey.(*rsa.PublicKey), nil
+	rsaKey, ok := pbkey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+	return rsaKey, nil
 }
 
 func EncodeRSAPublicKey(pk *rsa.PublicKey) ([]byte, error) {
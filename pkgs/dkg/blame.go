@@ -0,0 +1,131 @@
+package dkg
+
+import (
+	"sort"
+
+	"github.com/drand/kyber/share"
+	kyber_dkg "github.com/drand/kyber/share/dkg"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// blame names operators which caused the kyber protocol to fail by bundles received by the operator: dealers which
+// deal bundles are missing, share holders which response bundles are missing and dealers which didnt justify
+// complaints against their deals. The phase of the blame is the earliest phase with offenders.
+// Response bundles with complaints against blamed dealers and their justification bundles are the evidence,
+// bundles are signed by DKG keys of operators sent at exchange messages.
+func (o *LocalOwner) blame(err error) (*wire.Blame, error) {
+	cfg := o.data.dkgConfig
+	blame := &wire.Blame{OperatorID: o.ID, Reason: err.Error()}
+	o.bundlesMtx.Lock()
+	defer o.bundlesMtx.Unlock()
+	// kyber ignores bundles with invalid signatures
+	valid := func(phase kyber_dkg.Phase, index kyber_dkg.Index) (kyber_dkg.Packet, bool) {
+		bundle, ok := o.bundles[phase][uint32(index)]
+		if !ok || kyber_dkg.VerifyPacketSignature(cfg, bundle) != nil {
+			return nil, false
+		}
+		return bundle, true
+	}
+	missing := func(phase kyber_dkg.Phase, nodes []kyber_dkg.Node) []uint64 {
+		var ids []uint64
+		for _, n := range nodes {
+			if _, ok := valid(phase, n.Index); !ok {
+				ids = append(ids, uint64(n.Index)+1)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		return ids
+	}
+	if ids := missing(kyber_dkg.DealPhase, cfg.OldNodes); len(ids) != 0 {
+		blame.Phase = kyber_dkg.DealPhase.String()
+		blame.Offenders = ids
+		return blame, nil
+	}
+	if ids := missing(kyber_dkg.ResponsePhase, cfg.NewNodes); len(ids) != 0 {
+		blame.Phase = kyber_dkg.ResponsePhase.String()
+		blame.Offenders = ids
+		return blame, nil
+	}
+	blame.Phase = kyber_dkg.JustifPhase.String()
+	complaints := make(map[uint32][]*kyber_dkg.ResponseBundle)
+	for _, n := range cfg.NewNodes {
+		bundle, _ := valid(kyber_dkg.ResponsePhase, n.Index)
+		responses := bundle.(*kyber_dkg.ResponseBundle)
+		for _, r := range responses.Responses {
+			if r.Status == kyber_dkg.Complaint {
+				complaints[r.DealerIndex] = append(complaints[r.DealerIndex], responses)
+			}
+		}
+	}
+	dealers := make([]uint32, 0, len(complaints))
+	for dealer := range complaints {
+		dealers = append(dealers, dealer)
+	}
+	sort.Slice(dealers, func(i, j int) bool { return dealers[i] < dealers[j] })
+	evidence := make(map[kyber_dkg.Phase]map[uint32]bool)
+	addEvidence := func(phase kyber_dkg.Phase, bundle kyber_dkg.Packet) error {
+		index := uint32(bundle.Index())
+		if evidence[phase][index] {
+			return nil
+		}
+		if evidence[phase] == nil {
+			evidence[phase] = make(map[uint32]bool)
+		}
+		evidence[phase][index] = true
+		var msgType wire.TransportType
+		var msg []byte
+		var err error
+		switch b := bundle.(type) {
+		case *kyber_dkg.ResponseBundle:
+			msgType = wire.KyberResponseBundleMessageType
+			msg, err = wire.EncodeResponseBundle(b)
+		case *kyber_dkg.JustificationBundle:
+			msgType = wire.KyberJustificationBundleMessageType
+			msg, err = wire.EncodeJustificationBundle(b)
+		}
+		if err != nil {
+			return err
+		}
+		blame.Evidence = append(blame.Evidence, &wire.Evidence{OperatorID: uint64(index) + 1, Type: msgType.String(), Message: msg})
+		return nil
+	}
+	for _, dealer := range dealers {
+		deal, ok := valid(kyber_dkg.DealPhase, kyber_dkg.Index(dealer))
+		if !ok {
+			// complaint against an operator which isnt a dealer
+			continue
+		}
+		public := share.NewPubPoly(cfg.Suite, cfg.Suite.Point().Base(), deal.(*kyber_dkg.DealBundle).Public)
+		resolved := make(map[uint32]bool)
+		justification, justified := valid(kyber_dkg.JustifPhase, kyber_dkg.Index(dealer))
+		if justified {
+			for _, j := range justification.(*kyber_dkg.JustificationBundle).Justifications {
+				if cfg.Suite.Point().Mul(j.Share, nil).Equal(public.Eval(int(j.ShareIndex)).V) {
+					resolved[j.ShareIndex] = true
+				}
+			}
+		}
+		var unresolved []*kyber_dkg.ResponseBundle
+		for _, responses := range complaints[dealer] {
+			if !resolved[responses.ShareIndex] {
+				unresolved = append(unresolved, responses)
+			}
+		}
+		if len(unresolved) == 0 {
+			continue
+		}
+		blame.Offenders = append(blame.Offenders, uint64(dealer)+1)
+		for _, responses := range unresolved {
+			if err := addEvidence(kyber_dkg.ResponsePhase, responses); err != nil {
+				return nil, err
+			}
+		}
+		if justified {
+			if err := addEvidence(kyber_dkg.JustifPhase, justification); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return blame, nil
+}
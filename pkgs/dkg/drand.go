@@ -1,13 +1,17 @@
 package dkg
 
 import (
+	"bytes"
 	"crypto/rsa"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/drand/kyber"
 	"github.com/drand/kyber/pairing"
+	"github.com/drand/kyber/share"
 	kyber_dkg "github.com/drand/kyber/share/dkg"
 	drand_bls "github.com/drand/kyber/sign/bls" //nolint:all
 	"github.com/drand/kyber/util/random"
@@ -32,6 +36,22 @@ type DKGdata struct {
 	init *wire.Init
 	// Randomly generated scalar to be used for DKG ceremony
 	secret kyber.Scalar
+	// reshare message from initiator, nil for a new DKG ceremony
+	reshare *wire.ReshareMessage
+	// Coefficients of the public polynomial of the validator key being reshared
+	oldCommits []kyber.Point
+	// Operator's share of the validator key being reshared, present only at old operators
+	oldShare *share.PriShare
+	// Config of the kyber protocol, set when the protocol starts
+	dkgConfig *kyber_dkg.Config
+}
+
+// operators returns all operators participating in the ceremony
+func (d *DKGdata) operators() []*wire.Operator {
+	if d.reshare != nil {
+		return spec.ReshareOperators(&d.reshare.SignedReshare.Reshare)
+	}
+	return d.init.Operators
 }
 
 // OwnerOpts structure to pass parameters from Switch to LocalOwner structure
@@ -48,6 +68,9 @@ type OwnerOpts struct {
 	Owner              [20]byte
 	Nonce              uint64
 	Version            []byte
+	StoreShareF        func(reqID [24]byte, nonce uint64, proof *wire.SignedProof) error    // optional, persists the operator's share after a ceremony
+	LoadShareF         func(validatorPubKey, sharePubKey []byte) (*wire.SignedProof, error) // optional, loads the operator's persisted share, proofs sent by initiator are used if not set or the share is missing
+	Phaser             wire.PhaserConfig                                                    // phaser of the kyber protocol, event phaser by default
 }
 
 var ErrAlreadyExists = errors.New("duplicate message")
@@ -63,6 +86,13 @@ type LocalOwner struct {
 	Suite              pairing.Suite
 	broadcastF         func([]byte) error
 	exchanges          map[uint64]*wire.Exchange
+	statusMtx          sync.RWMutex // protects exchanges and lastErr read by Status
+	lastErr            error
+	bundlesMtx         sync.Mutex                                      // protects received bundles
+	bundles            map[kyber_dkg.Phase]map[uint32]kyber_dkg.Packet // received bundles by phase and operator index
+	responses          map[uint32]*kyber_dkg.ResponseBundle            // relayed response bundles by share holder index
+	phaserConfig       wire.PhaserConfig
+	phaser             wire.Phaser
 	signer             spec.Signer
 	encryptFunc        func([]byte) ([]byte, error)
 	decryptFunc        func([]byte) ([]byte, error)
@@ -70,6 +100,8 @@ type LocalOwner struct {
 	OperatorPublicKey  *rsa.PublicKey
 	done               chan struct{}
 	version            []byte
+	storeShareF        func(reqID [24]byte, nonce uint64, proof *wire.SignedProof) error
+	loadShareF         func(validatorPubKey, sharePubKey []byte) (*wire.SignedProof, error)
 }
 
 // New creates a LocalOwner structure. We create it for each new DKG ceremony.
@@ -81,6 +113,9 @@ func New(opts *OwnerOpts) *LocalOwner {
 		ID:                 opts.ID,
 		broadcastF:         opts.BroadcastF,
 		exchanges:          make(map[uint64]*wire.Exchange),
+		bundles:            make(map[kyber_dkg.Phase]map[uint32]kyber_dkg.Packet),
+		responses:          make(map[uint32]*kyber_dkg.ResponseBundle),
+		phaserConfig:       opts.Phaser,
 		signer:             opts.Signer,
 		encryptFunc:        opts.EncryptFunc,
 		decryptFunc:        opts.DecryptFunc,
@@ -89,6 +124,8 @@ func New(opts *OwnerOpts) *LocalOwner {
 		done:               make(chan struct{}, 1),
 		Suite:              opts.Suite,
 		version:            opts.Version,
+		storeShareF:        opts.StoreShareF,
+		loadShareF:         opts.LoadShareF,
 	}
 	return owner
 }
@@ -120,7 +157,9 @@ func (o *LocalOwner) StartDKG() error {
 		Threshold: int(o.data.init.T),
 		Auth:      drand_bls.NewSchemeOnG2(o.Suite),
 	}
-	p, err := wire.NewDKGProtocol(dkgConfig, o.board, logger)
+	o.data.dkgConfig = dkgConfig
+	o.phaser = wire.NewPhaser(o.phaserConfig)
+	p, err := wire.NewDKGProtocol(dkgConfig, o.board, o.phaser, logger)
 	if err != nil {
 		return err
 	}
@@ -129,13 +168,77 @@ func (o *LocalOwner) StartDKG() error {
 		res := <-p.WaitEnd()
 		if err := postF(&res); err != nil {
 			o.Logger.Error("Error in PostDKG function", zap.Error(err))
-			o.broadcastError(fmt.Errorf("operator ID:%d, err:%w", o.ID, err))
+			o.broadcastFailure(&res, fmt.Errorf("operator ID:%d, err:%w", o.ID, err))
 		}
 	}(p, o.PostDKG)
 	close(o.startedDKG)
 	return nil
 }
 
+// StartReshare initializes and starts resharing protocol. Old operators deal shares of their key share, new operators receive them.
+func (o *LocalOwner) StartReshare() error {
+	o.Logger.Info("Starting resharing")
+	reshare := o.data.reshare.SignedReshare.Reshare
+	oldNodes, err := o.GetDKGNodes(reshare.OldOperators)
+	if err != nil {
+		return err
+	}
+	newNodes, err := o.GetDKGNodes(reshare.NewOperators)
+	if err != nil {
+		return err
+	}
+	logger := o.Logger.With(zap.Uint64("ID", o.ID))
+	dkgConfig := &kyber_dkg.Config{
+		Longterm:     o.data.secret,
+		Nonce:        utils.GetNonce(o.data.reqID[:]),
+		Suite:        o.Suite.G1().(kyber_dkg.Suite),
+		NewNodes:     newNodes,
+		OldNodes:     oldNodes,
+		Threshold:    int(reshare.NewT),
+		OldThreshold: int(reshare.OldT),
+		Auth:         drand_bls.NewSchemeOnG2(o.Suite),
+	}
+	if o.data.oldShare != nil {
+		dkgConfig.Share = &kyber_dkg.DistKeyShare{
+			Commits: o.data.oldCommits,
+			Share:   o.data.oldShare,
+		}
+	} else {
+		// new operators verify deals against the public polynomial of the validator key
+		dkgConfig.PublicCoeffs = o.data.oldCommits
+	}
+	o.data.dkgConfig = dkgConfig
+	o.phaser = wire.NewPhaser(o.phaserConfig)
+	p, err := wire.NewDKGProtocol(dkgConfig, o.board, o.phaser, logger)
+	if err != nil {
+		return err
+	}
+	isNew := spec.GetOperator(reshare.NewOperators, o.ID) != nil
+	go func(p *kyber_dkg.Protocol) {
+		res := <-p.WaitEnd()
+		if !isNew {
+			// old operators which leave the cluster are done after dealing
+			o.Logger.Info("Resharing finished for leaving operator")
+			close(o.done)
+			return
+		}
+		if err := o.PostReshare(&res); err != nil {
+			o.Logger.Error("Error in PostReshare function", zap.Error(err))
+			o.broadcastFailure(&res, fmt.Errorf("operator ID:%d, err:%w", o.ID, err))
+		}
+	}(p)
+	close(o.startedDKG)
+	if o.data.oldShare == nil {
+		// new operators don't deal, let initiator know that exchange messages are processed
+		return o.Broadcast(&wire.Transport{
+			Type:       wire.ReshareAckMessageType,
+			Identifier: o.data.reqID,
+			Version:    o.version,
+		})
+	}
+	return nil
+}
+
 // Function to send signed messages back to initiator
 func (o *LocalOwner) Broadcast(ts *wire.Transport) error {
 	bts, err := ts.MarshalSSZ()
@@ -177,8 +280,37 @@ func (o *LocalOwner) PostDKG(res *kyber_dkg.OptionResult) error {
 	if err != nil {
 		return fmt.Errorf("failed to get validator BLS public key: %w", err)
 	}
+	init := o.data.init
+	return o.postResult(res.Result.Key, validatorPubKey, init.Owner, init.Nonce, spec.InitWithdrawalCredentials(init), spec.InitDepositAmount(init), init.Fork)
+}
+
+// PostReshare checks that the new key share belongs to the reshared validator key
+// and creates the Result structure to send back to initiator
+func (o *LocalOwner) PostReshare(res *kyber_dkg.OptionResult) error {
+	if res.Error != nil {
+		return fmt.Errorf("resharing protocol failed: %w", res.Error)
+	}
+	o.Logger.Info("Resharing ceremony finished successfully")
+	reshare := o.data.reshare.SignedReshare.Reshare
+	validatorPubKey, err := crypto.ResultToValidatorPK(res.Result.Key, o.Suite.G1().(kyber_dkg.Suite))
+	if err != nil {
+		return fmt.Errorf("failed to get validator BLS public key: %w", err)
+	}
+	if !bytes.Equal(validatorPubKey.Serialize(), reshare.ValidatorPubKey) {
+		return fmt.Errorf("resharing resulted in a wrong validator public key %x", validatorPubKey.Serialize())
+	}
+	withdrawalCredentials, amount, err := spec.AddressWithdrawalCredentials(o.data.reshare.WithdrawalPrefix, o.data.reshare.Amount, o.data.reshare.WithdrawalCredentials)
+	if err != nil {
+		return err
+	}
+	return o.postResult(res.Result.Key, validatorPubKey, reshare.Owner, reshare.Nonce, withdrawalCredentials, amount, o.data.reshare.Fork)
+}
+
+// postResult signs deposit data and owner + nonce with the operator's key share, encrypts the share
+// and broadcasts the resulting signed proof back to initiator
+func (o *LocalOwner) postResult(key *kyber_dkg.DistKeyShare, validatorPubKey *bls.PublicKey, owner [20]byte, nonce uint64, withdrawalCredentials []byte, amount phase0.Gwei, fork [4]byte) error {
 	// Get BLS partial secret key share from DKG
-	secretKeyBLS, err := crypto.ResultToShareSecretKey(res.Result.Key)
+	secretKeyBLS, err := crypto.ResultToShareSecretKey(key)
 	if err != nil {
 		return fmt.Errorf("failed to get BLS partial secret key share: %w", err)
 	}
@@ -187,56 +319,93 @@ func (o *LocalOwner) PostDKG(res *kyber_dkg.OptionResult) error {
 	if err != nil {
 		return fmt.Errorf("failed to encrypt BLS share: %w", err)
 	}
+	commits, err := crypto.EncodeCommits(key.Commitments())
+	if err != nil {
+		return fmt.Errorf("failed to encode public polynomial commits: %w", err)
+	}
+	out, err := o.signResult(secretKeyBLS, encryptedShare, validatorPubKey, commits, owner, nonce, withdrawalCredentials, amount, fork)
+	if err != nil {
+		return err
+	}
+	if err := o.storeShare(nonce, out); err != nil {
+		return err
+	}
+	if err := o.broadcastResult(out); err != nil {
+		o.Logger.Error("failed to broadcast output in PostDKG", zap.Error(err))
+	}
+	close(o.done)
+	return nil
+}
+
+// signResult creates partial signatures of deposit data and owner + nonce and a signed ceremony proof,
+// withdrawal credentials are the full 32 bytes credentials of deposit data. Commits of the public polynomial
+// are sent with results of DKG and resharing, so that initiator can verify share public keys of all operators
+func (o *LocalOwner) signResult(secretKeyBLS *bls.SecretKey, encryptedShare []byte, validatorPubKey *bls.PublicKey, commits []byte, owner [20]byte, nonce uint64, withdrawalCredentials []byte, amount phase0.Gwei, fork [4]byte) (*wire.Result, error) {
 	// Sign root
-	network, err := utils.GetNetworkByFork(o.data.init.Fork)
+	network, err := utils.GetNetworkByFork(fork)
 	if err != nil {
-		return fmt.Errorf("failed to get network by fork: %w", err)
+		return nil, fmt.Errorf("failed to get network by fork: %w", err)
 	}
 	signingRoot, err := crypto.ComputeDepositMessageSigningRoot(network, &phase0.DepositMessage{
 		PublicKey:             phase0.BLSPubKey(validatorPubKey.Serialize()),
-		WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(o.data.init.WithdrawalCredentials),
-		Amount:                crypto.MaxEffectiveBalanceInGwei,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                amount,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to generate deposit data with root %w", err)
+		return nil, fmt.Errorf("failed to generate deposit data with root %w", err)
 	}
 	// Sign.
 	depositPartialSignature := secretKeyBLS.SignByte(signingRoot[:])
 	if depositPartialSignature == nil {
-		return fmt.Errorf("failed to sign deposit data with partial signature %w", err)
+		return nil, fmt.Errorf("failed to sign deposit data with partial signature %w", err)
 	}
 	// Validate partial signature
 	if val := depositPartialSignature.VerifyByte(secretKeyBLS.GetPublicKey(), signingRoot[:]); !val {
-		err = fmt.Errorf("partial deposit root signature is not valid %x", depositPartialSignature.Serialize())
-		return err
+		return nil, fmt.Errorf("partial deposit root signature is not valid %x", depositPartialSignature.Serialize())
 	}
 	// Sign SSV owner + nonce
-	data := []byte(fmt.Sprintf("%s:%d", eth_common.Address(o.data.init.Owner).String(), o.data.init.Nonce))
+	data := []byte(fmt.Sprintf("%s:%d", eth_common.Address(owner).String(), nonce))
 	hash := eth_crypto.Keccak256([]byte(data))
 	sigOwnerNonce := secretKeyBLS.SignByte(hash)
 	// Verify partial SSV owner + nonce signature
 	val := sigOwnerNonce.VerifyByte(secretKeyBLS.GetPublicKey(), hash)
 	if !val {
-		return fmt.Errorf("partial owner + nonce signature isnt valid %x", sigOwnerNonce.Serialize())
+		return nil, fmt.Errorf("partial owner + nonce signature isnt valid %x", sigOwnerNonce.Serialize())
 	}
 	// Generate and sign proof
 	proof := &wire.Proof{
 		ValidatorPubKey: validatorPubKey.Serialize(),
 		EncryptedShare:  encryptedShare,
 		SharePubKey:     secretKeyBLS.GetPublicKey().Serialize(),
-		Owner:           o.data.init.Owner,
+		Owner:           owner,
 	}
 	signedProof, err := spec.SignCeremonyProof(o.signer, proof)
 	if err != nil {
-		return fmt.Errorf("failed to sign proof: %w", err)
+		return nil, fmt.Errorf("failed to sign proof: %w", err)
 	}
-	out := &wire.Result{
+	return &wire.Result{
 		RequestID:                  o.data.reqID,
 		DepositPartialSignature:    depositPartialSignature.Serialize(),
 		OperatorID:                 o.ID,
 		OwnerNoncePartialSignature: sigOwnerNonce.Serialize(),
 		SignedProof:                *signedProof,
+		Commits:                    commits,
+	}, nil
+}
+
+// storeShare persists the signed proof with the encrypted share, so the operator can find its share later
+func (o *LocalOwner) storeShare(nonce uint64, out *wire.Result) error {
+	if o.storeShareF == nil {
+		return nil
+	}
+	if err := o.storeShareF(o.data.reqID, nonce, &out.SignedProof); err != nil {
+		return fmt.Errorf("failed to store key share: %w", err)
 	}
+	return nil
+}
+
+// broadcastResult sends the result of the ceremony back to initiator
+func (o *LocalOwner) broadcastResult(out *wire.Result) error {
 	encodedOutput, err := out.MarshalSSZ()
 	if err != nil {
 		return fmt.Errorf("failed to encode output: %w", err)
@@ -247,11 +416,115 @@ func (o *LocalOwner) PostDKG(res *kyber_dkg.OptionResult) error {
 		Data:       encodedOutput,
 		Version:    o.version,
 	}
-	if err := o.Broadcast(tsMsg); err != nil {
-		o.Logger.Error("failed to broadcast output in PostDKG", zap.Error(err))
+	return o.Broadcast(tsMsg)
+}
+
+// Resign signs a new owner and nonce with the operator's key share of the ceremony proof.
+// The encrypted share at the proof is kept, so the validator key and keyshares data dont change.
+func (o *LocalOwner) Resign(reqID [24]byte, resign *wire.ResignMessage) error {
+	if o.data == nil {
+		o.data = &DKGdata{}
 	}
-	close(o.done)
-	return nil
+	o.data.reqID = reqID
+	r := resign.SignedResign.Resign
+	proof, secretKeyBLS, err := o.proofShare(r.Operators, resign.Proofs)
+	if err != nil {
+		return err
+	}
+	validatorPubKey, err := spec.BLSPKEncode(r.ValidatorPubKey)
+	if err != nil {
+		return fmt.Errorf("failed to decode validator public key: %w", err)
+	}
+	withdrawalCredentials, amount, err := spec.AddressWithdrawalCredentials(resign.WithdrawalPrefix, resign.Amount, resign.WithdrawalCredentials)
+	if err != nil {
+		return err
+	}
+	// commits arent sent, commits recovered from share public keys of the proofs would match by construction
+	out, err := o.signResult(secretKeyBLS, proof.EncryptedShare, validatorPubKey, nil, r.Owner, r.Nonce, withdrawalCredentials, amount, resign.Fork)
+	if err != nil {
+		return err
+	}
+	if err := o.storeShare(r.Nonce, out); err != nil {
+		return err
+	}
+	o.Logger.Info("Signed new owner and nonce with key share", zap.String("owner", eth_common.Address(r.Owner).String()), zap.Uint64("nonce", r.Nonce))
+	return o.broadcastResult(out)
+}
+
+// SignExit signs a voluntary exit of the validator with the operator's key share of the ceremony proof
+// and broadcasts the partial signature back to initiator
+func (o *LocalOwner) SignExit(reqID [24]byte, exit *wire.ExitMessage) error {
+	if o.data == nil {
+		o.data = &DKGdata{}
+	}
+	o.data.reqID = reqID
+	e := exit.SignedExit.Exit
+	_, secretKeyBLS, err := o.proofShare(e.Operators, exit.Proofs)
+	if err != nil {
+		return err
+	}
+	signingRoot, err := spec.ExitSigningRoot(&e)
+	if err != nil {
+		return fmt.Errorf("failed to compute voluntary exit signing root: %w", err)
+	}
+	sig := secretKeyBLS.SignByte(signingRoot[:])
+	if !sig.VerifyByte(secretKeyBLS.GetPublicKey(), signingRoot[:]) {
+		return fmt.Errorf("partial voluntary exit signature isnt valid %x", sig.Serialize())
+	}
+	out := &wire.PartialExit{
+		OperatorID:       o.ID,
+		RequestID:        reqID,
+		PartialSignature: sig.Serialize(),
+	}
+	encodedOutput, err := out.MarshalSSZ()
+	if err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+	o.Logger.Info("Signed voluntary exit with key share", zap.Uint64("validator index", e.ValidatorIndex), zap.Uint64("epoch", e.Epoch))
+	return o.Broadcast(&wire.Transport{
+		Type:       wire.PartialExitMessageType,
+		Identifier: reqID,
+		Data:       encodedOutput,
+		Version:    o.version,
+	})
+}
+
+// proofShare finds the operator's ceremony proof and decrypts the key share of it
+func (o *LocalOwner) proofShare(operators []*wire.Operator, proofs []*wire.SignedProof) (*wire.Proof, *bls.SecretKey, error) {
+	var proof *wire.Proof
+	for i, op := range operators {
+		if op.ID == o.ID && i < len(proofs) {
+			proof = proofs[i].Proof
+			break
+		}
+	}
+	if proof == nil {
+		return nil, nil, fmt.Errorf("no proof for operator %d", o.ID)
+	}
+	return o.ownShare(proof)
+}
+
+// ownShare decrypts the operator's key share identified by the share public key at the proof. If the operator
+// persists its shares, the share is loaded from its store instead of the proof sent by initiator. Shares missing
+// at the store, e.g. of validators created before it existed, are taken from the proof sent by initiator.
+func (o *LocalOwner) ownShare(proof *wire.Proof) (*wire.Proof, *bls.SecretKey, error) {
+	if o.loadShareF != nil {
+		stored, err := o.loadShareF(proof.ValidatorPubKey, proof.SharePubKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load key share: %w", err)
+		}
+		if stored != nil {
+			proof = stored.Proof
+		}
+	}
+	secretKeyBLS, err := o.decryptShare(proof.EncryptedShare)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(secretKeyBLS.GetPublicKey().Serialize(), proof.SharePubKey) {
+		return nil, nil, fmt.Errorf("decrypted key share doesnt match share public key at proof")
+	}
+	return proof, secretKeyBLS, nil
 }
 
 // Init function creates an interface for DKG (board) which process protocol messages
@@ -262,6 +535,25 @@ func (o *LocalOwner) Init(reqID [24]byte, init *wire.Init) (*wire.Transport, err
 	}
 	o.data.init = init
 	o.data.reqID = reqID
+	return o.initExchange()
+}
+
+// InitReshare prepares a resharing ceremony. Old operators decrypt their share of the validator key
+// identified by the ceremony proofs, all operators recover the public polynomial of the key from share public keys
+func (o *LocalOwner) InitReshare(reqID [24]byte, reshare *wire.ReshareMessage) (*wire.Transport, error) {
+	if o.data == nil {
+		o.data = &DKGdata{}
+	}
+	o.data.reshare = reshare
+	o.data.reqID = reqID
+	if err := o.prepareReshare(); err != nil {
+		return nil, err
+	}
+	return o.initExchange()
+}
+
+// initExchange creates a board and a DKG public key of the operator
+func (o *LocalOwner) initExchange() (*wire.Transport, error) {
 	kyberLogger := o.Logger.With(zap.String("reqid", fmt.Sprintf("%x", o.data.reqID[:])))
 	o.board = board.NewBoard(
 		kyberLogger,
@@ -297,7 +589,7 @@ func (o *LocalOwner) Init(reqID [24]byte, init *wire.Init) (*wire.Transport, err
 	}
 	return &wire.Transport{
 		Type:       wire.ExchangeMessageType,
-		Identifier: reqID,
+		Identifier: o.data.reqID,
 		Data:       bts,
 		Version:    o.version,
 	}, nil
@@ -321,6 +613,7 @@ func (o *LocalOwner) processDKG(from uint64, msg *wire.Transport) error {
 		}
 		o.Logger.Debug("operator: received deal bundle from", zap.Uint64("ID", from))
 		o.board.DealC <- *b
+		o.receivedBundle(kyber_dkg.DealPhase, b)
 	case wire.KyberResponseBundleMessageType:
 		b, err := wire.DecodeResponseBundle(kyberMsg.Data)
 		if err != nil {
@@ -328,6 +621,8 @@ func (o *LocalOwner) processDKG(from uint64, msg *wire.Transport) error {
 		}
 		o.Logger.Debug("operator: received response bundle from", zap.Uint64("ID", from))
 		o.board.ResponseC <- *b
+		o.receivedBundle(kyber_dkg.ResponsePhase, b)
+		return o.processResponse(b)
 	case wire.KyberJustificationBundleMessageType:
 		b, err := wire.DecodeJustificationBundle(kyberMsg.Data, o.Suite.G1().(kyber_dkg.Suite))
 		if err != nil {
@@ -335,15 +630,93 @@ func (o *LocalOwner) processDKG(from uint64, msg *wire.Transport) error {
 		}
 		o.Logger.Debug("operator: received justification bundle from", zap.Uint64("ID", from))
 		o.board.JustificationC <- *b
+		o.receivedBundle(kyber_dkg.JustifPhase, b)
 	default:
 		return fmt.Errorf("unknown kyber message type")
 	}
 	return nil
 }
 
+// receivedBundle notifies the phaser when bundles of all operators expected at the phase are received:
+// deal and justification bundles of dealers, response bundles of share holders. Bundles are kept to blame operators if the protocol fails.
+func (o *LocalOwner) receivedBundle(phase kyber_dkg.Phase, bundle kyber_dkg.Packet) {
+	expected := len(o.data.dkgConfig.OldNodes)
+	if phase == kyber_dkg.ResponsePhase {
+		expected = len(o.data.dkgConfig.NewNodes)
+	}
+	o.bundlesMtx.Lock()
+	if o.bundles[phase] == nil {
+		o.bundles[phase] = make(map[uint32]kyber_dkg.Packet)
+	}
+	o.bundles[phase][uint32(bundle.Index())] = bundle
+	completed := len(o.bundles[phase]) == expected
+	o.bundlesMtx.Unlock()
+	if completed {
+		o.phaser.Received(phase)
+	}
+}
+
+// processResponse answers complaints once response bundles of all share holders are received.
+// Kyber sends justifications only for complaints against operator's own deals, but initiator expects
+// a reply of each operator at every round: a dealer without complaints sends an empty justification bundle
+// and a share holder which doesnt deal acknowledges the responses.
+func (o *LocalOwner) processResponse(b *kyber_dkg.ResponseBundle) error {
+	cfg := o.data.dkgConfig
+	if err := kyber_dkg.VerifyPacketSignature(cfg, b); err != nil {
+		// kyber ignores the bundle as well
+		o.Logger.Error("invalid response bundle signature", zap.Uint32("share index", b.ShareIndex), zap.Error(err))
+		return nil
+	}
+	dealerIndex := uint32(o.ID - 1)
+	o.bundlesMtx.Lock()
+	if _, ok := o.responses[b.ShareIndex]; ok {
+		o.bundlesMtx.Unlock()
+		return nil
+	}
+	o.responses[b.ShareIndex] = b
+	if len(o.responses) != len(cfg.NewNodes) {
+		o.bundlesMtx.Unlock()
+		return nil
+	}
+	var complaints, ownComplaints bool
+	for _, bundle := range o.responses {
+		for _, r := range bundle.Responses {
+			if r.Status == kyber_dkg.Complaint {
+				complaints = true
+				ownComplaints = ownComplaints || r.DealerIndex == dealerIndex
+			}
+		}
+	}
+	o.bundlesMtx.Unlock()
+	if !complaints || ownComplaints {
+		// kyber either computes the result or justifies the complaints
+		return nil
+	}
+	if o.data.reshare != nil && o.data.oldShare == nil {
+		o.Logger.Info("operator: acknowledging complaints against other operators deals")
+		return o.Broadcast(&wire.Transport{
+			Type:       wire.ReshareAckMessageType,
+			Identifier: o.data.reqID,
+			Version:    o.version,
+		})
+	}
+	o.Logger.Info("operator: no complaints against own deals, sending empty justifications")
+	bundle := &kyber_dkg.JustificationBundle{
+		DealerIndex: dealerIndex,
+		SessionID:   cfg.Nonce,
+	}
+	sig, err := cfg.Auth.Sign(cfg.Longterm, bundle.Hash())
+	if err != nil {
+		return err
+	}
+	bundle.Signature = sig
+	o.board.PushJustifications(bundle)
+	return nil
+}
+
 // Process processes incoming messages from initiator at /dkg route
 func (o *LocalOwner) Process(st *wire.SignedTransport) error {
-	from, err := spec.OperatorIDByPubKey(o.data.init.Operators, st.Signer)
+	from, err := spec.OperatorIDByPubKey(o.data.operators(), st.Signer)
 	if err != nil {
 		return err
 	}
@@ -366,13 +739,19 @@ func (o *LocalOwner) Process(st *wire.SignedTransport) error {
 		if err := exchMsg.UnmarshalSSZ(st.Message.Data); err != nil {
 			return err
 		}
+		o.statusMtx.Lock()
 		if _, ok := o.exchanges[from]; ok {
+			o.statusMtx.Unlock()
 			return ErrAlreadyExists
 		}
 		o.exchanges[from] = exchMsg
+		o.statusMtx.Unlock()
 
 		// check if have all participating operators pub keys, then start dkg protocol
 		if o.checkOperators() {
+			if o.data.reshare != nil {
+				return o.StartReshare()
+			}
 			if err := o.StartDKG(); err != nil {
 				return err
 			}
@@ -387,6 +766,64 @@ func (o *LocalOwner) Process(st *wire.SignedTransport) error {
 	return nil
 }
 
+// prepareReshare recovers the public polynomial of the reshared validator key from the ceremony proofs
+// and decrypts operator's own key share if the operator is one of the old operators
+func (o *LocalOwner) prepareReshare() error {
+	reshare := o.data.reshare.SignedReshare.Reshare
+	if len(o.data.reshare.Proofs) != len(reshare.OldOperators) {
+		return fmt.Errorf("proofs count %d doesnt match old operators count %d", len(o.data.reshare.Proofs), len(reshare.OldOperators))
+	}
+	suite := o.Suite.G1().(kyber_dkg.Suite)
+	ids := make([]uint64, 0, len(reshare.OldOperators))
+	sharePks := make([]*bls.PublicKey, 0, len(reshare.OldOperators))
+	for i, op := range reshare.OldOperators {
+		proof := o.data.reshare.Proofs[i].Proof
+		sharePk, err := spec.BLSPKEncode(proof.SharePubKey)
+		if err != nil {
+			return fmt.Errorf("failed to decode share public key of operator %d: %w", op.ID, err)
+		}
+		ids = append(ids, op.ID)
+		sharePks = append(sharePks, sharePk)
+		if op.ID != o.ID {
+			continue
+		}
+		_, secretKeyBLS, err := o.ownShare(proof)
+		if err != nil {
+			return err
+		}
+		o.data.oldShare, err = crypto.ShareSecretKeyToPriShare(o.ID, secretKeyBLS, suite)
+		if err != nil {
+			return err
+		}
+	}
+	commits, err := crypto.SharePubKeysToCommits(ids, sharePks, int(reshare.OldT), suite)
+	if err != nil {
+		return fmt.Errorf("failed to recover public polynomial from proofs: %w", err)
+	}
+	validatorPubKey, err := commits[0].MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(validatorPubKey, reshare.ValidatorPubKey) {
+		return fmt.Errorf("share public keys at proofs dont match validator public key")
+	}
+	o.data.oldCommits = commits
+	return nil
+}
+
+// decryptShare decrypts operator's BLS key share encrypted with its RSA key
+func (o *LocalOwner) decryptShare(encryptedShare []byte) (*bls.SecretKey, error) {
+	decrypted, err := o.decryptFunc(encryptedShare)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt key share: %w", err)
+	}
+	secretKeyBLS := &bls.SecretKey{}
+	if err := secretKeyBLS.DeserializeHexStr(string(decrypted)); err != nil {
+		return nil, fmt.Errorf("failed to deserialize key share: %w", err)
+	}
+	return secretKeyBLS, nil
+}
+
 // initsecret generates a random scalar and computes public point k*G where G is a generator of the field
 func initsecret(suite pairing.Suite) (kyber.Scalar, kyber.Point) {
 	eciesSK := suite.G1().Scalar().Pick(random.New())
@@ -412,6 +849,9 @@ func CreateExchange(pk kyber.Point, commits []byte) ([]byte, *wire.Exchange, err
 
 // broadcastError propagates the error at operator back to initiator
 func (o *LocalOwner) broadcastError(err error) {
+	o.statusMtx.Lock()
+	o.lastErr = err
+	o.statusMtx.Unlock()
 	errMsgEnc, err := json.Marshal(err.Error())
 	if err != nil {
 		o.Logger.Error("failed to marshal error message", zap.Error(err))
@@ -430,9 +870,85 @@ func (o *LocalOwner) broadcastError(err error) {
 	close(o.done)
 }
 
+// broadcastFailure propagates the error of a finished kyber protocol back to initiator. If the protocol failed,
+// the operator sends a signed blame naming operators which caused the failure instead of a plain error.
+func (o *LocalOwner) broadcastFailure(res *kyber_dkg.OptionResult, err error) {
+	if res.Error == nil {
+		o.broadcastError(err)
+		return
+	}
+	blame, blameErr := o.blame(err)
+	if blameErr != nil {
+		o.Logger.Error("failed to blame operators", zap.Error(blameErr))
+		o.broadcastError(err)
+		return
+	}
+	o.Logger.Warn("blaming operators for the failed protocol", zap.String("phase", blame.Phase), zap.Uint64s("offenders", blame.Offenders))
+	blameEnc, blameErr := json.Marshal(blame)
+	if blameErr != nil {
+		o.Logger.Error("failed to marshal blame message", zap.Error(blameErr))
+		o.broadcastError(err)
+		return
+	}
+	o.statusMtx.Lock()
+	o.lastErr = err
+	o.statusMtx.Unlock()
+	blameMsg := &wire.Transport{
+		Type:       wire.BlameMessageType,
+		Identifier: o.data.reqID,
+		Data:       blameEnc,
+		Version:    o.version,
+	}
+	if err := o.Broadcast(blameMsg); err != nil {
+		o.Logger.Error("failed to broadcast blame message", zap.Error(err))
+	}
+	close(o.done)
+}
+
+// Status is a snapshot of the ceremony state at LocalOwner
+type Status struct {
+	Participants []uint64 // IDs of operators participating in the ceremony
+	Exchanges    []uint64 // IDs of operators which exchange messages were received
+	StartedDKG   bool     // all exchange messages are received and the DKG protocol is started
+	Done         bool     // the ceremony is finished by the operator, successfully or with an error
+	Reshare      bool
+	Err          error // last error propagated to initiator
+}
+
+// Status returns the current state of the ceremony
+func (o *LocalOwner) Status() Status {
+	status := Status{
+		StartedDKG: isClosed(o.startedDKG),
+		Done:       isClosed(o.done),
+	}
+	if o.data != nil {
+		for _, op := range o.data.operators() {
+			status.Participants = append(status.Participants, op.ID)
+		}
+		status.Reshare = o.data.reshare != nil
+	}
+	o.statusMtx.RLock()
+	for id := range o.exchanges {
+		status.Exchanges = append(status.Exchanges, id)
+	}
+	status.Err = o.lastErr
+	o.statusMtx.RUnlock()
+	sort.Slice(status.Exchanges, func(i, j int) bool { return status.Exchanges[i] < status.Exchanges[j] })
+	return status
+}
+
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
 // checkOperators checks that operator received all participating parties DKG public keys
 func (o *LocalOwner) checkOperators() bool {
-	for _, op := range o.data.init.Operators {
+	for _, op := range o.data.operators() {
 		if o.exchanges[op.ID] == nil {
 			return false
 		}
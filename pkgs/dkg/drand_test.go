@@ -3,10 +3,14 @@ package dkg
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/json"
 	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	kyber_bls "github.com/drand/kyber-bls12381"
+	kyber_dkg "github.com/drand/kyber/share/dkg"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -37,6 +41,11 @@ type testState struct {
 	opsPriv map[uint64]*rsa.PrivateKey
 	tv      *testVerify
 	ipk     *rsa.PublicKey
+	// tamper replaces messages delivered to operators, a nil message is dropped. Can be nil
+	tamper     func(from, to uint64, st *wire2.SignedTransport) *wire2.SignedTransport
+	outputs    map[uint64]*wire2.Result
+	blames     map[uint64]*wire2.Blame
+	outputsMtx sync.Mutex
 }
 
 func (ts *testState) Broadcast(id uint64, data []byte) error {
@@ -45,6 +54,31 @@ func (ts *testState) Broadcast(id uint64, data []byte) error {
 		if err := st.UnmarshalSSZ(data); err != nil {
 			return err
 		}
+		if st.Message.Type == wire2.OutputMessageType {
+			res := &wire2.Result{}
+			if err := res.UnmarshalSSZ(st.Message.Data); err != nil {
+				return err
+			}
+			ts.outputsMtx.Lock()
+			ts.outputs[id] = res
+			ts.outputsMtx.Unlock()
+			return nil
+		}
+		if st.Message.Type == wire2.BlameMessageType {
+			blame := &wire2.Blame{}
+			if err := json.Unmarshal(st.Message.Data, blame); err != nil {
+				return err
+			}
+			ts.outputsMtx.Lock()
+			ts.blames[id] = blame
+			ts.outputsMtx.Unlock()
+			return nil
+		}
+		if ts.tamper != nil {
+			if st = ts.tamper(id, o.ID, st); st == nil {
+				return nil
+			}
+		}
 		if err := o.Process(st); err != nil {
 			return err
 		}
@@ -80,6 +114,8 @@ func NewTestOperator(ts *testState, id uint64) (*LocalOwner, *rsa.PrivateKey) {
 		ID:        id,
 		Suite:     kyber_bls.NewBLS12381Suite(),
 		exchanges: make(map[uint64]*wire2.Exchange),
+		bundles:   make(map[kyber_dkg.Phase]map[uint32]kyber_dkg.Packet),
+		responses: make(map[uint32]*kyber_dkg.ResponseBundle),
 		broadcastF: func(bytes []byte) error {
 			return ts.Broadcast(id, bytes)
 		},
@@ -93,8 +129,7 @@ func NewTestOperator(ts *testState, id uint64) (*LocalOwner, *rsa.PrivateKey) {
 	}, pv
 }
 
-func TestDKGInit(t *testing.T) {
-	// Send operators we want to deal with them
+func newTestState(t *testing.T) *testState {
 	_, initatorPk, err := crypto.GenerateRSAKeys()
 	require.NoError(t, err)
 	ts := &testState{
@@ -103,12 +138,19 @@ func TestDKGInit(t *testing.T) {
 		opsPriv: make(map[uint64]*rsa.PrivateKey),
 		tv:      newTestVerify(),
 		ipk:     initatorPk,
+		outputs: make(map[uint64]*wire2.Result),
+		blames:  make(map[uint64]*wire2.Blame),
 	}
 	for i := 1; i < 5; i++ {
 		op, priv := NewTestOperator(ts, uint64(i))
 		ts.ops[op.ID] = op
 		ts.opsPriv[op.ID] = priv
 	}
+	return ts
+}
+
+// runDKG runs a DKG ceremony at all operators of the test state and waits until operators are done
+func runDKG(t *testing.T, ts *testState) {
 	opsarr := make([]*wire2.Operator, 0, len(ts.ops))
 	for id := range ts.ops {
 		pktobytes, err := crypto.EncodeRSAPublicKey(ts.tv.ops[id])
@@ -124,15 +166,17 @@ func TestDKGInit(t *testing.T) {
 	init := &wire2.Init{
 		Operators:             opsarr,
 		T:                     3,
-		WithdrawalCredentials: []byte("0x0000"),
+		WithdrawalCredentials: common.HexToAddress("0x1234").Bytes(),
 		Fork:                  [4]byte{0, 0, 0, 0},
 		Nonce:                 0,
 		Owner:                 common.HexToAddress("0x1234"),
+		WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+		Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
 	}
 	uid := crypto.NewID()
 	exch := map[uint64]*wire2.Transport{}
 
-	err = ts.ForAll(func(o *LocalOwner) error {
+	err := ts.ForAll(func(o *LocalOwner) error {
 		ts, err := o.Init(uid, init)
 		if err != nil {
 			t.Error(t, err)
@@ -157,3 +201,123 @@ func TestDKGInit(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestDKGInit(t *testing.T) {
+	ts := newTestState(t)
+	runDKG(t, ts)
+}
+
+func TestDKGTimePhaser(t *testing.T) {
+	ts := newTestState(t)
+	// operator 4 runs the timer phaser, the others the default event phaser
+	ts.ops[4].phaserConfig = wire2.PhaserConfig{Type: wire2.TimePhaserType, Timeout: time.Minute}
+	start := time.Now()
+	runDKG(t, ts)
+	// the timer phaser operator doesnt wait out its phase timers when all bundles are received
+	require.Less(t, time.Since(start), time.Minute)
+	require.Len(t, ts.outputs, 4)
+	for _, out := range ts.outputs {
+		require.Equal(t, ts.outputs[1].SignedProof.Proof.ValidatorPubKey, out.SignedProof.Proof.ValidatorPubKey)
+	}
+}
+
+// corruptDeal re-signs the deal bundle of the dealer with a corrupted share of the share holder
+func corruptDeal(t *testing.T, ts *testState, from, to uint64, st *wire2.SignedTransport) *wire2.SignedTransport {
+	if st.Message.Type != wire2.KyberMessageType {
+		return st
+	}
+	kyberMsg := &wire2.KyberMessage{}
+	require.NoError(t, kyberMsg.UnmarshalSSZ(st.Message.Data))
+	if kyberMsg.Type != wire2.KyberDealBundleMessageType {
+		return st
+	}
+	dealer := ts.ops[from]
+	bundle, err := wire2.DecodeDealBundle(kyberMsg.Data, dealer.Suite.G1().(kyber_dkg.Suite))
+	require.NoError(t, err)
+	for i, deal := range bundle.Deals {
+		if deal.ShareIndex == uint32(to-1) {
+			bundle.Deals[i].EncryptedShare = append([]byte{}, deal.EncryptedShare...)
+			bundle.Deals[i].EncryptedShare[len(deal.EncryptedShare)-1] ^= 0xff
+		}
+	}
+	// the dealer signs the corrupted deal bundle
+	cfg := dealer.data.dkgConfig
+	bundle.Signature, err = cfg.Auth.Sign(cfg.Longterm, bundle.Hash())
+	require.NoError(t, err)
+	kyberMsg.Data, err = wire2.EncodeDealBundle(bundle)
+	require.NoError(t, err)
+	data, err := kyberMsg.MarshalSSZ()
+	require.NoError(t, err)
+	msg := &wire2.Transport{
+		Type:       st.Message.Type,
+		Identifier: st.Message.Identifier,
+		Data:       data,
+		Version:    st.Message.Version,
+	}
+	msgBytes, err := msg.MarshalSSZ()
+	require.NoError(t, err)
+	sig, err := spec.RSASigner(ts.opsPriv[from]).Sign(msgBytes)
+	require.NoError(t, err)
+	return &wire2.SignedTransport{Message: msg, Signer: st.Signer, Signature: sig}
+}
+
+func TestDKGComplaint(t *testing.T) {
+	ts := newTestState(t)
+	// operator 1 deals a corrupted share to operator 2, operator 2 complains about it,
+	// operator 1 justifies the complaint and other dealers send empty justifications
+	ts.tamper = func(from, to uint64, st *wire2.SignedTransport) *wire2.SignedTransport {
+		if from != 1 || to != 2 {
+			return st
+		}
+		return corruptDeal(t, ts, from, to, st)
+	}
+	runDKG(t, ts)
+	require.Len(t, ts.outputs, len(ts.ops))
+	for id, out := range ts.outputs {
+		require.Equal(t, id, out.OperatorID)
+		require.Equal(t, ts.outputs[1].SignedProof.Proof.ValidatorPubKey, out.SignedProof.Proof.ValidatorPubKey)
+	}
+}
+
+func TestDKGBlame(t *testing.T) {
+	ts := newTestState(t)
+	for _, op := range ts.ops {
+		op.phaserConfig = wire2.PhaserConfig{Timeout: time.Second}
+	}
+	// operators 1 and 2 deal corrupted shares to operator 3 and dont justify its complaints,
+	// so that too few dealers are qualified at share holders which didnt deal the corrupted shares
+	// and those operators blame the dealers
+	ts.tamper = func(from, to uint64, st *wire2.SignedTransport) *wire2.SignedTransport {
+		if from > 2 {
+			return st
+		}
+		if isKyberMessage(t, st, wire2.KyberJustificationBundleMessageType) {
+			return nil
+		}
+		if to != 3 {
+			return st
+		}
+		return corruptDeal(t, ts, from, to, st)
+	}
+	runDKG(t, ts)
+	require.Len(t, ts.blames, 2)
+	for _, id := range []uint64{3, 4} {
+		blame := ts.blames[id]
+		require.NotNil(t, blame)
+		require.Equal(t, id, blame.OperatorID)
+		require.Equal(t, "justification", blame.Phase)
+		require.Equal(t, []uint64{1, 2}, blame.Offenders)
+		require.Len(t, blame.Evidence, 1)
+		require.Equal(t, uint64(3), blame.Evidence[0].OperatorID)
+		require.Equal(t, wire2.KyberResponseBundleMessageType.String(), blame.Evidence[0].Type)
+	}
+}
+
+func isKyberMessage(t *testing.T, st *wire2.SignedTransport, kyberType wire2.TransportType) bool {
+	if st.Message.Type != wire2.KyberMessageType {
+		return false
+	}
+	kyberMsg := &wire2.KyberMessage{}
+	require.NoError(t, kyberMsg.UnmarshalSSZ(st.Message.Data))
+	return kyberMsg.Type == kyberType
+}
@@ -0,0 +1,138 @@
+package initiator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
+)
+
+// BlameError is returned when a ceremony fails because of faulty operators.
+// The report names the operators with evidence and is saved next to the ceremony output by WriteBlameReport.
+type BlameError struct {
+	Report *wire.BlameReport
+	Err    error
+}
+
+func (e *BlameError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BlameError) Unwrap() error {
+	return e.Err
+}
+
+// newBlameError creates a blame report of the ceremony, operators which blames are received are reported as well
+func newBlameError(id [24]byte, phase string, offenders []uint64, err error, evidence []*wire.Evidence, blames []*wire.SignedBlame) *BlameError {
+	return &BlameError{
+		Report: &wire.BlameReport{
+			RequestID: hex.EncodeToString(id[:]),
+			Phase:     phase,
+			Offenders: offenders,
+			Reason:    err.Error(),
+			Evidence:  evidence,
+			Blames:    blames,
+		},
+		Err: err,
+	}
+}
+
+// BlameReportFile returns a file name of the blame report of a ceremony
+func BlameReportFile(requestID string) string {
+	return fmt.Sprintf("blame-%s.json", requestID)
+}
+
+// WriteBlameReport writes the blame report to the directory, returns the path of the report
+func WriteBlameReport(dir string, report *wire.BlameReport) (string, error) {
+	path := filepath.Join(dir, BlameReportFile(report.RequestID))
+	return path, utils.WriteJSON(path, report)
+}
+
+// signedEvidence returns signed operator messages as evidence, the operator is resolved by the signer public key
+func signedEvidence(operators []*wire.Operator, messages [][]byte) ([]*wire.Evidence, error) {
+	evidence := make([]*wire.Evidence, 0, len(messages))
+	for _, msg := range messages {
+		tsp := &wire.SignedTransport{}
+		if err := tsp.UnmarshalSSZ(msg); err != nil {
+			return nil, err
+		}
+		id, err := spec.OperatorIDByPubKey(operators, tsp.Signer)
+		if err != nil {
+			return nil, err
+		}
+		msgType := tsp.Message.Type
+		if msgType == wire.KyberMessageType {
+			kyberMsg := &wire.KyberMessage{}
+			if err := kyberMsg.UnmarshalSSZ(tsp.Message.Data); err != nil {
+				return nil, err
+			}
+			msgType = kyberMsg.Type
+		}
+		evidence = append(evidence, &wire.Evidence{OperatorID: id, Type: msgType.String(), Message: msg})
+	}
+	return evidence, nil
+}
+
+// parseBlame decodes the blame of an operator, the blame should be reported by the operator which signed it
+func parseBlame(operators []*wire.Operator, msg []byte, tsp *wire.SignedTransport) (*wire.SignedBlame, error) {
+	blame := &wire.Blame{}
+	if err := json.Unmarshal(tsp.Message.Data, blame); err != nil {
+		return nil, fmt.Errorf("failed to decode blame: %w", err)
+	}
+	id, err := spec.OperatorIDByPubKey(operators, tsp.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if blame.OperatorID != id {
+		return nil, fmt.Errorf("blame of operator %d is signed by operator %d", blame.OperatorID, id)
+	}
+	return &wire.SignedBlame{Blame: blame, Message: msg}, nil
+}
+
+// blamedOperators returns offenders named by blames of operators and the phase of the earliest blame
+func blamedOperators(blames []*wire.SignedBlame) (string, []uint64) {
+	phases := []string{"deal", "response", "justification"}
+	phase := "result"
+	var offenders []uint64
+	for _, b := range blames {
+		if i := slices.Index(phases, b.Blame.Phase); i != -1 && (phase == "result" || i < slices.Index(phases, phase)) {
+			phase = b.Blame.Phase
+		}
+		for _, id := range b.Blame.Offenders {
+			if !slices.Contains(offenders, id) {
+				offenders = append(offenders, id)
+			}
+		}
+	}
+	slices.Sort(offenders)
+	return phase, offenders
+}
+
+// wrongValidatorPubKeys returns IDs of operators which results have a validator public key different
+// from the one of a strict majority of operators. Without a strict majority honest operators cant be
+// told apart from faulty ones, then ok is false and no operator is returned.
+func wrongValidatorPubKeys(results []*wire.Result) (wrong []uint64, ok bool) {
+	counts := make(map[string]int)
+	var majority string
+	for _, res := range results {
+		pk := string(res.SignedProof.Proof.ValidatorPubKey)
+		counts[pk]++
+		if counts[pk] > counts[majority] {
+			majority = pk
+		}
+	}
+	if len(majority) == 0 || counts[majority]*2 <= len(results) {
+		return nil, false
+	}
+	for _, res := range results {
+		if string(res.SignedProof.Proof.ValidatorPubKey) != majority {
+			wrong = append(wrong, res.OperatorID)
+		}
+	}
+	return wrong, true
+}
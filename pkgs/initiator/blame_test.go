@@ -0,0 +1,126 @@
+package initiator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+func signedMessage(t *testing.T, id [24]byte, msgType wire.TransportType, data []byte, signer []byte) []byte {
+	signed := &wire.SignedTransport{
+		Message:   &wire.Transport{Type: msgType, Identifier: id, Data: data},
+		Signer:    signer,
+		Signature: []byte("signature"),
+	}
+	msg, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+	return msg
+}
+
+func TestBlame(t *testing.T) {
+	id := [24]byte{1, 2, 3}
+	var operators []*wire.Operator
+	for i := uint64(1); i <= 4; i++ {
+		operators = append(operators, &wire.Operator{ID: i, PubKey: []byte(fmt.Sprintf("operator %d", i))})
+	}
+	result := func(op *wire.Operator, validatorPubKey []byte) []byte {
+		res := &wire.Result{
+			OperatorID:                 op.ID,
+			RequestID:                  id,
+			DepositPartialSignature:    make([]byte, 96),
+			OwnerNoncePartialSignature: make([]byte, 96),
+			SignedProof: wire.SignedProof{
+				Proof:     &wire.Proof{ValidatorPubKey: validatorPubKey, SharePubKey: make([]byte, 48)},
+				Signature: make([]byte, 256),
+			},
+		}
+		data, err := res.MarshalSSZ()
+		require.NoError(t, err)
+		return signedMessage(t, id, wire.OutputMessageType, data, op.PubKey)
+	}
+	validatorPubKey := make([]byte, 48)
+	t.Run("wrong validator public key", func(t *testing.T) {
+		wrongPubKey := make([]byte, 48)
+		wrongPubKey[0] = 1
+		msgs := [][]byte{
+			result(operators[0], validatorPubKey),
+			result(operators[1], wrongPubKey),
+			result(operators[2], validatorPubKey),
+			result(operators[3], validatorPubKey),
+		}
+		_, err := parseDKGResultsFromBytes(msgs, id, operators)
+		var blameErr *BlameError
+		require.True(t, errors.As(err, &blameErr))
+		require.EqualError(t, err, "operators [2] sent wrong validator public key")
+		report := blameErr.Report
+		require.Equal(t, "result", report.Phase)
+		require.Equal(t, []uint64{2}, report.Offenders)
+		require.Len(t, report.Evidence, 1)
+		require.Equal(t, uint64(2), report.Evidence[0].OperatorID)
+		require.Equal(t, wire.OutputMessageType.String(), report.Evidence[0].Type)
+		require.Equal(t, msgs[1], report.Evidence[0].Message)
+
+		path, err := WriteBlameReport(t.TempDir(), report)
+		require.NoError(t, err)
+		require.Equal(t, BlameReportFile(report.RequestID), filepath.Base(path))
+	})
+	t.Run("no majority validator public key", func(t *testing.T) {
+		wrongPubKey := make([]byte, 48)
+		wrongPubKey[0] = 1
+		msgs := [][]byte{
+			result(operators[0], wrongPubKey),
+			result(operators[1], wrongPubKey),
+			result(operators[2], validatorPubKey),
+			result(operators[3], validatorPubKey),
+		}
+		_, err := parseDKGResultsFromBytes(msgs, id, operators)
+		var blameErr *BlameError
+		require.True(t, errors.As(err, &blameErr))
+		require.EqualError(t, err, "no validator public key is sent by a majority of operators")
+		report := blameErr.Report
+		require.Equal(t, "result", report.Phase)
+		require.Empty(t, report.Offenders)
+		require.Len(t, report.Evidence, 4)
+		for i, evidence := range report.Evidence {
+			require.Equal(t, operators[i].ID, evidence.OperatorID)
+			require.Equal(t, msgs[i], evidence.Message)
+		}
+	})
+	t.Run("operators blame dealers", func(t *testing.T) {
+		blame := func(op *wire.Operator, phase string, offenders []uint64) []byte {
+			data, err := json.Marshal(&wire.Blame{OperatorID: op.ID, Phase: phase, Offenders: offenders, Reason: "dkg abort"})
+			require.NoError(t, err)
+			return signedMessage(t, id, wire.BlameMessageType, data, op.PubKey)
+		}
+		msgs := [][]byte{
+			result(operators[0], validatorPubKey),
+			result(operators[1], validatorPubKey),
+			blame(operators[2], "justification", []uint64{1, 2}),
+			blame(operators[3], "response", []uint64{1}),
+		}
+		_, err := parseDKGResultsFromBytes(msgs, id, operators)
+		var blameErr *BlameError
+		require.True(t, errors.As(err, &blameErr))
+		report := blameErr.Report
+		require.Equal(t, "response", report.Phase)
+		require.Equal(t, []uint64{1, 2}, report.Offenders)
+		require.Len(t, report.Blames, 2)
+		require.Equal(t, uint64(3), report.Blames[0].Blame.OperatorID)
+		require.Equal(t, msgs[2], report.Blames[0].Message)
+	})
+	t.Run("blame signed by another operator", func(t *testing.T) {
+		data, err := json.Marshal(&wire.Blame{OperatorID: 1, Phase: "deal", Offenders: []uint64{2}})
+		require.NoError(t, err)
+		msgs := [][]byte{signedMessage(t, id, wire.BlameMessageType, data, operators[2].PubKey)}
+		_, err = parseDKGResultsFromBytes(msgs, id, operators)
+		require.EqualError(t, err, "blame of operator 1 is signed by operator 3")
+		var blameErr *BlameError
+		require.False(t, errors.As(err, &blameErr))
+	})
+}
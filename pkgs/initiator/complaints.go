@@ -0,0 +1,311 @@
+package initiator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	kyber_bls12381 "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/share"
+	kyber_dkg "github.com/drand/kyber/share/dkg"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
+)
+
+// kyberRounds relays deal bundles to share holders, and then relays response bundles. If share holders complain
+// about deals, dealers justify complaints against their deals and justifications are relayed to share holders,
+// all operators reply to the response round in that case. Returns the outputs of share holders.
+// Dealers which dont resolve complaints against their deals are blamed by the error with the complaints,
+// deals and justifications as evidence.
+func (c *Initiator) kyberRounds(ctx context.Context, deals [][]byte, id [24]byte, operators, holders []*wire.Operator) ([][]byte, error) {
+	c.Logger.Info("phase 3: ➡️ sending deal data to share holders")
+	results, err := c.SendKyberMsgsWithContext(ctx, deals, id, holders)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyMessageSignatures(id, results, c.VerifyMessageSignature); err != nil {
+		return nil, err
+	}
+	responses, err := filterMessagesByType(results, wire.KyberMessageType)
+	if err != nil {
+		return nil, err
+	}
+	complaints, err := dealComplaints(responses)
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Info("phase 3: ✅ verified operator responses (response messages) signatures")
+	if len(complaints) == 0 {
+		c.Logger.Info("phase 4: ➡️ sending responses to share holders")
+		results, err = c.SendKyberMsgsWithContext(ctx, responses, id, holders)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifyMessageSignatures(id, results, c.VerifyMessageSignature); err != nil {
+			return nil, err
+		}
+		c.Logger.Info("phase 4: ✅ verified operator dkg results signatures")
+		if err := unexpectedJustifications(id, operators, results); err != nil {
+			return nil, err
+		}
+		return results, nil
+	}
+	var leaving []uint64
+	for _, dealer := range sortedKeys(complaints) {
+		c.Logger.Warn("⚠️ share holders complained about deal", zap.Uint64("dealer", dealer), zap.Uint64s("share holders", complaints[dealer]))
+		if spec.GetOperator(holders, dealer) == nil {
+			leaving = append(leaving, dealer)
+		}
+	}
+	// a dealer which leaves the cluster doesnt process responses, so cant justify its deals
+	if len(leaving) != 0 {
+		err := fmt.Errorf("complaints against deals of leaving operators %v cant be justified", leaving)
+		evidence, evidenceErr := complaintsEvidence(operators, leaving, deals, responses, nil)
+		if evidenceErr != nil {
+			return nil, errors.Join(err, evidenceErr)
+		}
+		return nil, newBlameError(id, "response", leaving, err, evidence, nil)
+	}
+	c.Logger.Info("phase 4: ➡️ sending responses with complaints to all operators")
+	results, err = c.SendKyberMsgsWithContext(ctx, responses, id, operators)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyMessageSignatures(id, results, c.VerifyMessageSignature); err != nil {
+		return nil, err
+	}
+	// share holders which dont deal acknowledge the responses
+	var justifications [][]byte
+	var blames []*wire.SignedBlame
+	var errs error
+	for _, msg := range results {
+		tsp := &wire.SignedTransport{}
+		if err := tsp.UnmarshalSSZ(msg); err != nil {
+			return nil, err
+		}
+		switch tsp.Message.Type {
+		case wire.KyberMessageType:
+			justifications = append(justifications, msg)
+		case wire.ErrorMessageType:
+			errs = errors.Join(errs, fmt.Errorf("%s", string(tsp.Message.Data)))
+		case wire.BlameMessageType:
+			blame, err := parseBlame(operators, msg, tsp)
+			if err != nil {
+				return nil, err
+			}
+			blames = append(blames, blame)
+			errs = errors.Join(errs, fmt.Errorf("operator %d blames operators %v at %s phase: %s", blame.Blame.OperatorID, blame.Blame.Offenders, blame.Blame.Phase, blame.Blame.Reason))
+		}
+	}
+	unresolved, err := unresolvedDealers(complaints, deals, justifications)
+	if err != nil {
+		return nil, err
+	}
+	if len(unresolved) != 0 {
+		err := errors.Join(fmt.Errorf("complaints against deals of operators %v werent resolved, the dealers are faulty", unresolved), errs)
+		evidence, evidenceErr := complaintsEvidence(operators, unresolved, deals, responses, justifications)
+		if evidenceErr != nil {
+			return nil, errors.Join(err, evidenceErr)
+		}
+		return nil, newBlameError(id, "justification", unresolved, err, evidence, blames)
+	}
+	if len(blames) != 0 {
+		phase, offenders := blamedOperators(blames)
+		return nil, newBlameError(id, phase, offenders, errs, nil, blames)
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	c.Logger.Info("phase 4: ✅ complaints are resolved by justifications")
+	c.Logger.Info("phase 5: ➡️ sending justifications to share holders")
+	results, err = c.SendKyberMsgsWithContext(ctx, justifications, id, holders)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyMessageSignatures(id, results, c.VerifyMessageSignature); err != nil {
+		return nil, err
+	}
+	c.Logger.Info("phase 5: ✅ verified operator dkg results signatures")
+	return results, nil
+}
+
+// unexpectedJustifications returns a blame error if share holders replied to responses without complaints
+// with kyber bundles instead of outputs. The protocol phase of those operators timed out before they received
+// responses of all share holders, so they evicted share holders and cant compute the result of other operators.
+func unexpectedJustifications(id [24]byte, operators []*wire.Operator, results [][]byte) error {
+	var bundles [][]byte
+	for _, msg := range results {
+		tsp := &wire.SignedTransport{}
+		if err := tsp.UnmarshalSSZ(msg); err != nil {
+			return err
+		}
+		if tsp.Message.Type == wire.KyberMessageType {
+			bundles = append(bundles, msg)
+		}
+	}
+	if len(bundles) == 0 {
+		return nil
+	}
+	evidence, err := signedEvidence(operators, bundles)
+	if err != nil {
+		return err
+	}
+	offenders := make([]uint64, 0, len(evidence))
+	for _, e := range evidence {
+		offenders = append(offenders, e.OperatorID)
+	}
+	slices.Sort(offenders)
+	return newBlameError(id, "response", offenders, fmt.Errorf("operators %v replied to responses without complaints with justifications, their protocol phase timed out", offenders), evidence, nil)
+}
+
+// kyberBundle returns the kyber message of a signed operator message, the message should be of the requested kyber type
+func kyberBundle(msg []byte, kyberType wire.TransportType) ([]byte, error) {
+	tsp := &wire.SignedTransport{}
+	if err := tsp.UnmarshalSSZ(msg); err != nil {
+		return nil, err
+	}
+	kyberMsg := &wire.KyberMessage{}
+	if err := kyberMsg.UnmarshalSSZ(tsp.Message.Data); err != nil {
+		return nil, err
+	}
+	if kyberMsg.Type != kyberType {
+		return nil, fmt.Errorf("wrong kyber message type: exp %s, got %s", kyberType.String(), kyberMsg.Type.String())
+	}
+	return kyberMsg.Data, nil
+}
+
+// dealComplaints returns IDs of share holders which complained about deals by dealer operator ID.
+// Kyber node index of an operator is its ID - 1.
+func dealComplaints(responses [][]byte) (map[uint64][]uint64, error) {
+	complaints := make(map[uint64][]uint64)
+	for _, msg := range responses {
+		data, err := kyberBundle(msg, wire.KyberResponseBundleMessageType)
+		if err != nil {
+			return nil, err
+		}
+		bundle, err := wire.DecodeResponseBundle(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range bundle.Responses {
+			if r.Status == kyber_dkg.Complaint {
+				dealer := uint64(r.DealerIndex) + 1
+				complaints[dealer] = append(complaints[dealer], uint64(bundle.ShareIndex)+1)
+			}
+		}
+	}
+	for _, holders := range complaints {
+		slices.Sort(holders)
+	}
+	return complaints, nil
+}
+
+// unresolvedDealers returns IDs of dealers which didnt justify all complaints against their deals
+// with shares matching public polynomials of their deal bundles
+func unresolvedDealers(complaints map[uint64][]uint64, deals, justifications [][]byte) ([]uint64, error) {
+	suite := kyber_bls12381.NewBLS12381Suite().G1().(kyber_dkg.Suite)
+	publics := make(map[uint64]*share.PubPoly)
+	for _, msg := range deals {
+		data, err := kyberBundle(msg, wire.KyberDealBundleMessageType)
+		if err != nil {
+			return nil, err
+		}
+		bundle, err := wire.DecodeDealBundle(data, suite)
+		if err != nil {
+			return nil, err
+		}
+		publics[uint64(bundle.DealerIndex)+1] = share.NewPubPoly(suite, suite.Point().Base(), bundle.Public)
+	}
+	resolved := make(map[uint64][]uint64)
+	for _, msg := range justifications {
+		data, err := kyberBundle(msg, wire.KyberJustificationBundleMessageType)
+		if err != nil {
+			return nil, err
+		}
+		bundle, err := wire.DecodeJustificationBundle(data, suite)
+		if err != nil {
+			return nil, err
+		}
+		dealer := uint64(bundle.DealerIndex) + 1
+		public, ok := publics[dealer]
+		if !ok {
+			continue
+		}
+		for _, j := range bundle.Justifications {
+			if suite.Point().Mul(j.Share, nil).Equal(public.Eval(int(j.ShareIndex)).V) {
+				resolved[dealer] = append(resolved[dealer], uint64(j.ShareIndex)+1)
+			}
+		}
+	}
+	var unresolved []uint64
+	for _, dealer := range sortedKeys(complaints) {
+		for _, holder := range complaints[dealer] {
+			if !slices.Contains(resolved[dealer], holder) {
+				unresolved = append(unresolved, dealer)
+				break
+			}
+		}
+	}
+	return unresolved, nil
+}
+
+// complaintsEvidence returns deals of the dealers, responses complaining about them and their justifications
+func complaintsEvidence(operators []*wire.Operator, dealers []uint64, deals, responses, justifications [][]byte) ([]*wire.Evidence, error) {
+	var msgs [][]byte
+	for _, msg := range deals {
+		data, err := kyberBundle(msg, wire.KyberDealBundleMessageType)
+		if err != nil {
+			return nil, err
+		}
+		bundle, err := wire.DecodeDealBundle(data, kyber_bls12381.NewBLS12381Suite().G1().(kyber_dkg.Suite))
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(dealers, uint64(bundle.DealerIndex)+1) {
+			msgs = append(msgs, msg)
+		}
+	}
+	for _, msg := range responses {
+		data, err := kyberBundle(msg, wire.KyberResponseBundleMessageType)
+		if err != nil {
+			return nil, err
+		}
+		bundle, err := wire.DecodeResponseBundle(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range bundle.Responses {
+			if r.Status == kyber_dkg.Complaint && slices.Contains(dealers, uint64(r.DealerIndex)+1) {
+				msgs = append(msgs, msg)
+				break
+			}
+		}
+	}
+	for _, msg := range justifications {
+		data, err := kyberBundle(msg, wire.KyberJustificationBundleMessageType)
+		if err != nil {
+			return nil, err
+		}
+		bundle, err := wire.DecodeJustificationBundle(data, kyber_bls12381.NewBLS12381Suite().G1().(kyber_dkg.Suite))
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(dealers, uint64(bundle.DealerIndex)+1) {
+			msgs = append(msgs, msg)
+		}
+	}
+	return signedEvidence(operators, msgs)
+}
+
+func sortedKeys(m map[uint64][]uint64) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,103 @@
+package initiator
+
+import (
+	"testing"
+
+	kyber_bls12381 "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/share"
+	kyber_dkg "github.com/drand/kyber/share/dkg"
+	"github.com/drand/kyber/util/random"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+func signedKyberMessage(t *testing.T, kyberType wire.TransportType, data []byte) []byte {
+	kyberMsg := &wire.KyberMessage{Type: kyberType, Data: data}
+	byts, err := kyberMsg.MarshalSSZ()
+	require.NoError(t, err)
+	signed := &wire.SignedTransport{
+		Message:   &wire.Transport{Type: wire.KyberMessageType, Data: byts},
+		Signer:    []byte("signer"),
+		Signature: []byte("signature"),
+	}
+	msg, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+	return msg
+}
+
+func TestComplaints(t *testing.T) {
+	suite := kyber_bls12381.NewBLS12381Suite().G1().(kyber_dkg.Suite)
+	priPoly := share.NewPriPoly(suite, 3, nil, random.New())
+	_, commits := priPoly.Commit(suite.Point().Base()).Info()
+	dealBundle, err := wire.EncodeDealBundle(&kyber_dkg.DealBundle{DealerIndex: 0, Public: commits, SessionID: []byte("session")})
+	require.NoError(t, err)
+	deals := [][]byte{signedKyberMessage(t, wire.KyberDealBundleMessageType, dealBundle)}
+
+	var responses [][]byte
+	for holder := uint32(0); holder < 4; holder++ {
+		status := kyber_dkg.Success
+		if holder == 1 || holder == 3 {
+			status = kyber_dkg.Complaint
+		}
+		bundle, err := wire.EncodeResponseBundle(&kyber_dkg.ResponseBundle{
+			ShareIndex: holder,
+			Responses:  []kyber_dkg.Response{{DealerIndex: 0, Status: status}},
+			SessionID:  []byte("session"),
+		})
+		require.NoError(t, err)
+		responses = append(responses, signedKyberMessage(t, wire.KyberResponseBundleMessageType, bundle))
+	}
+	complaints, err := dealComplaints(responses)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64][]uint64{1: {2, 4}}, complaints)
+
+	justify := func(shares map[uint32]*share.PriShare) [][]byte {
+		bundle := &kyber_dkg.JustificationBundle{DealerIndex: 0, SessionID: []byte("session")}
+		for idx, sh := range shares {
+			bundle.Justifications = append(bundle.Justifications, kyber_dkg.Justification{ShareIndex: idx, Share: sh.V})
+		}
+		byts, err := wire.EncodeJustificationBundle(bundle)
+		require.NoError(t, err)
+		return [][]byte{signedKyberMessage(t, wire.KyberJustificationBundleMessageType, byts)}
+	}
+	t.Run("complaints are resolved", func(t *testing.T) {
+		justifications := justify(map[uint32]*share.PriShare{1: priPoly.Eval(1), 3: priPoly.Eval(3)})
+		unresolved, err := unresolvedDealers(complaints, deals, justifications)
+		require.NoError(t, err)
+		require.Empty(t, unresolved)
+	})
+	t.Run("wrong share", func(t *testing.T) {
+		justifications := justify(map[uint32]*share.PriShare{1: priPoly.Eval(1), 3: priPoly.Eval(2)})
+		unresolved, err := unresolvedDealers(complaints, deals, justifications)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1}, unresolved)
+	})
+	t.Run("missing justification", func(t *testing.T) {
+		unresolved, err := unresolvedDealers(complaints, deals, nil)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1}, unresolved)
+	})
+	t.Run("justifications without complaints", func(t *testing.T) {
+		operators := []*wire.Operator{{ID: 1, PubKey: []byte("other")}, {ID: 2, PubKey: []byte("signer")}}
+		output, err := (&wire.SignedTransport{
+			Message:   &wire.Transport{Type: wire.OutputMessageType},
+			Signer:    []byte("other"),
+			Signature: []byte("signature"),
+		}).MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, unexpectedJustifications([24]byte{1}, operators, [][]byte{output}))
+		justifications := justify(map[uint32]*share.PriShare{})
+		err = unexpectedJustifications([24]byte{1}, operators, append([][]byte{output}, justifications...))
+		var blameErr *BlameError
+		require.ErrorAs(t, err, &blameErr)
+		require.Equal(t, "response", blameErr.Report.Phase)
+		require.Equal(t, []uint64{2}, blameErr.Report.Offenders)
+		require.Len(t, blameErr.Report.Evidence, 1)
+		require.Equal(t, wire.KyberJustificationBundleMessageType.String(), blameErr.Report.Evidence[0].Type)
+	})
+	t.Run("wrong message type", func(t *testing.T) {
+		_, err := dealComplaints(deals)
+		require.EqualError(t, err, "wrong kyber message type: exp KyberResponseBundleMessageType, got KyberDealBundleMessageType")
+	})
+}
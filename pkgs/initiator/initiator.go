@@ -2,12 +2,14 @@ package initiator
 
 import (
 	"bytes"
+	"context"
 	"crypto/rsa"
 	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -27,6 +29,13 @@ import (
 
 type VerifyMessageSignatureFunc func(pub *rsa.PublicKey, msg, sig []byte) error
 
+const (
+	// DefaultPhaseRetries is a default number of resends of a ceremony phase message
+	DefaultPhaseRetries = 3
+	// DefaultRetryBackoff is a default delay before the first resend of a ceremony phase message
+	DefaultRetryBackoff = time.Second
+)
+
 // Initiator main structure for initiator
 type Initiator struct {
 	Logger                 *zap.Logger                // logger
@@ -34,6 +43,15 @@ type Initiator struct {
 	Operators              wire.OperatorsCLI          // operators info mapping
 	VerifyMessageSignature VerifyMessageSignatureFunc // function to verify signatures of incoming messages
 	PrivateKey             *rsa.PrivateKey            // a unique initiator's RSA private key used for signing messages and identity
+	ThresholdPolicy        spec.ThresholdPolicy       // accepted number of operators and threshold, SSV clusters by default
+	Threshold              uint64                     // optional DKG threshold, computed following 3f+1 tolerance if not set
+	WithdrawalPrefix       byte                       // optional withdrawal credentials type of a withdrawal address, 0x01 if not set or 0x02 for compounding validators
+	DepositAmount          phase0.Gwei                // optional deposit amount, 32 ETH if not set
+	PhaseRetries           int                        // number of resends of a ceremony phase message to operators which failed to respond
+	RetryBackoff           time.Duration              // delay before the first resend, doubled for each next resend
+	PhaseTimeout           time.Duration              // optional deadline of each ceremony phase, including resends
+	CeremonyTimeout        time.Duration              // optional deadline of the whole ceremony
+	KeysRegistry           OperatorKeysRegistry       // optional registry to verify operator public keys against the SSV network before a ceremony
 	Version                []byte
 }
 
@@ -117,6 +135,8 @@ func New(operators wire.OperatorsCLI, logger *zap.Logger, ver string, certs []st
 		Operators:              operators,
 		PrivateKey:             privKey,
 		VerifyMessageSignature: standardMessageVerification(operators),
+		PhaseRetries:           DefaultPhaseRetries,
+		RetryBackoff:           DefaultRetryBackoff,
 		Version:                []byte(ver),
 	}
 	return c, nil
@@ -124,16 +144,37 @@ func New(operators wire.OperatorsCLI, logger *zap.Logger, ver string, certs []st
 
 // ValidatedOperatorData validates operators information data before starting a DKG ceremony
 func ValidatedOperatorData(ids []uint64, operators wire.OperatorsCLI) ([]*wire.Operator, error) {
-	if len(ids) < 4 {
-		return nil, fmt.Errorf("wrong operators len: < 4")
-	}
-	if len(ids) > 13 {
-		return nil, fmt.Errorf("wrong operators len: > 13")
-	}
-	if len(ids)%3 != 1 {
-		return nil, fmt.Errorf("amount of operators should be 4,7,10,13: got %d", ids)
+	return ValidatedOperatorDataWithPolicy(ids, operators, spec.SSVThresholdPolicy)
+}
+
+// ValidatedOperatorDataWithPolicy validates operators information data under the threshold policy before starting a DKG ceremony
+func ValidatedOperatorDataWithPolicy(ids []uint64, operators wire.OperatorsCLI, policy spec.ThresholdPolicy) ([]*wire.Operator, error) {
+	switch policy {
+	case spec.SSVThresholdPolicy:
+		if len(ids) < 4 {
+			return nil, fmt.Errorf("wrong operators len: < 4")
+		}
+		if len(ids) > 13 {
+			return nil, fmt.Errorf("wrong operators len: > 13")
+		}
+		if len(ids)%3 != 1 {
+			return nil, fmt.Errorf("amount of operators should be 4,7,10,13: got %d", ids)
+		}
+	case spec.CustomThresholdPolicy:
+		if len(ids) < spec.MinCustomOperators {
+			return nil, fmt.Errorf("wrong operators len: < %d", spec.MinCustomOperators)
+		}
+		if len(ids) > wire.MaxInitV2Operators {
+			return nil, fmt.Errorf("wrong operators len: > %d", wire.MaxInitV2Operators)
+		}
+	default:
+		return nil, fmt.Errorf("unknown threshold policy %d", policy)
 	}
+	return OperatorData(ids, operators)
+}
 
+// OperatorData returns operators by IDs with encoded public keys, IDs should be unique
+func OperatorData(ids []uint64, operators wire.OperatorsCLI) ([]*wire.Operator, error) {
 	ops := make([]*wire.Operator, len(ids))
 	opMap := make(map[uint64]struct{})
 	for i, id := range ids {
@@ -162,11 +203,33 @@ func ValidatedOperatorData(ids []uint64, operators wire.OperatorsCLI) ([]*wire.O
 	return ops, nil
 }
 
+// validatedOperatorData validates operators information data under the initiator's threshold policy
+func (c *Initiator) validatedOperatorData(ids []uint64) ([]*wire.Operator, error) {
+	return ValidatedOperatorDataWithPolicy(ids, c.Operators, c.ThresholdPolicy)
+}
+
+// verifyOperatorKeys refuses to start a ceremony when public keys of operators differ from the SSV network registry
+func (c *Initiator) verifyOperatorKeys(ctx context.Context, ops []*wire.Operator) error {
+	if c.KeysRegistry == nil {
+		return nil
+	}
+	if err := VerifyOperatorKeys(ctx, c.KeysRegistry, ops); err != nil {
+		return err
+	}
+	c.Logger.Info("✅ verified operator public keys against the SSV network registry")
+	return nil
+}
+
 // messageFlowHandling main steps of DKG at initiator
-func (c *Initiator) messageFlowHandling(init *wire.Init, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+func (c *Initiator) messageFlowHandling(ctx context.Context, init *wire.Init, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
 	c.Logger.Info("phase 1: sending init message to operators")
-	results, err := c.SendInitMsg(init, id, operators)
+	results, err := c.SendInitMsgWithContext(ctx, init, id, operators)
 	if err != nil {
+		// operators which didnt respond are offline unless the ceremony is canceled
+		if offline := offlineOperators(err); len(offline) != 0 && ctx.Err() == nil {
+			slices.Sort(offline)
+			return nil, &InitError{Offline: offline, Err: err}
+		}
 		return nil, err
 	}
 	err = verifyMessageSignatures(id, results, c.VerifyMessageSignature)
@@ -176,7 +239,7 @@ func (c *Initiator) messageFlowHandling(init *wire.Init, id [24]byte, operators
 	c.Logger.Info("phase 1: ✅ verified operator init responses signatures")
 
 	c.Logger.Info("phase 2: ➡️ sending operator data (exchange messages) required for dkg")
-	results, err = c.SendExchangeMsgs(results, id, operators)
+	results, err = c.SendExchangeMsgsWithContext(ctx, results, id, operators)
 	if err != nil {
 		return nil, err
 	}
@@ -185,28 +248,76 @@ func (c *Initiator) messageFlowHandling(init *wire.Init, id [24]byte, operators
 		return nil, err
 	}
 	c.Logger.Info("phase 2: ✅ verified operator responses (deal messages) signatures")
-	c.Logger.Info("phase 3: ➡️ sending deal dkg data to all operators")
-	dkgResult, err := c.SendKyberMsgs(results, id, operators)
+	return c.kyberRounds(ctx, results, id, operators, operators)
+}
+
+// initWithdrawal returns withdrawal credentials type and withdrawal credentials field of init message:
+// a withdrawal address, or full 0x00 withdrawal credentials of a BLS withdrawal public key
+func (c *Initiator) initWithdrawal(withdraw []byte) (byte, []byte, error) {
+	switch len(withdraw) {
+	case common.AddressLength:
+		if c.WithdrawalPrefix == 0 {
+			return crypto.ETH1WithdrawalPrefixByte, withdraw, nil
+		}
+		return c.WithdrawalPrefix, withdraw, nil
+	case phase0.PublicKeyLength:
+		if c.WithdrawalPrefix != 0 && c.WithdrawalPrefix != crypto.BLSWithdrawalPrefixByte {
+			return 0, nil, fmt.Errorf("withdrawal credentials type %#x requires a withdrawal address", c.WithdrawalPrefix)
+		}
+		if err := crypto.ValidateBLSWithdrawalPubKey(withdraw); err != nil {
+			return 0, nil, err
+		}
+		return crypto.BLSWithdrawalPrefixByte, crypto.BLSWithdrawalCredentials(withdraw), nil
+	default:
+		return 0, nil, fmt.Errorf("incorrect withdrawal address length")
+	}
+}
+
+// depositAmount returns the deposit amount of validators, 32 ETH if not set
+func (c *Initiator) depositAmount() phase0.Gwei {
+	if c.DepositAmount == 0 {
+		return crypto.MaxEffectiveBalanceInGwei
+	}
+	return c.DepositAmount
+}
+
+// addressWithdrawalCredentials returns withdrawal credentials type and deposit amount of reshare and resign
+// messages, and withdrawal credentials of their deposit data
+func (c *Initiator) addressWithdrawalCredentials(withdraw []byte) (byte, phase0.Gwei, []byte, error) {
+	withdrawalPrefix, _, err := c.initWithdrawal(withdraw)
 	if err != nil {
-		return nil, err
+		return 0, 0, nil, err
 	}
-	err = verifyMessageSignatures(id, results, c.VerifyMessageSignature)
+	amount := c.depositAmount()
+	withdrawalCredentials, _, err := spec.AddressWithdrawalCredentials(withdrawalPrefix, uint64(amount), withdraw)
 	if err != nil {
-		return nil, err
+		return 0, 0, nil, err
 	}
-	c.Logger.Info("phase 2: ✅ verified operator dkg results signatures")
-	return dkgResult, nil
+	return withdrawalPrefix, amount, withdrawalCredentials, nil
 }
 
 // StartDKG starts DKG ceremony at initiator with requested parameters
 func (c *Initiator) StartDKG(id [24]byte, withdraw []byte, ids []uint64, network eth2_key_manager_core.Network, owner common.Address, nonce uint64) (*wire.DepositDataCLI, *wire.KeySharesCLI, []*wire.SignedProof, error) {
-	if len(withdraw) != len(common.Address{}) {
-		return nil, nil, nil, fmt.Errorf("incorrect withdrawal address length")
+	return c.StartDKGWithContext(context.Background(), id, withdraw, ids, network, owner, nonce)
+}
+
+// StartDKGWithContext starts DKG ceremony at initiator with requested parameters. The ceremony is aborted
+// when ctx is done, a phase takes longer than PhaseTimeout or the ceremony takes longer than CeremonyTimeout.
+// Withdraw is a withdrawal address, or a BLS withdrawal public key of validators with 0x00 withdrawal credentials.
+func (c *Initiator) StartDKGWithContext(ctx context.Context, id [24]byte, withdraw []byte, ids []uint64, network eth2_key_manager_core.Network, owner common.Address, nonce uint64) (*wire.DepositDataCLI, *wire.KeySharesCLI, []*wire.SignedProof, error) {
+	ctx, cancel := c.ceremonyContext(ctx)
+	defer cancel()
+	withdrawalPrefix, withdrawalCredentials, err := c.initWithdrawal(withdraw)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	ops, err := ValidatedOperatorData(ids, c.Operators)
+	ops, err := c.validatedOperatorData(ids)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if err := c.verifyOperatorKeys(ctx, ops); err != nil {
+		return nil, nil, nil, err
+	}
 
 	pkBytes, err := crypto.EncodeRSAPublicKey(&c.PrivateKey.PublicKey)
 	if err != nil {
@@ -217,23 +328,32 @@ func (c *Initiator) StartDKG(id [24]byte, withdraw []byte, ids []uint64, network
 	c.Logger.Info("🚀 Starting dkg ceremony", zap.String("initiator public key", string(pkBytes)), zap.Uint64s("operator IDs", ids), instanceIDField)
 
 	// compute threshold (3f+1)
-	threshold := len(ids) - ((len(ids) - 1) / 3)
+	threshold := uint64(len(ids) - ((len(ids) - 1) / 3))
+	if c.Threshold != 0 {
+		threshold = c.Threshold
+	}
+	amount := c.depositAmount()
 	// make init message
 	init := &wire.Init{
 		Operators:             ops,
-		T:                     uint64(threshold),
-		WithdrawalCredentials: withdraw,
-		Fork:                  network.GenesisForkVersion(),
+		T:                     threshold,
+		WithdrawalCredentials: withdrawalCredentials,
+		Fork:                  utils.GenesisForkVersion(network),
 		Owner:                 owner,
 		Nonce:                 nonce,
+		WithdrawalPrefix:      withdrawalPrefix,
+		Amount:                uint64(amount),
+	}
+	if err := spec.ValidateInitMessageWithPolicy(init, c.ThresholdPolicy); err != nil {
+		return nil, nil, nil, err
 	}
 	c.Logger = c.Logger.With(instanceIDField)
 
-	dkgResultsBytes, err := c.messageFlowHandling(init, id, ops)
+	dkgResultsBytes, err := c.messageFlowHandling(ctx, init, id, ops)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	dkgResults, err := parseDKGResultsFromBytes(dkgResultsBytes, id)
+	dkgResults, err := parseDKGResultsFromBytes(dkgResultsBytes, id, ops)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -243,7 +363,7 @@ func (c *Initiator) StartDKG(id [24]byte, withdraw []byte, ids []uint64, network
 		return nil, nil, nil, err
 	}
 	c.Logger.Info("✅ verified master signature for ssv contract data")
-	if err := crypto.ValidateDepositDataCLI(depositDataJson, common.BytesToAddress(withdraw)); err != nil {
+	if err := crypto.ValidateDepositDataCLIWithCredentials(depositDataJson, spec.InitWithdrawalCredentials(init), amount); err != nil {
 		return nil, nil, nil, err
 	}
 	if err := crypto.ValidateKeysharesCLI(keyshares, init.Operators, init.Owner, init.Nonce, depositDataJson.PubKey); err != nil {
@@ -273,13 +393,336 @@ func (c *Initiator) StartDKG(id [24]byte, withdraw []byte, ids []uint64, network
 		KeysharesData: keysharesData,
 		Proofs:        proofsData,
 	}
-	err = c.sendResult(resultMsg, ops, consts.API_RESULTS_URL, id)
+	err = c.sendResult(ctx, resultMsg, ops, consts.API_RESULTS_URL, id)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("🤖 Error storing results at operators %w", err)
 	}
 	return depositDataJson, keyshares, proofsArray, nil
 }
 
+// ConstructReshareMessage creates a reshare message to be signed by the validator owner. Proofs of the previous
+// ceremony should be ordered the same way as old operators, the old threshold is recovered from share public keys
+// at the proofs. The new threshold is the initiator's threshold, computed following 3f+1 tolerance if not set.
+func (c *Initiator) ConstructReshareMessage(oldIDs, newIDs []uint64, validatorPub []byte, proofs []*wire.SignedProof, owner common.Address, nonce uint64) (*wire.Reshare, error) {
+	oldOps, err := c.validatedOperatorData(oldIDs)
+	if err != nil {
+		return nil, err
+	}
+	newOps, err := c.validatedOperatorData(newIDs)
+	if err != nil {
+		return nil, err
+	}
+	oldThreshold, err := proofsThreshold(validatorPub, oldOps, proofs)
+	if err != nil {
+		return nil, err
+	}
+	// compute threshold (3f+1)
+	newThreshold := uint64(len(newIDs) - ((len(newIDs) - 1) / 3))
+	if c.Threshold != 0 {
+		newThreshold = c.Threshold
+	}
+	return &wire.Reshare{
+		ValidatorPubKey: validatorPub,
+		OldOperators:    oldOps,
+		NewOperators:    newOps,
+		OldT:            oldThreshold,
+		NewT:            newThreshold,
+		Owner:           owner,
+		Nonce:           nonce,
+	}, nil
+}
+
+// proofsThreshold recovers the threshold of the validator key from ceremony proofs ordered the same way as operators
+func proofsThreshold(validatorPub []byte, operators []*wire.Operator, proofs []*wire.SignedProof) (uint64, error) {
+	if len(proofs) != len(operators) {
+		return 0, fmt.Errorf("proofs count %d doesnt match operators count %d", len(proofs), len(operators))
+	}
+	proofsMap := make(map[*wire.Operator]wire.SignedProof, len(proofs))
+	for i, op := range operators {
+		proofsMap[op] = *proofs[i]
+	}
+	return spec.ProofsThreshold(validatorPub, proofsMap)
+}
+
+// StartResharing starts a resharing ceremony at initiator: old operators redistribute an existing validator key to new operators.
+// Proofs of the previous ceremony should be ordered the same way as old operators. Resharing doesnt produce new deposit data.
+func (c *Initiator) StartResharing(id [24]byte, signedReshare *wire.SignedReshare, proofs []*wire.SignedProof, withdraw []byte, network eth2_key_manager_core.Network) (*wire.KeySharesCLI, []*wire.SignedProof, error) {
+	return c.StartResharingWithContext(context.Background(), id, signedReshare, proofs, withdraw, network)
+}
+
+// StartResharingWithContext starts a resharing ceremony at initiator. The ceremony is aborted when ctx is done,
+// a phase takes longer than PhaseTimeout or the ceremony takes longer than CeremonyTimeout.
+func (c *Initiator) StartResharingWithContext(ctx context.Context, id [24]byte, signedReshare *wire.SignedReshare, proofs []*wire.SignedProof, withdraw []byte, network eth2_key_manager_core.Network) (*wire.KeySharesCLI, []*wire.SignedProof, error) {
+	ctx, cancel := c.ceremonyContext(ctx)
+	defer cancel()
+	if len(withdraw) != len(common.Address{}) {
+		return nil, nil, fmt.Errorf("incorrect withdrawal address length")
+	}
+	withdrawalPrefix, amount, withdrawalCredentials, err := c.addressWithdrawalCredentials(withdraw)
+	if err != nil {
+		return nil, nil, err
+	}
+	reshare := &signedReshare.Reshare
+	if len(proofs) != len(reshare.OldOperators) {
+		return nil, nil, fmt.Errorf("proofs count %d doesnt match old operators count %d", len(proofs), len(reshare.OldOperators))
+	}
+	proofsMap := make(map[*wire.Operator]wire.SignedProof, len(proofs))
+	for i, op := range reshare.OldOperators {
+		proofsMap[op] = *proofs[i]
+	}
+	if err := spec.ValidateReshareMessageWithPolicy(reshare, proofsMap, c.ThresholdPolicy); err != nil {
+		return nil, nil, err
+	}
+	oldThreshold, err := spec.ProofsThreshold(reshare.ValidatorPubKey, proofsMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	if oldThreshold != reshare.OldT {
+		return nil, nil, fmt.Errorf("old threshold %d doesnt match threshold %d of ceremony proofs", reshare.OldT, oldThreshold)
+	}
+	if err := c.verifyOperatorKeys(ctx, append(append([]*wire.Operator{}, reshare.OldOperators...), reshare.NewOperators...)); err != nil {
+		return nil, nil, err
+	}
+	pkBytes, err := crypto.EncodeRSAPublicKey(&c.PrivateKey.PublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	instanceIDField := zap.String("reshare ID", hex.EncodeToString(id[:]))
+	c.Logger.Info("🚀 Starting resharing ceremony", zap.String("initiator public key", string(pkBytes)), zap.String("validator public key", hex.EncodeToString(reshare.ValidatorPubKey)), instanceIDField)
+	reshareMsg := &wire.ReshareMessage{
+		SignedReshare:         signedReshare,
+		Proofs:                proofs,
+		WithdrawalCredentials: withdraw,
+		Fork:                  utils.GenesisForkVersion(network),
+		WithdrawalPrefix:      withdrawalPrefix,
+		Amount:                uint64(amount),
+	}
+	c.Logger = c.Logger.With(instanceIDField)
+
+	resultsBytes, err := c.reshareMessageFlowHandling(ctx, reshareMsg, id, spec.ReshareOperators(reshare), reshare.NewOperators)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := parseDKGResultsFromBytes(resultsBytes, id, reshare.NewOperators)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.Logger.Info("🏁 Resharing completed, verifying ssv payload")
+	_, _, masterSigOwnerNonce, err := spec.ValidateResults(reshare.NewOperators, withdrawalCredentials, amount, reshare.ValidatorPubKey, reshareMsg.Fork, reshare.Owner, reshare.Nonce, id, results)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := validateThreshold(reshare.ValidatorPubKey, results, reshare.NewT); err != nil {
+		return nil, nil, err
+	}
+	keyshares, err := c.generateSSVKeysharesPayload(reshare.NewOperators, results, masterSigOwnerNonce, reshare.Owner, reshare.Nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := crypto.ValidateKeysharesCLI(keyshares, reshare.NewOperators, reshare.Owner, reshare.Nonce, hex.EncodeToString(reshare.ValidatorPubKey)); err != nil {
+		return nil, nil, err
+	}
+	c.Logger.Info("✅ verified master signature for ssv contract data")
+	var proofsArray []*wire.SignedProof
+	for _, res := range results {
+		proofsArray = append(proofsArray, &res.SignedProof)
+	}
+	return keyshares, proofsArray, nil
+}
+
+// ConstructResignMessage creates a resign message to be signed by the validator owner
+func (c *Initiator) ConstructResignMessage(ids []uint64, validatorPub []byte, owner common.Address, nonce uint64) (*wire.Resign, error) {
+	ops, err := c.validatedOperatorData(ids)
+	if err != nil {
+		return nil, err
+	}
+	return &wire.Resign{
+		ValidatorPubKey: validatorPub,
+		Operators:       ops,
+		Owner:           owner,
+		Nonce:           nonce,
+	}, nil
+}
+
+// StartResigning asks operators to sign a new owner and nonce with their key shares and rebuilds keyshares data from the ceremony proofs.
+// Proofs should be ordered the same way as operators. The validator key doesnt change, so no new deposit data is produced.
+func (c *Initiator) StartResigning(id [24]byte, signedResign *wire.SignedResign, proofs []*wire.SignedProof, withdraw []byte, network eth2_key_manager_core.Network) (*wire.KeySharesCLI, []*wire.SignedProof, error) {
+	return c.StartResigningWithContext(context.Background(), id, signedResign, proofs, withdraw, network)
+}
+
+// StartResigningWithContext asks operators to sign a new owner and nonce with their key shares. Resigning is aborted
+// when ctx is done or takes longer than PhaseTimeout or CeremonyTimeout.
+func (c *Initiator) StartResigningWithContext(ctx context.Context, id [24]byte, signedResign *wire.SignedResign, proofs []*wire.SignedProof, withdraw []byte, network eth2_key_manager_core.Network) (*wire.KeySharesCLI, []*wire.SignedProof, error) {
+	ctx, cancel := c.ceremonyContext(ctx)
+	defer cancel()
+	if len(withdraw) != len(common.Address{}) {
+		return nil, nil, fmt.Errorf("incorrect withdrawal address length")
+	}
+	withdrawalPrefix, amount, withdrawalCredentials, err := c.addressWithdrawalCredentials(withdraw)
+	if err != nil {
+		return nil, nil, err
+	}
+	resign := &signedResign.Resign
+	if len(proofs) != len(resign.Operators) {
+		return nil, nil, fmt.Errorf("proofs count %d doesnt match operators count %d", len(proofs), len(resign.Operators))
+	}
+	proofsMap := make(map[*wire.Operator]wire.SignedProof, len(proofs))
+	for i, op := range resign.Operators {
+		proofsMap[op] = *proofs[i]
+	}
+	if err := spec.ValidateResignMessage(resign, proofsMap); err != nil {
+		return nil, nil, err
+	}
+	if err := c.verifyOperatorKeys(ctx, resign.Operators); err != nil {
+		return nil, nil, err
+	}
+	pkBytes, err := crypto.EncodeRSAPublicKey(&c.PrivateKey.PublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	instanceIDField := zap.String("resign ID", hex.EncodeToString(id[:]))
+	c.Logger.Info("🚀 Starting resigning", zap.String("initiator public key", string(pkBytes)), zap.String("validator public key", hex.EncodeToString(resign.ValidatorPubKey)), instanceIDField)
+	resignMsg := &wire.ResignMessage{
+		SignedResign:          signedResign,
+		Proofs:                proofs,
+		WithdrawalCredentials: withdraw,
+		Fork:                  utils.GenesisForkVersion(network),
+		WithdrawalPrefix:      withdrawalPrefix,
+		Amount:                uint64(amount),
+	}
+	c.Logger = c.Logger.With(instanceIDField)
+
+	resultsBytes, err := c.SendResignMsgWithContext(ctx, resignMsg, id, resign.Operators)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = verifyMessageSignatures(id, resultsBytes, c.VerifyMessageSignature)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.Logger.Info("✅ verified operator resign responses signatures")
+	results, err := parseDKGResultsFromBytes(resultsBytes, id, resign.Operators)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, _, masterSigOwnerNonce, err := spec.ValidateResults(resign.Operators, withdrawalCredentials, amount, resign.ValidatorPubKey, resignMsg.Fork, resign.Owner, resign.Nonce, id, results)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyshares, err := c.generateSSVKeysharesPayload(resign.Operators, results, masterSigOwnerNonce, resign.Owner, resign.Nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := crypto.ValidateKeysharesCLI(keyshares, resign.Operators, resign.Owner, resign.Nonce, hex.EncodeToString(resign.ValidatorPubKey)); err != nil {
+		return nil, nil, err
+	}
+	c.Logger.Info("✅ verified master signature for ssv contract data")
+	var proofsArray []*wire.SignedProof
+	for _, res := range results {
+		proofsArray = append(proofsArray, &res.SignedProof)
+	}
+	return keyshares, proofsArray, nil
+}
+
+// ConstructExitMessage creates a voluntary exit message to be signed by the validator owner
+func (c *Initiator) ConstructExitMessage(ids []uint64, validatorPub []byte, validatorIndex, epoch uint64, network eth2_key_manager_core.Network) (*wire.Exit, error) {
+	ops, err := c.validatedOperatorData(ids)
+	if err != nil {
+		return nil, err
+	}
+	return &wire.Exit{
+		ValidatorPubKey: validatorPub,
+		Operators:       ops,
+		ValidatorIndex:  validatorIndex,
+		Epoch:           epoch,
+		Fork:            utils.GenesisForkVersion(network),
+	}, nil
+}
+
+// StartExit asks operators to sign a voluntary exit of the validator with their key shares and reconstructs
+// the validator signature. Proofs should be ordered the same way as operators. Partial signatures of at least
+// threshold of operators are required, the rest of operators can be offline.
+func (c *Initiator) StartExit(id [24]byte, signedExit *wire.SignedExit, proofs []*wire.SignedProof) (*phase0.SignedVoluntaryExit, error) {
+	return c.StartExitWithContext(context.Background(), id, signedExit, proofs)
+}
+
+// StartExitWithContext asks operators to sign a voluntary exit of the validator. Signing is aborted
+// when ctx is done or takes longer than PhaseTimeout or CeremonyTimeout.
+func (c *Initiator) StartExitWithContext(ctx context.Context, id [24]byte, signedExit *wire.SignedExit, proofs []*wire.SignedProof) (*phase0.SignedVoluntaryExit, error) {
+	ctx, cancel := c.ceremonyContext(ctx)
+	defer cancel()
+	exit := &signedExit.Exit
+	if len(proofs) != len(exit.Operators) {
+		return nil, fmt.Errorf("proofs count %d doesnt match operators count %d", len(proofs), len(exit.Operators))
+	}
+	proofsMap := make(map[*wire.Operator]wire.SignedProof, len(proofs))
+	for i, op := range exit.Operators {
+		proofsMap[op] = *proofs[i]
+	}
+	if err := spec.ValidateExitMessage(exit, proofsMap); err != nil {
+		return nil, err
+	}
+	if err := c.verifyOperatorKeys(ctx, exit.Operators); err != nil {
+		return nil, err
+	}
+	pkBytes, err := crypto.EncodeRSAPublicKey(&c.PrivateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	instanceIDField := zap.String("exit ID", hex.EncodeToString(id[:]))
+	c.Logger.Info("🚀 Starting voluntary exit signing", zap.String("initiator public key", string(pkBytes)), zap.String("validator public key", hex.EncodeToString(exit.ValidatorPubKey)), instanceIDField)
+	exitMsg := &wire.ExitMessage{
+		SignedExit: signedExit,
+		Proofs:     proofs,
+	}
+	c.Logger = c.Logger.With(instanceIDField)
+
+	// operators which failed to sign dont fail the exit while threshold of partial signatures is valid
+	resultsBytes, sendErr := c.SendExitMsgWithContext(ctx, exitMsg, id, exit.Operators)
+	results, parseErr := parsePartialExitsFromBytes(resultsBytes, id, c.VerifyMessageSignature)
+	if failed := errors.Join(sendErr, parseErr); failed != nil {
+		c.Logger.Warn("some operators failed to sign voluntary exit", zap.Error(failed))
+	}
+	c.Logger.Info("✅ verified operator exit responses signatures", zap.Int("partial signatures", len(results)))
+	signedVoluntaryExit, err := spec.ValidateExitResults(exit, proofsMap, id, results)
+	if err != nil {
+		return nil, errors.Join(err, sendErr, parseErr)
+	}
+	c.Logger.Info("✅ verified voluntary exit signature")
+	return signedVoluntaryExit, nil
+}
+
+// reshareMessageFlowHandling main steps of resharing at initiator
+func (c *Initiator) reshareMessageFlowHandling(ctx context.Context, reshare *wire.ReshareMessage, id [24]byte, operators, newOperators []*wire.Operator) ([][]byte, error) {
+	c.Logger.Info("phase 1: sending reshare message to old and new operators")
+	results, err := c.SendReshareMsgWithContext(ctx, reshare, id, operators)
+	if err != nil {
+		return nil, err
+	}
+	err = verifyMessageSignatures(id, results, c.VerifyMessageSignature)
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Info("phase 1: ✅ verified operator reshare responses signatures")
+
+	c.Logger.Info("phase 2: ➡️ sending operator data (exchange messages) required for resharing")
+	results, err = c.SendExchangeMsgsWithContext(ctx, results, id, operators)
+	if err != nil {
+		return nil, err
+	}
+	err = verifyMessageSignatures(id, results, c.VerifyMessageSignature)
+	if err != nil {
+		return nil, err
+	}
+	// only old operators deal, new operators acknowledge exchange messages
+	deals, err := filterMessagesByType(results, wire.KyberMessageType)
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Info("phase 2: ✅ verified old operator responses (deal messages) signatures")
+	return c.kyberRounds(ctx, deals, id, operators, newOperators)
+}
+
 // processDKGResultResponseInitial deserializes incoming DKG result messages from operators after successful initiation ceremony
 func (c *Initiator) processDKGResultResponseInitial(dkgResults []*wire.Result, init *wire.Init, requestID [24]byte) (*wire.DepositDataCLI, *wire.KeySharesCLI, error) {
 	// check results sorted by operatorID
@@ -293,10 +736,13 @@ func (c *Initiator) processDKGResultResponseInitial(dkgResults []*wire.Result, i
 	if err != nil {
 		return nil, nil, err
 	}
-	_, depositData, masterSigOwnerNonce, err := spec.ValidateResults(init.Operators, init.WithdrawalCredentials, validatorPK, init.Fork, init.Owner, init.Nonce, requestID, dkgResults)
+	_, depositData, masterSigOwnerNonce, err := spec.ValidateResults(init.Operators, spec.InitWithdrawalCredentials(init), spec.InitDepositAmount(init), validatorPK, init.Fork, init.Owner, init.Nonce, requestID, dkgResults)
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := validateThreshold(validatorPK, dkgResults, init.T); err != nil {
+		return nil, nil, err
+	}
 	network, err := utils.GetNetworkByFork(init.Fork)
 	if err != nil {
 		return nil, nil, err
@@ -313,7 +759,25 @@ func (c *Initiator) processDKGResultResponseInitial(dkgResults []*wire.Result, i
 	return depositDataJson, keyshares, nil
 }
 
-func parseDKGResultsFromBytes(responseResult [][]byte, id [24]byte) (dkgResults []*wire.Result, finalErr error) {
+// validateThreshold checks that the public polynomial committed by operators has the threshold of the ceremony,
+// results without commits are checked by the recovered validator public key only
+func validateThreshold(validatorPK []byte, results []*wire.Result, t uint64) error {
+	threshold, err := spec.ValidateCommits(validatorPK, results)
+	if err != nil {
+		return err
+	}
+	if threshold != 0 && threshold != t {
+		return fmt.Errorf("threshold of public polynomial %d doesnt match ceremony threshold %d", threshold, t)
+	}
+	return nil
+}
+
+// parseDKGResultsFromBytes decodes results of operators. If the kyber protocol failed at some operators,
+// a blame error is returned with operators named by their blames. Operators which results have
+// a wrong validator public key are blamed by initiator with the results as evidence.
+func parseDKGResultsFromBytes(responseResult [][]byte, id [24]byte, operators []*wire.Operator) (dkgResults []*wire.Result, finalErr error) {
+	var blames []*wire.SignedBlame
+	resultMsgs := make(map[uint64][]byte)
 	for i := 0; i < len(responseResult); i++ {
 		msg := responseResult[i]
 		tsp := &wire.SignedTransport{}
@@ -325,6 +789,16 @@ func parseDKGResultsFromBytes(responseResult [][]byte, id [24]byte) (dkgResults
 			finalErr = errors.Join(finalErr, fmt.Errorf("%s", string(tsp.Message.Data)))
 			continue
 		}
+		if tsp.Message.Type == wire.BlameMessageType {
+			blame, err := parseBlame(operators, msg, tsp)
+			if err != nil {
+				finalErr = errors.Join(finalErr, err)
+				continue
+			}
+			blames = append(blames, blame)
+			finalErr = errors.Join(finalErr, fmt.Errorf("operator %d blames operators %v at %s phase: %s", blame.Blame.OperatorID, blame.Blame.Offenders, blame.Blame.Phase, blame.Blame.Reason))
+			continue
+		}
 		if tsp.Message.Type != wire.OutputMessageType {
 			finalErr = errors.Join(finalErr, fmt.Errorf("wrong DKG result message type: exp %s, got %s ", wire.OutputMessageType.String(), tsp.Message.Type.String()))
 			continue
@@ -339,35 +813,177 @@ func parseDKGResultsFromBytes(responseResult [][]byte, id [24]byte) (dkgResults
 			continue
 		}
 		dkgResults = append(dkgResults, result)
+		resultMsgs[result.OperatorID] = msg
 	}
 	if finalErr != nil {
+		if len(blames) != 0 {
+			phase, offenders := blamedOperators(blames)
+			return nil, newBlameError(id, phase, offenders, finalErr, nil, blames)
+		}
 		return nil, finalErr
 	}
 	// sort the results by operatorID
 	sort.SliceStable(dkgResults, func(i, j int) bool {
 		return dkgResults[i].OperatorID < dkgResults[j].OperatorID
 	})
-	for i := 0; i < len(dkgResults); i++ {
-		if len(dkgResults[i].SignedProof.Proof.ValidatorPubKey) == 0 ||
-			!bytes.Equal(dkgResults[i].SignedProof.Proof.ValidatorPubKey,
-				dkgResults[0].SignedProof.Proof.ValidatorPubKey) {
-			return nil, fmt.Errorf("operator %d sent wrong validator public key", dkgResults[i].OperatorID)
+	wrong, ok := wrongValidatorPubKeys(dkgResults)
+	if !ok {
+		// no operator can be blamed, results of all operators show the disagreement
+		msgs := make([][]byte, 0, len(dkgResults))
+		for _, res := range dkgResults {
+			msgs = append(msgs, resultMsgs[res.OperatorID])
 		}
+		evidence, err := signedEvidence(operators, msgs)
+		if err != nil {
+			return nil, err
+		}
+		return nil, newBlameError(id, "result", nil, fmt.Errorf("no validator public key is sent by a majority of operators"), evidence, nil)
+	}
+	if len(wrong) != 0 {
+		msgs := make([][]byte, 0, len(wrong))
+		for _, opID := range wrong {
+			msgs = append(msgs, resultMsgs[opID])
+		}
+		evidence, err := signedEvidence(operators, msgs)
+		if err != nil {
+			return nil, err
+		}
+		return nil, newBlameError(id, "result", wrong, fmt.Errorf("operators %v sent wrong validator public key", wrong), evidence, nil)
 	}
 	return dkgResults, nil
 }
 
+// parsePartialExitsFromBytes decodes partial exit signatures of operators. Messages which fail to verify
+// are skipped and returned as errors, so that a threshold of valid partial signatures can still sign the exit.
+func parsePartialExitsFromBytes(responseResult [][]byte, id [24]byte, verify VerifyMessageSignatureFunc) (results []*wire.PartialExit, finalErr error) {
+	for _, msg := range responseResult {
+		if err := verifyMessageSignatures(id, [][]byte{msg}, verify); err != nil {
+			finalErr = errors.Join(finalErr, err)
+			continue
+		}
+		tsp := &wire.SignedTransport{}
+		if err := tsp.UnmarshalSSZ(msg); err != nil {
+			finalErr = errors.Join(finalErr, err)
+			continue
+		}
+		if tsp.Message.Type == wire.ErrorMessageType {
+			finalErr = errors.Join(finalErr, fmt.Errorf("%s", string(tsp.Message.Data)))
+			continue
+		}
+		if tsp.Message.Type != wire.PartialExitMessageType {
+			finalErr = errors.Join(finalErr, fmt.Errorf("wrong exit result message type: exp %s, got %s ", wire.PartialExitMessageType.String(), tsp.Message.Type.String()))
+			continue
+		}
+		result := &wire.PartialExit{}
+		if err := result.UnmarshalSSZ(tsp.Message.Data); err != nil {
+			finalErr = errors.Join(finalErr, err)
+			continue
+		}
+		if !bytes.Equal(result.RequestID[:], id[:]) {
+			finalErr = errors.Join(finalErr, fmt.Errorf("exit result has wrong ID, operator ID: %d", result.OperatorID))
+			continue
+		}
+		results = append(results, result)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].OperatorID < results[j].OperatorID
+	})
+	return results, finalErr
+}
+
 // SendInitMsg sends initial DKG ceremony message to participating operators from initiator
 func (c *Initiator) SendInitMsg(init *wire.Init, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
-	signedInitMsgBts, err := c.prepareAndSignMessage(init, wire.InitMessageType, id, c.Version)
+	return c.SendInitMsgWithContext(context.Background(), init, id, operators)
+}
+
+// SendInitMsgWithContext sends initial DKG ceremony message to participating operators, requests are aborted when ctx is done
+func (c *Initiator) SendInitMsgWithContext(ctx context.Context, init *wire.Init, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	var signedInitMsgBts []byte
+	var err error
+	// clusters larger than Init message allows are sent with the versioned InitV2 message
+	if len(init.Operators) > wire.MaxInitOperators {
+		signedInitMsgBts, err = c.prepareAndSignMessage(init.ToV2(), wire.InitV2MessageType, id, c.Version)
+	} else {
+		signedInitMsgBts, err = c.prepareAndSignMessage(init, wire.InitMessageType, id, c.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.SendToAllWithContext(ctx, consts.API_INIT_URL, signedInitMsgBts, operators, false)
+}
+
+// SendReshareMsg sends reshare message to old and new operators participating in resharing ceremony
+func (c *Initiator) SendReshareMsg(reshare *wire.ReshareMessage, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	return c.SendReshareMsgWithContext(context.Background(), reshare, id, operators)
+}
+
+// SendReshareMsgWithContext sends reshare message to old and new operators, requests are aborted when ctx is done
+func (c *Initiator) SendReshareMsgWithContext(ctx context.Context, reshare *wire.ReshareMessage, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	var signedReshareMsgBts []byte
+	var err error
+	// clusters larger than ReshareMessage allows are sent with the versioned ReshareMessageV2 message
+	if reshare.SignedReshare.Reshare.IsV2() {
+		signedReshareMsgBts, err = c.prepareAndSignMessage(reshare.ToV2(), wire.ReshareV2MessageType, id, c.Version)
+	} else {
+		signedReshareMsgBts, err = c.prepareAndSignMessage(reshare, wire.ReshareMessageType, id, c.Version)
+	}
 	if err != nil {
 		return nil, err
 	}
-	return c.SendToAll(consts.API_INIT_URL, signedInitMsgBts, operators, false)
+	return c.SendToAllWithContext(ctx, consts.API_RESHARE_URL, signedReshareMsgBts, operators, false)
+}
+
+// SendResignMsg sends resign message to operators participating in the previous ceremony
+func (c *Initiator) SendResignMsg(resign *wire.ResignMessage, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	return c.SendResignMsgWithContext(context.Background(), resign, id, operators)
+}
+
+// SendResignMsgWithContext sends resign message to operators of the previous ceremony, requests are aborted when ctx is done
+func (c *Initiator) SendResignMsgWithContext(ctx context.Context, resign *wire.ResignMessage, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	var signedResignMsgBts []byte
+	var err error
+	// clusters larger than ResignMessage allows are sent with the versioned ResignMessageV2 message
+	if resign.SignedResign.Resign.IsV2() {
+		signedResignMsgBts, err = c.prepareAndSignMessage(resign.ToV2(), wire.ResignV2MessageType, id, c.Version)
+	} else {
+		signedResignMsgBts, err = c.prepareAndSignMessage(resign, wire.ResignMessageType, id, c.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.SendToAllWithContext(ctx, consts.API_RESIGN_URL, signedResignMsgBts, operators, false)
+}
+
+// SendExitMsg sends voluntary exit message to operators participating in the previous ceremony.
+// Responses of operators which failed are returned as errors.
+func (c *Initiator) SendExitMsg(exit *wire.ExitMessage, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	return c.SendExitMsgWithContext(context.Background(), exit, id, operators)
+}
+
+// SendExitMsgWithContext sends voluntary exit message to operators of the previous ceremony, requests are aborted when ctx is done.
+// Responses of operators which failed are returned as errors.
+func (c *Initiator) SendExitMsgWithContext(ctx context.Context, exit *wire.ExitMessage, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	var signedExitMsgBts []byte
+	var err error
+	// clusters larger than ExitMessage allows are sent with the versioned ExitMessageV2 message
+	if exit.SignedExit.Exit.IsV2() {
+		signedExitMsgBts, err = c.prepareAndSignMessage(exit.ToV2(), wire.ExitV2MessageType, id, c.Version)
+	} else {
+		signedExitMsgBts, err = c.prepareAndSignMessage(exit, wire.ExitMessageType, id, c.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.SendToAllWithContext(ctx, consts.API_EXIT_URL, signedExitMsgBts, operators, true)
 }
 
 // SendExchangeMsgs sends combined exchange messages to each operator participating in DKG ceremony
 func (c *Initiator) SendExchangeMsgs(exchangeMsgs [][]byte, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	return c.SendExchangeMsgsWithContext(context.Background(), exchangeMsgs, id, operators)
+}
+
+// SendExchangeMsgsWithContext sends combined exchange messages to each operator, requests are aborted when ctx is done
+func (c *Initiator) SendExchangeMsgsWithContext(ctx context.Context, exchangeMsgs [][]byte, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
 	mltpl, err := makeMultipleSignedTransports(c.PrivateKey, id, exchangeMsgs)
 	if err != nil {
 		return nil, err
@@ -376,11 +992,16 @@ func (c *Initiator) SendExchangeMsgs(exchangeMsgs [][]byte, id [24]byte, operato
 	if err != nil {
 		return nil, err
 	}
-	return c.SendToAll(consts.API_DKG_URL, mltplbyts, operators, false)
+	return c.SendToAllWithRetryWithContext(ctx, consts.API_DKG_URL, mltplbyts, operators)
 }
 
 // SendKyberMsgs sends combined kyber messages to each operator participating in DKG ceremony
 func (c *Initiator) SendKyberMsgs(kyberDeals [][]byte, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
+	return c.SendKyberMsgsWithContext(context.Background(), kyberDeals, id, operators)
+}
+
+// SendKyberMsgsWithContext sends combined kyber messages to each operator, requests are aborted when ctx is done
+func (c *Initiator) SendKyberMsgsWithContext(ctx context.Context, kyberDeals [][]byte, id [24]byte, operators []*wire.Operator) ([][]byte, error) {
 	mltpl2, err := makeMultipleSignedTransports(c.PrivateKey, id, kyberDeals)
 	if err != nil {
 		return nil, err
@@ -390,15 +1011,15 @@ func (c *Initiator) SendKyberMsgs(kyberDeals [][]byte, id [24]byte, operators []
 	if err != nil {
 		return nil, err
 	}
-	return c.SendToAll(consts.API_DKG_URL, mltpl2byts, operators, false)
+	return c.SendToAllWithRetryWithContext(ctx, consts.API_DKG_URL, mltpl2byts, operators)
 }
 
-func (c *Initiator) sendResult(resData *wire.ResultData, operators []*wire.Operator, method string, id [24]byte) error {
+func (c *Initiator) sendResult(ctx context.Context, resData *wire.ResultData, operators []*wire.Operator, method string, id [24]byte) error {
 	signedMsgBts, err := c.prepareAndSignMessage(resData, wire.ResultMessageType, id, c.Version)
 	if err != nil {
 		return err
 	}
-	_, err = c.SendToAll(method, signedMsgBts, operators, true)
+	_, err = c.SendToAllWithContext(ctx, method, signedMsgBts, operators, true)
 	if err != nil {
 		return err
 	}
@@ -428,6 +1049,50 @@ func (c *Initiator) Ping(ips []string) error {
 	return nil
 }
 
+// CeremoniesStatus requests states of ceremonies running at operators. Operators authorize the request with their status token.
+func (c *Initiator) CeremoniesStatus(ips []string, token string) error {
+	type statusResult struct {
+		ip       string
+		statuses []*wire.CeremonyStatus
+		err      error
+	}
+	resc := make(chan statusResult, len(ips))
+	for _, ip := range ips {
+		go func(ip string) {
+			res := statusResult{ip: ip}
+			resdata, err := c.GetWithToken(wire.OperatorCLI{Addr: ip}, consts.API_CEREMONIES_URL, token)
+			if err != nil {
+				res.err = err
+			} else {
+				res.err = json.Unmarshal(resdata, &res.statuses)
+			}
+			resc <- res
+		}(ip)
+	}
+	for i := 0; i < len(ips); i++ {
+		res := <-resc
+		if res.err != nil {
+			c.Logger.Error("😥 Failed to get ceremonies status: ", zap.Error(res.err), zap.String("IP", res.ip))
+			continue
+		}
+		c.Logger.Info("📋 operator ceremonies", zap.String("IP", res.ip), zap.Int("count", len(res.statuses)))
+		for _, status := range res.statuses {
+			c.Logger.Info("🔄 ceremony",
+				zap.String("IP", res.ip),
+				zap.String("id", status.ID),
+				zap.String("type", status.Type),
+				zap.String("phase", status.Phase),
+				zap.Uint64s("participants", status.Participants),
+				zap.Uint64s("exchanges", status.Exchanges),
+				zap.Bool("started_dkg", status.StartedDKG),
+				zap.String("last_error", status.LastError),
+				zap.String("age", status.Age),
+			)
+		}
+	}
+	return nil
+}
+
 func (c *Initiator) prepareAndSignMessage(msg wire.SSZMarshaller, msgType wire.TransportType, identifier [24]byte, v []byte) ([]byte, error) {
 	// Marshal the provided message
 	marshaledMsg, err := msg.MarshalSSZ()
@@ -1,24 +1,35 @@
 package initiator_test
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/common"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv-dkg/pkgs/consts"
 	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
 	"github.com/bloxapp/ssv-dkg/pkgs/initiator"
+	"github.com/bloxapp/ssv-dkg/pkgs/operator"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
 	"github.com/bloxapp/ssv-dkg/pkgs/utils/test_utils"
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
 	"github.com/bloxapp/ssv/logging"
 )
 
@@ -83,6 +94,68 @@ func TestStartDKG(t *testing.T) {
 		err = crypto.ValidateDepositDataCLI(depositData, withdraw)
 		require.NoError(t, err)
 	})
+	t.Run("happy flow compounding validator", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		intr.WithdrawalPrefix = crypto.CompoundingWithdrawalPrefixByte
+		intr.DepositAmount = 100000000000
+		id := crypto.NewID()
+		depositData, _, _, err := intr.StartDKG(id, withdraw.Bytes(), []uint64{1, 2, 3, 4}, "holesky", owner, 0)
+		require.NoError(t, err)
+		require.Equal(t, phase0.Gwei(100000000000), depositData.Amount)
+		require.Equal(t, hex.EncodeToString(crypto.WithdrawalCredentials(crypto.CompoundingWithdrawalPrefixByte, withdraw.Bytes())), depositData.WithdrawalCredentials)
+		require.NoError(t, crypto.ValidateDepositDataCLI(depositData, withdraw))
+		err = crypto.ValidateDepositDataCLIWithCredentials(depositData, crypto.ETH1WithdrawalCredentials(withdraw.Bytes()), crypto.MaxEffectiveBalanceInGwei)
+		require.ErrorContains(t, err, "failed to verify withdrawal address")
+	})
+	t.Run("happy flow custom network", func(t *testing.T) {
+		require.NoError(t, utils.RegisterNetwork(&utils.NetworkConfig{
+			Name:                  "initiator-devnet",
+			GenesisForkVersion:    []byte{0x10, 0x00, 0x00, 0x38},
+			GenesisValidatorsRoot: make([]byte, 32),
+		}))
+		network, err := utils.GetNetworkByName("initiator-devnet")
+		require.NoError(t, err)
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		id := crypto.NewID()
+		depositData, _, _, err := intr.StartDKG(id, withdraw.Bytes(), []uint64{1, 2, 3, 4}, network, owner, 0)
+		require.NoError(t, err)
+		require.Equal(t, "10000038", depositData.ForkVersion)
+		require.Equal(t, "initiator-devnet", depositData.NetworkName)
+		require.NoError(t, crypto.ValidateDepositDataCLI(depositData, withdraw))
+	})
+	t.Run("happy flow BLS withdrawal credentials", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		withdrawSK := &bls.SecretKey{}
+		withdrawSK.SetByCSPRNG()
+		withdrawPubKey := withdrawSK.GetPublicKey().Serialize()
+		id := crypto.NewID()
+		depositData, _, _, err := intr.StartDKG(id, withdrawPubKey, []uint64{1, 2, 3, 4}, "holesky", owner, 0)
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(crypto.BLSWithdrawalCredentials(withdrawPubKey)), depositData.WithdrawalCredentials)
+		require.NoError(t, crypto.ValidateDepositDataCLIBLS(depositData, withdrawPubKey))
+		require.ErrorContains(t, crypto.ValidateDepositDataCLI(depositData, withdraw), "failed to verify withdrawal address")
+	})
+	t.Run("test compounding validator with BLS withdrawal credentials", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		intr.WithdrawalPrefix = crypto.CompoundingWithdrawalPrefixByte
+		withdrawSK := &bls.SecretKey{}
+		withdrawSK.SetByCSPRNG()
+		id := crypto.NewID()
+		_, _, _, err = intr.StartDKG(id, withdrawSK.GetPublicKey().Serialize(), []uint64{1, 2, 3, 4}, "holesky", owner, 0)
+		require.ErrorContains(t, err, "withdrawal credentials type 0x2 requires a withdrawal address")
+	})
+	t.Run("test wrong deposit amount", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		intr.DepositAmount = 64000000000
+		id := crypto.NewID()
+		_, _, _, err = intr.StartDKG(id, withdraw.Bytes(), []uint64{1, 2, 3, 4}, "mainnet", owner, 0)
+		require.ErrorContains(t, err, "deposit amount is invalid")
+	})
 	t.Run("test wrong amount of opeators < 4", func(t *testing.T) {
 		intr, err := initiator.New(ops, logger, "test.version", rootCert)
 		require.NoError(t, err)
@@ -111,6 +184,601 @@ func TestStartDKG(t *testing.T) {
 	srv4.HttpSrv.Close()
 }
 
+// droppedResponse serves operator routes and drops the connection instead of responding to the n-th dkg message,
+// so that initiator fails to receive the response while operator has already processed the message
+func droppedResponse(handler http.Handler, n int32) http.Handler {
+	var count atomic.Int32
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+consts.API_DKG_URL || count.Add(1) != n {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		_ = conn.Close()
+	})
+}
+
+func TestStartDKGWithRetry(t *testing.T) {
+	err := logging.SetGlobalLogger("debug", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("operator-tests")
+	version := "test.version"
+	srv1 := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	srv2 := test_utils.CreateTestOperatorFromFile(t, 2, examplePath, version, operatorCert, operatorKey)
+	srv3 := test_utils.CreateTestOperatorFromFile(t, 3, examplePath, version, operatorCert, operatorKey)
+	srv4 := test_utils.CreateTestOperatorFromFile(t, 4, examplePath, version, operatorCert, operatorKey)
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	owner := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	startDKG := func(t *testing.T, droppedMsg int32, retries int) error {
+		flakySrv, err := test_utils.NewLocalHTTPSTestServer(droppedResponse(srv4.Srv.Router, droppedMsg), operatorCert, operatorKey)
+		require.NoError(t, err)
+		defer flakySrv.Close()
+		ops := wire.OperatorsCLI{
+			{Addr: srv1.HttpSrv.URL, ID: 1, PubKey: &srv1.PrivKey.PublicKey},
+			{Addr: srv2.HttpSrv.URL, ID: 2, PubKey: &srv2.PrivKey.PublicKey},
+			{Addr: srv3.HttpSrv.URL, ID: 3, PubKey: &srv3.PrivKey.PublicKey},
+			{Addr: flakySrv.URL, ID: 4, PubKey: &srv4.PrivKey.PublicKey},
+		}
+		intr, err := initiator.New(ops, logger, version, rootCert)
+		require.NoError(t, err)
+		intr.PhaseRetries = retries
+		intr.RetryBackoff = 100 * time.Millisecond
+		depositData, keyshares, _, err := intr.StartDKG(crypto.NewID(), withdraw.Bytes(), []uint64{1, 2, 3, 4}, "mainnet", owner, 0)
+		if err != nil {
+			return err
+		}
+		require.NoError(t, test_utils.VerifySharesData([]uint64{1, 2, 3, 4}, []*rsa.PrivateKey{srv1.PrivKey, srv2.PrivKey, srv3.PrivKey, srv4.PrivKey}, keyshares, owner, 0))
+		return crypto.ValidateDepositDataCLI(depositData, withdraw)
+	}
+	t.Run("resend exchange messages", func(t *testing.T) {
+		require.NoError(t, startDKG(t, 1, initiator.DefaultPhaseRetries))
+	})
+	t.Run("resend deal bundles", func(t *testing.T) {
+		require.NoError(t, startDKG(t, 2, initiator.DefaultPhaseRetries))
+	})
+	t.Run("no resends", func(t *testing.T) {
+		err := startDKG(t, 1, 0)
+		require.ErrorContains(t, err, "operator ID: 4")
+	})
+	srv1.HttpSrv.Close()
+	srv2.HttpSrv.Close()
+	srv3.HttpSrv.Close()
+	srv4.HttpSrv.Close()
+}
+
+// stalledResponse serves operator routes and holds dkg messages without responding until released
+func stalledResponse(handler http.Handler, release <-chan struct{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+consts.API_DKG_URL {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+}
+
+func TestStartDKGWithContext(t *testing.T) {
+	err := logging.SetGlobalLogger("debug", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("operator-tests")
+	version := "test.version"
+	srv1 := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	srv2 := test_utils.CreateTestOperatorFromFile(t, 2, examplePath, version, operatorCert, operatorKey)
+	srv3 := test_utils.CreateTestOperatorFromFile(t, 3, examplePath, version, operatorCert, operatorKey)
+	srv4 := test_utils.CreateTestOperatorFromFile(t, 4, examplePath, version, operatorCert, operatorKey)
+	release := make(chan struct{})
+	stalledSrv, err := test_utils.NewLocalHTTPSTestServer(stalledResponse(srv4.Srv.Router, release), operatorCert, operatorKey)
+	require.NoError(t, err)
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	owner := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	ops := wire.OperatorsCLI{
+		{Addr: srv1.HttpSrv.URL, ID: 1, PubKey: &srv1.PrivKey.PublicKey},
+		{Addr: srv2.HttpSrv.URL, ID: 2, PubKey: &srv2.PrivKey.PublicKey},
+		{Addr: srv3.HttpSrv.URL, ID: 3, PubKey: &srv3.PrivKey.PublicKey},
+		{Addr: stalledSrv.URL, ID: 4, PubKey: &srv4.PrivKey.PublicKey},
+	}
+	startDKG := func(t *testing.T, ctx context.Context, phaseTimeout, ceremonyTimeout time.Duration) error {
+		intr, err := initiator.New(ops, logger, version, rootCert)
+		require.NoError(t, err)
+		intr.RetryBackoff = 100 * time.Millisecond
+		intr.PhaseTimeout = phaseTimeout
+		intr.CeremonyTimeout = ceremonyTimeout
+		start := time.Now()
+		_, _, _, err = intr.StartDKGWithContext(ctx, crypto.NewID(), withdraw.Bytes(), []uint64{1, 2, 3, 4}, "mainnet", owner, 0)
+		// the ceremony is aborted long before the http client timeout
+		require.Less(t, time.Since(start), 10*time.Second)
+		return err
+	}
+	t.Run("phase deadline", func(t *testing.T) {
+		err := startDKG(t, context.Background(), time.Second, 0)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorContains(t, err, "operator ID: 4")
+	})
+	t.Run("ceremony deadline", func(t *testing.T) {
+		err := startDKG(t, context.Background(), 0, 2*time.Second)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(time.Second, cancel)
+		err := startDKG(t, ctx, 0, 0)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+	t.Run("canceled before start", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := startDKG(t, ctx, 0, 0)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+	close(release)
+	stalledSrv.Close()
+	srv1.HttpSrv.Close()
+	srv2.HttpSrv.Close()
+	srv3.HttpSrv.Close()
+	srv4.HttpSrv.Close()
+}
+
+func TestStartResharing(t *testing.T) {
+	err := logging.SetGlobalLogger("debug", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("operator-tests")
+	ops := wire.OperatorsCLI{}
+	version := "test.version"
+	srv1 := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	srv2 := test_utils.CreateTestOperatorFromFile(t, 2, examplePath, version, operatorCert, operatorKey)
+	srv3 := test_utils.CreateTestOperatorFromFile(t, 3, examplePath, version, operatorCert, operatorKey)
+	srv4 := test_utils.CreateTestOperatorFromFile(t, 4, examplePath, version, operatorCert, operatorKey)
+	srv5 := test_utils.CreateTestOperatorFromFile(t, 5, examplePath, version, operatorCert, operatorKey)
+	ops = append(
+		ops,
+		wire.OperatorCLI{Addr: srv1.HttpSrv.URL, ID: 1, PubKey: &srv1.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv2.HttpSrv.URL, ID: 2, PubKey: &srv2.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv3.HttpSrv.URL, ID: 3, PubKey: &srv3.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv4.HttpSrv.URL, ID: 4, PubKey: &srv4.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv5.HttpSrv.URL, ID: 5, PubKey: &srv5.PrivKey.PublicKey},
+	)
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	ownerKey, err := eth_crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := eth_crypto.PubkeyToAddress(ownerKey.PublicKey)
+	intr, err := initiator.New(ops, logger, "test.version", rootCert)
+	require.NoError(t, err)
+	depositData, _, proofs, err := intr.StartDKG(crypto.NewID(), withdraw.Bytes(), []uint64{1, 2, 3, 4}, "mainnet", owner, 0)
+	require.NoError(t, err)
+	validatorPK, err := hex.DecodeString(depositData.PubKey)
+	require.NoError(t, err)
+	t.Run("happy flow", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		reshare, err := intr.ConstructReshareMessage([]uint64{1, 2, 3, 4}, []uint64{1, 2, 3, 5}, validatorPK, proofs, owner, 1)
+		require.NoError(t, err)
+		hash, err := reshare.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		keyshares, newProofs, err := intr.StartResharing(crypto.NewID(), &wire.SignedReshare{Reshare: *reshare, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.NoError(t, err)
+		require.Len(t, newProofs, 4)
+		require.Equal(t, "0x"+depositData.PubKey, keyshares.Shares[0].PublicKey)
+		err = test_utils.VerifySharesData([]uint64{1, 2, 3, 5}, []*rsa.PrivateKey{srv1.PrivKey, srv2.PrivKey, srv3.PrivKey, srv5.PrivKey}, keyshares, owner, 1)
+		require.NoError(t, err)
+	})
+	t.Run("test wrong owner signature", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		reshare, err := intr.ConstructReshareMessage([]uint64{1, 2, 3, 4}, []uint64{1, 2, 3, 5}, validatorPK, proofs, owner, 1)
+		require.NoError(t, err)
+		hash, err := reshare.HashTreeRoot()
+		require.NoError(t, err)
+		wrongKey, err := eth_crypto.GenerateKey()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], wrongKey)
+		require.NoError(t, err)
+		_, _, err = intr.StartResharing(crypto.NewID(), &wire.SignedReshare{Reshare: *reshare, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.ErrorContains(t, err, "invalid owner signature")
+	})
+	t.Run("test same old and new operators", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		reshare, err := intr.ConstructReshareMessage([]uint64{1, 2, 3, 4}, []uint64{1, 2, 3, 4}, validatorPK, proofs, owner, 1)
+		require.NoError(t, err)
+		_, _, err = intr.StartResharing(crypto.NewID(), &wire.SignedReshare{Reshare: *reshare}, proofs, withdraw.Bytes(), "mainnet")
+		require.ErrorContains(t, err, "old and new operators are the same")
+	})
+
+	srv1.HttpSrv.Close()
+	srv2.HttpSrv.Close()
+	srv3.HttpSrv.Close()
+	srv4.HttpSrv.Close()
+	srv5.HttpSrv.Close()
+}
+
+func TestStartResigning(t *testing.T) {
+	err := logging.SetGlobalLogger("debug", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("operator-tests")
+	ops := wire.OperatorsCLI{}
+	version := "test.version"
+	srv1 := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	srv2 := test_utils.CreateTestOperatorFromFile(t, 2, examplePath, version, operatorCert, operatorKey)
+	srv3 := test_utils.CreateTestOperatorFromFile(t, 3, examplePath, version, operatorCert, operatorKey)
+	srv4 := test_utils.CreateTestOperatorFromFile(t, 4, examplePath, version, operatorCert, operatorKey)
+	ops = append(
+		ops,
+		wire.OperatorCLI{Addr: srv1.HttpSrv.URL, ID: 1, PubKey: &srv1.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv2.HttpSrv.URL, ID: 2, PubKey: &srv2.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv3.HttpSrv.URL, ID: 3, PubKey: &srv3.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv4.HttpSrv.URL, ID: 4, PubKey: &srv4.PrivKey.PublicKey},
+	)
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	ownerKey, err := eth_crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := eth_crypto.PubkeyToAddress(ownerKey.PublicKey)
+	newOwner := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	intr, err := initiator.New(ops, logger, "test.version", rootCert)
+	require.NoError(t, err)
+	depositData, _, proofs, err := intr.StartDKG(crypto.NewID(), withdraw.Bytes(), []uint64{1, 2, 3, 4}, "mainnet", owner, 0)
+	require.NoError(t, err)
+	validatorPK, err := hex.DecodeString(depositData.PubKey)
+	require.NoError(t, err)
+	t.Run("happy flow", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		resign, err := intr.ConstructResignMessage([]uint64{1, 2, 3, 4}, validatorPK, newOwner, 5)
+		require.NoError(t, err)
+		hash, err := resign.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		keyshares, newProofs, err := intr.StartResigning(crypto.NewID(), &wire.SignedResign{Resign: *resign, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.NoError(t, err)
+		require.Len(t, newProofs, 4)
+		require.Equal(t, "0x"+depositData.PubKey, keyshares.Shares[0].PublicKey)
+		for i, p := range newProofs {
+			require.Equal(t, [20]byte(newOwner), p.Proof.Owner)
+			require.Equal(t, proofs[i].Proof.EncryptedShare, p.Proof.EncryptedShare)
+		}
+		err = test_utils.VerifySharesData([]uint64{1, 2, 3, 4}, []*rsa.PrivateKey{srv1.PrivKey, srv2.PrivKey, srv3.PrivKey, srv4.PrivKey}, keyshares, newOwner, 5)
+		require.NoError(t, err)
+		// operators keep both ceremony and resign shares
+		for _, srv := range []*test_utils.TestOperator{srv1, srv2, srv3, srv4} {
+			require.Len(t, srv.Srv.State.Shares.ByValidator(validatorPK), 2)
+			stored := srv.Srv.State.Shares.ByOwner(newOwner)
+			require.Len(t, stored, 1)
+			require.Equal(t, uint64(5), stored[0].Nonce)
+		}
+	})
+	t.Run("happy flow compounding validator", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		intr.WithdrawalPrefix = crypto.CompoundingWithdrawalPrefixByte
+		intr.DepositAmount = 100000000000
+		resign, err := intr.ConstructResignMessage([]uint64{1, 2, 3, 4}, validatorPK, newOwner, 6)
+		require.NoError(t, err)
+		hash, err := resign.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		_, newProofs, err := intr.StartResigning(crypto.NewID(), &wire.SignedResign{Resign: *resign, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.NoError(t, err)
+		require.Len(t, newProofs, 4)
+	})
+	t.Run("test wrong deposit amount", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		intr.DepositAmount = 33000000000
+		resign, err := intr.ConstructResignMessage([]uint64{1, 2, 3, 4}, validatorPK, newOwner, 6)
+		require.NoError(t, err)
+		_, _, err = intr.StartResigning(crypto.NewID(), &wire.SignedResign{Resign: *resign}, proofs, withdraw.Bytes(), "mainnet")
+		require.ErrorContains(t, err, "deposit amount is invalid")
+	})
+	t.Run("test wrong owner signature", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		resign, err := intr.ConstructResignMessage([]uint64{1, 2, 3, 4}, validatorPK, newOwner, 5)
+		require.NoError(t, err)
+		hash, err := resign.HashTreeRoot()
+		require.NoError(t, err)
+		wrongKey, err := eth_crypto.GenerateKey()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], wrongKey)
+		require.NoError(t, err)
+		_, _, err = intr.StartResigning(crypto.NewID(), &wire.SignedResign{Resign: *resign, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.ErrorContains(t, err, "invalid owner signature")
+	})
+	t.Run("happy flow share missing at operator store", func(t *testing.T) {
+		shares := srv1.Srv.State.Shares
+		defer func() { srv1.Srv.State.Shares = shares }()
+		srv1.Srv.State.Shares, err = operator.NewShareStore(t.TempDir())
+		require.NoError(t, err)
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		resign, err := intr.ConstructResignMessage([]uint64{1, 2, 3, 4}, validatorPK, newOwner, 6)
+		require.NoError(t, err)
+		hash, err := resign.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		_, newProofs, err := intr.StartResigning(crypto.NewID(), &wire.SignedResign{Resign: *resign, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.NoError(t, err)
+		require.Len(t, newProofs, 4)
+		// the share sent by initiator is used and stored
+		stored := srv1.Srv.State.Shares.ByValidator(validatorPK)
+		require.Len(t, stored, 1)
+		require.Equal(t, proofs[0].Proof.EncryptedShare, stored[0].Proof.Proof.EncryptedShare)
+	})
+
+	srv1.HttpSrv.Close()
+	srv2.HttpSrv.Close()
+	srv3.HttpSrv.Close()
+	srv4.HttpSrv.Close()
+}
+
+func TestStartExit(t *testing.T) {
+	err := logging.SetGlobalLogger("debug", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("operator-tests")
+	ops := wire.OperatorsCLI{}
+	version := "test.version"
+	srv1 := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	srv2 := test_utils.CreateTestOperatorFromFile(t, 2, examplePath, version, operatorCert, operatorKey)
+	srv3 := test_utils.CreateTestOperatorFromFile(t, 3, examplePath, version, operatorCert, operatorKey)
+	srv4 := test_utils.CreateTestOperatorFromFile(t, 4, examplePath, version, operatorCert, operatorKey)
+	ops = append(
+		ops,
+		wire.OperatorCLI{Addr: srv1.HttpSrv.URL, ID: 1, PubKey: &srv1.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv2.HttpSrv.URL, ID: 2, PubKey: &srv2.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv3.HttpSrv.URL, ID: 3, PubKey: &srv3.PrivKey.PublicKey},
+		wire.OperatorCLI{Addr: srv4.HttpSrv.URL, ID: 4, PubKey: &srv4.PrivKey.PublicKey},
+	)
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	ownerKey, err := eth_crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := eth_crypto.PubkeyToAddress(ownerKey.PublicKey)
+	intr, err := initiator.New(ops, logger, "test.version", rootCert)
+	require.NoError(t, err)
+	depositData, _, proofs, err := intr.StartDKG(crypto.NewID(), withdraw.Bytes(), []uint64{1, 2, 3, 4}, "mainnet", owner, 0)
+	require.NoError(t, err)
+	validatorPK, err := hex.DecodeString(depositData.PubKey)
+	require.NoError(t, err)
+	signExit := func(t *testing.T, key *ecdsa.PrivateKey) *wire.SignedExit {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		exit, err := intr.ConstructExitMessage([]uint64{1, 2, 3, 4}, validatorPK, 12345, 256, "mainnet")
+		require.NoError(t, err)
+		hash, err := exit.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], key)
+		require.NoError(t, err)
+		return &wire.SignedExit{Exit: *exit, Signature: sig}
+	}
+	t.Run("happy flow", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		signedExit, err := intr.StartExit(crypto.NewID(), signExit(t, ownerKey), proofs)
+		require.NoError(t, err)
+		require.Equal(t, phase0.ValidatorIndex(12345), signedExit.Message.ValidatorIndex)
+		require.Equal(t, phase0.Epoch(256), signedExit.Message.Epoch)
+		require.NoError(t, crypto.VerifyVoluntaryExit("mainnet", validatorPK, signedExit))
+	})
+	t.Run("test wrong owner signature", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		wrongKey, err := eth_crypto.GenerateKey()
+		require.NoError(t, err)
+		_, err = intr.StartExit(crypto.NewID(), signExit(t, wrongKey), proofs)
+		require.ErrorContains(t, err, "invalid owner signature")
+	})
+	t.Run("test invalid response of one operator", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		verify := intr.VerifyMessageSignature
+		intr.VerifyMessageSignature = func(pub *rsa.PublicKey, msg, sig []byte) error {
+			if pub.Equal(&srv2.PrivKey.PublicKey) {
+				return errors.New("invalid signature")
+			}
+			return verify(pub, msg, sig)
+		}
+		signedExit, err := intr.StartExit(crypto.NewID(), signExit(t, ownerKey), proofs)
+		require.NoError(t, err)
+		require.NoError(t, crypto.VerifyVoluntaryExit("mainnet", validatorPK, signedExit))
+	})
+	t.Run("test threshold of operators online", func(t *testing.T) {
+		srv4.HttpSrv.Close()
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		signedExit, err := intr.StartExit(crypto.NewID(), signExit(t, ownerKey), proofs)
+		require.NoError(t, err)
+		require.NoError(t, crypto.VerifyVoluntaryExit("mainnet", validatorPK, signedExit))
+	})
+	t.Run("test less than threshold of valid responses", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, "test.version", rootCert)
+		require.NoError(t, err)
+		verify := intr.VerifyMessageSignature
+		intr.VerifyMessageSignature = func(pub *rsa.PublicKey, msg, sig []byte) error {
+			if pub.Equal(&srv2.PrivKey.PublicKey) {
+				return errors.New("invalid signature")
+			}
+			return verify(pub, msg, sig)
+		}
+		_, err = intr.StartExit(crypto.NewID(), signExit(t, ownerKey), proofs)
+		require.ErrorContains(t, err, "not enough partial exit signatures")
+	})
+
+	srv1.HttpSrv.Close()
+	srv2.HttpSrv.Close()
+	srv3.HttpSrv.Close()
+}
+
+func TestStartDKGCustomThreshold(t *testing.T) {
+	err := logging.SetGlobalLogger("debug", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("operator-tests")
+	ops := wire.OperatorsCLI{}
+	version := "test.version"
+	var servers []*test_utils.TestOperator
+	for i := 1; i <= 16; i++ {
+		srv := test_utils.CreateTestOperator(t, uint64(i), version, operatorCert, operatorKey)
+		srv.Srv.State.ThresholdPolicy = spec.CustomThresholdPolicy
+		servers = append(servers, srv)
+		ops = append(ops, wire.OperatorCLI{Addr: srv.HttpSrv.URL, ID: uint64(i), PubKey: &srv.PrivKey.PublicKey})
+	}
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	owner := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	startDKG := func(t *testing.T, n int, threshold uint64) (*wire.KeySharesCLI, []uint64, error) {
+		intr, err := initiator.New(ops, logger, version, rootCert)
+		require.NoError(t, err)
+		intr.ThresholdPolicy = spec.CustomThresholdPolicy
+		intr.Threshold = threshold
+		ids := make([]uint64, n)
+		for i := range ids {
+			ids[i] = uint64(i + 1)
+		}
+		depositData, keyshares, _, err := intr.StartDKG(crypto.NewID(), withdraw.Bytes(), ids, "mainnet", owner, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		require.NoError(t, crypto.ValidateDepositDataCLI(depositData, withdraw))
+		return keyshares, ids, nil
+	}
+	verifyShares := func(t *testing.T, ids []uint64, keyshares *wire.KeySharesCLI) {
+		var keys []*rsa.PrivateKey
+		for _, id := range ids {
+			keys = append(keys, servers[id-1].PrivKey)
+		}
+		require.NoError(t, test_utils.VerifySharesData(ids, keys, keyshares, owner, 0))
+	}
+	t.Run("5 operators with threshold 3", func(t *testing.T) {
+		keyshares, ids, err := startDKG(t, 5, 3)
+		require.NoError(t, err)
+		verifyShares(t, ids, keyshares)
+	})
+	t.Run("16 operators with default threshold", func(t *testing.T) {
+		keyshares, ids, err := startDKG(t, 16, 0)
+		require.NoError(t, err)
+		verifyShares(t, ids, keyshares)
+	})
+	t.Run("resign, reshare and exit of 16 operators", func(t *testing.T) {
+		ownerKey, err := eth_crypto.GenerateKey()
+		require.NoError(t, err)
+		owner := eth_crypto.PubkeyToAddress(ownerKey.PublicKey)
+		intr, err := initiator.New(ops, logger, version, rootCert)
+		require.NoError(t, err)
+		intr.ThresholdPolicy = spec.CustomThresholdPolicy
+		ids := make([]uint64, 16)
+		for i := range ids {
+			ids[i] = uint64(i + 1)
+		}
+		depositData, _, proofs, err := intr.StartDKG(crypto.NewID(), withdraw.Bytes(), ids, "mainnet", owner, 0)
+		require.NoError(t, err)
+		validatorPK, err := hex.DecodeString(depositData.PubKey)
+		require.NoError(t, err)
+
+		resign, err := intr.ConstructResignMessage(ids, validatorPK, owner, 1)
+		require.NoError(t, err)
+		require.True(t, resign.IsV2())
+		hash, err := resign.SigningRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		_, proofs, err = intr.StartResigning(crypto.NewID(), &wire.SignedResign{Resign: *resign, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.NoError(t, err)
+		require.Len(t, proofs, 16)
+
+		newIDs := ids[:15]
+		reshare, err := intr.ConstructReshareMessage(ids, newIDs, validatorPK, proofs, owner, 2)
+		require.NoError(t, err)
+		require.True(t, reshare.IsV2())
+		hash, err = reshare.SigningRoot()
+		require.NoError(t, err)
+		sig, err = eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		_, proofs, err = intr.StartResharing(crypto.NewID(), &wire.SignedReshare{Reshare: *reshare, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.NoError(t, err)
+		require.Len(t, proofs, 15)
+
+		exit, err := intr.ConstructExitMessage(newIDs, validatorPK, 12345, 256, "mainnet")
+		require.NoError(t, err)
+		require.True(t, exit.IsV2())
+		hash, err = exit.SigningRoot()
+		require.NoError(t, err)
+		sig, err = eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		signedExit, err := intr.StartExit(crypto.NewID(), &wire.SignedExit{Exit: *exit, Signature: sig}, proofs)
+		require.NoError(t, err)
+		require.NoError(t, crypto.VerifyVoluntaryExit("mainnet", validatorPK, signedExit))
+	})
+	t.Run("reshare and exit with custom threshold", func(t *testing.T) {
+		ownerKey, err := eth_crypto.GenerateKey()
+		require.NoError(t, err)
+		owner := eth_crypto.PubkeyToAddress(ownerKey.PublicKey)
+		intr, err := initiator.New(ops, logger, version, rootCert)
+		require.NoError(t, err)
+		intr.ThresholdPolicy = spec.CustomThresholdPolicy
+		intr.Threshold = 3
+		depositData, _, proofs, err := intr.StartDKG(crypto.NewID(), withdraw.Bytes(), []uint64{1, 2, 3, 4, 5}, "mainnet", owner, 0)
+		require.NoError(t, err)
+		validatorPK, err := hex.DecodeString(depositData.PubKey)
+		require.NoError(t, err)
+
+		intr, err = initiator.New(ops, logger, version, rootCert)
+		require.NoError(t, err)
+		intr.ThresholdPolicy = spec.CustomThresholdPolicy
+		intr.Threshold = 4
+		reshare, err := intr.ConstructReshareMessage([]uint64{1, 2, 3, 4, 5}, []uint64{1, 2, 3, 4, 6}, validatorPK, proofs, owner, 1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), reshare.OldT)
+		require.Equal(t, uint64(4), reshare.NewT)
+		hash, err := reshare.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		_, newProofs, err := intr.StartResharing(crypto.NewID(), &wire.SignedReshare{Reshare: *reshare, Signature: sig}, proofs, withdraw.Bytes(), "mainnet")
+		require.NoError(t, err)
+
+		intr, err = initiator.New(ops, logger, version, rootCert)
+		require.NoError(t, err)
+		intr.ThresholdPolicy = spec.CustomThresholdPolicy
+		exit, err := intr.ConstructExitMessage([]uint64{1, 2, 3, 4, 6}, validatorPK, 12345, 256, "mainnet")
+		require.NoError(t, err)
+		hash, err = exit.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err = eth_crypto.Sign(hash[:], ownerKey)
+		require.NoError(t, err)
+		// threshold of 4 new operators is enough to sign the exit
+		servers[5].HttpSrv.Close()
+		signedExit, err := intr.StartExit(crypto.NewID(), &wire.SignedExit{Exit: *exit, Signature: sig}, newProofs)
+		require.NoError(t, err)
+		require.NoError(t, crypto.VerifyVoluntaryExit("mainnet", validatorPK, signedExit))
+	})
+	t.Run("threshold below majority of operators", func(t *testing.T) {
+		_, _, err := startDKG(t, 5, 2)
+		require.ErrorContains(t, err, "threshold set is invalid")
+	})
+	t.Run("operator with ssv policy", func(t *testing.T) {
+		servers[0].Srv.State.ThresholdPolicy = spec.SSVThresholdPolicy
+		defer func() { servers[0].Srv.State.ThresholdPolicy = spec.CustomThresholdPolicy }()
+		_, _, err := startDKG(t, 5, 3)
+		require.ErrorContains(t, err, "threshold set is invalid")
+	})
+	t.Run("initiator with ssv policy", func(t *testing.T) {
+		intr, err := initiator.New(ops, logger, version, rootCert)
+		require.NoError(t, err)
+		_, _, _, err = intr.StartDKG(crypto.NewID(), withdraw.Bytes(), []uint64{1, 2, 3, 4, 5}, "mainnet", owner, 0)
+		require.ErrorContains(t, err, "amount of operators should be 4,7,10,13")
+	})
+	for _, srv := range servers {
+		srv.HttpSrv.Close()
+	}
+}
+
 func TestLoadOperators(t *testing.T) {
 	t.Run("test load happy flow", func(t *testing.T) {
 		var ops wire.OperatorsCLI
@@ -0,0 +1,267 @@
+package initiator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
+)
+
+// JournalFile is a file name of the ceremony journal at the initiator's output path
+const JournalFile = "ceremony-journal.json"
+
+// CeremonyState is a state of a single ceremony of a batch recorded at the journal
+type CeremonyState string
+
+const (
+	CeremonyStarted   CeremonyState = "started"
+	CeremonyCompleted CeremonyState = "completed"
+	CeremonyFailed    CeremonyState = "failed"
+)
+
+// JournalParams are parameters of a batch of ceremonies. A journal can be resumed only with the same parameters.
+type JournalParams struct {
+	OperatorIDs     []uint64       `json:"operator_ids"`
+	Owner           common.Address `json:"owner"`
+	WithdrawAddress common.Address `json:"withdraw_address"`
+	Network         string         `json:"network"`
+	Nonce           uint64         `json:"nonce"`
+	Validators      uint64         `json:"validators"`
+	// WithdrawalPrefix and DepositAmount of validators
+	WithdrawalPrefix byte   `json:"withdrawal_prefix"`
+	DepositAmount    uint64 `json:"deposit_amount"`
+	// WithdrawPubKey is a BLS withdrawal public key of validators with 0x00 withdrawal credentials
+	WithdrawPubKey hexutil.Bytes `json:"withdraw_pubkey,omitempty"`
+	// ThresholdPolicy and Threshold of ceremonies, the threshold is computed following 3f+1 tolerance if not set
+	ThresholdPolicy spec.ThresholdPolicy `json:"threshold_policy"`
+	Threshold       uint64               `json:"threshold"`
+}
+
+// journalParamsFields are parameters which journals have to record, zero values of them are valid
+var journalParamsFields = []string{"withdrawal_prefix", "deposit_amount", "threshold_policy", "threshold"}
+
+func (p JournalParams) equal(other JournalParams) bool {
+	return slices.Equal(p.OperatorIDs, other.OperatorIDs) &&
+		p.Owner == other.Owner &&
+		p.WithdrawAddress == other.WithdrawAddress &&
+		p.Network == other.Network &&
+		p.Nonce == other.Nonce &&
+		p.Validators == other.Validators &&
+		p.WithdrawalPrefix == other.WithdrawalPrefix &&
+		p.DepositAmount == other.DepositAmount &&
+		bytes.Equal(p.WithdrawPubKey, other.WithdrawPubKey) &&
+		p.ThresholdPolicy == other.ThresholdPolicy &&
+		p.Threshold == other.Threshold
+}
+
+// JournalEntry records a ceremony of a nonce, its state and results when the ceremony is completed
+type JournalEntry struct {
+	Nonce       uint64               `json:"nonce"`
+	RequestID   string               `json:"request_id"`
+	State       CeremonyState        `json:"state"`
+	OperatorIDs []uint64             `json:"operator_ids"` // operators of the ceremony, differ from operators of the batch if operators were substituted
+	Error       string               `json:"error,omitempty"`
+	DepositData *wire.DepositDataCLI `json:"deposit_data,omitempty"`
+	KeyShares   *wire.KeySharesCLI   `json:"keyshares,omitempty"`
+	Proofs      []*wire.SignedProof  `json:"proofs,omitempty"`
+}
+
+// ID returns the request ID of the ceremony
+func (e *JournalEntry) ID() ([24]byte, error) {
+	var id [24]byte
+	b, err := hex.DecodeString(e.RequestID)
+	if err != nil {
+		return id, fmt.Errorf("invalid request ID: %w", err)
+	}
+	if len(b) != len(id) {
+		return id, fmt.Errorf("invalid request ID length %d", len(b))
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+type journalJSON struct {
+	Params     JournalParams   `json:"params"`
+	Ceremonies []*JournalEntry `json:"ceremonies"`
+}
+
+// Journal records ceremonies of a batch as they progress, so that an interrupted batch can be resumed
+// running only ceremonies which weren't completed. The journal is rewritten on disk on every change.
+type Journal struct {
+	mtx     sync.Mutex
+	path    string
+	params  JournalParams
+	entries map[uint64]*JournalEntry
+}
+
+// NewJournal creates an empty journal for the batch at the directory. A previous journal at the directory
+// records ceremonies which may be still needed, so it is replaced only if overwrite is set.
+func NewJournal(dir string, params JournalParams, overwrite bool) (*Journal, error) {
+	path := filepath.Join(dir, JournalFile)
+	if !overwrite {
+		if _, err := os.Stat(path); err == nil {
+			return nil, fmt.Errorf("ceremony journal already exists at %s, resume the batch or remove the journal", path)
+		} else if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to check ceremony journal: %w", err)
+		}
+	}
+	j := &Journal{
+		path:    path,
+		params:  params,
+		entries: make(map[uint64]*JournalEntry),
+	}
+	if err := j.write(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// OpenJournal loads a journal from the directory. The journal should be created for the batch with the same parameters.
+func OpenJournal(dir string, params JournalParams) (*Journal, error) {
+	path := filepath.Join(dir, JournalFile)
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ceremony journal: %w", err)
+	}
+	var stored journalJSON
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse ceremony journal: %w", err)
+	}
+	var fields struct {
+		Params map[string]json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse ceremony journal: %w", err)
+	}
+	for _, field := range journalParamsFields {
+		if _, ok := fields.Params[field]; !ok {
+			return nil, fmt.Errorf("ceremony journal is missing the %s parameter", field)
+		}
+	}
+	if !stored.Params.equal(params) {
+		return nil, fmt.Errorf("ceremony journal was created for a batch with different parameters: %+v", stored.Params)
+	}
+	j := &Journal{
+		path:    path,
+		params:  params,
+		entries: make(map[uint64]*JournalEntry),
+	}
+	for _, entry := range stored.Ceremonies {
+		if entry.Nonce < params.Nonce || entry.Nonce >= params.Nonce+params.Validators {
+			return nil, fmt.Errorf("ceremony journal has a nonce %d out of the batch", entry.Nonce)
+		}
+		if entry.State == CeremonyCompleted && (entry.DepositData == nil || entry.KeyShares == nil || len(entry.Proofs) == 0) {
+			return nil, fmt.Errorf("ceremony journal is missing results of the nonce %d", entry.Nonce)
+		}
+		if len(entry.OperatorIDs) == 0 {
+			return nil, fmt.Errorf("ceremony journal is missing operators of the nonce %d", entry.Nonce)
+		}
+		j.entries[entry.Nonce] = entry
+	}
+	return j, nil
+}
+
+// Start records a start of the ceremony for the nonce with the operators
+func (j *Journal) Start(nonce uint64, id [24]byte, operatorIDs []uint64) error {
+	return j.record(&JournalEntry{
+		Nonce:       nonce,
+		RequestID:   hex.EncodeToString(id[:]),
+		State:       CeremonyStarted,
+		OperatorIDs: operatorIDs,
+	})
+}
+
+// Complete records results of the completed ceremony for the nonce with the operators
+func (j *Journal) Complete(nonce uint64, id [24]byte, operatorIDs []uint64, depositData *wire.DepositDataCLI, keyShares *wire.KeySharesCLI, proofs []*wire.SignedProof) error {
+	return j.record(&JournalEntry{
+		Nonce:       nonce,
+		RequestID:   hex.EncodeToString(id[:]),
+		State:       CeremonyCompleted,
+		OperatorIDs: operatorIDs,
+		DepositData: depositData,
+		KeyShares:   keyShares,
+		Proofs:      proofs,
+	})
+}
+
+// Fail records an error of the failed ceremony for the nonce with the operators
+func (j *Journal) Fail(nonce uint64, id [24]byte, operatorIDs []uint64, ceremonyErr error) error {
+	return j.record(&JournalEntry{
+		Nonce:       nonce,
+		RequestID:   hex.EncodeToString(id[:]),
+		State:       CeremonyFailed,
+		OperatorIDs: operatorIDs,
+		Error:       ceremonyErr.Error(),
+	})
+}
+
+// Completed returns the journal entry of the nonce if its ceremony is completed
+func (j *Journal) Completed(nonce uint64) (*JournalEntry, bool) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	entry, ok := j.entries[nonce]
+	if !ok || entry.State != CeremonyCompleted {
+		return nil, false
+	}
+	return entry, true
+}
+
+// Remove deletes the journal file after results of the whole batch are written
+func (j *Journal) Remove() error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	if err := os.Remove(j.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove ceremony journal: %w", err)
+	}
+	return nil
+}
+
+func (j *Journal) record(entry *JournalEntry) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	if entry.Nonce < j.params.Nonce || entry.Nonce >= j.params.Nonce+j.params.Validators {
+		return fmt.Errorf("nonce %d is out of the batch", entry.Nonce)
+	}
+	if prev, ok := j.entries[entry.Nonce]; ok && prev.State == CeremonyCompleted {
+		return fmt.Errorf("ceremony for the nonce %d is already completed", entry.Nonce)
+	}
+	j.entries[entry.Nonce] = entry
+	return j.write()
+}
+
+// write saves the journal to a temporary file first so that a crash doesnt leave a partially written journal
+func (j *Journal) write() error {
+	stored := journalJSON{
+		Params:     j.params,
+		Ceremonies: make([]*JournalEntry, 0, len(j.entries)),
+	}
+	for _, entry := range j.entries {
+		stored.Ceremonies = append(stored.Ceremonies, entry)
+	}
+	sort.Slice(stored.Ceremonies, func(i, k int) bool {
+		return stored.Ceremonies[i].Nonce < stored.Ceremonies[k].Nonce
+	})
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal ceremony journal: %w", err)
+	}
+	tmp := j.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write ceremony journal: %w", err)
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return fmt.Errorf("failed to write ceremony journal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package initiator
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
+)
+
+func TestJournal(t *testing.T) {
+	dir, err := os.MkdirTemp("", "dkg-journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	params := JournalParams{
+		OperatorIDs:      []uint64{1, 2, 3, 4},
+		Owner:            common.HexToAddress("0x0000000000000000000000000000000000000007"),
+		WithdrawAddress:  common.HexToAddress("0x0000000000000000000000000000000000000009"),
+		Network:          "holesky",
+		Nonce:            10,
+		Validators:       3,
+		WithdrawalPrefix: crypto.ETH1WithdrawalPrefixByte,
+		DepositAmount:    uint64(crypto.MaxEffectiveBalanceInGwei),
+	}
+	journal, err := NewJournal(dir, params, false)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(dir, JournalFile))
+
+	id1, id2, id3 := [24]byte{1}, [24]byte{2}, [24]byte{3}
+	depositData := &wire.DepositDataCLI{PubKey: "aa"}
+	keyShares := &wire.KeySharesCLI{Version: "v1", Shares: []wire.Data{{}}}
+	proofs := []*wire.SignedProof{{Proof: &wire.Proof{Owner: params.Owner}, Signature: []byte{1}}}
+	require.NoError(t, journal.Start(10, id1, params.OperatorIDs))
+	require.NoError(t, journal.Start(11, id2, params.OperatorIDs))
+	require.NoError(t, journal.Start(12, id3, params.OperatorIDs))
+	require.NoError(t, journal.Complete(10, id1, params.OperatorIDs, depositData, keyShares, proofs))
+	require.NoError(t, journal.Fail(11, id2, params.OperatorIDs, errors.New("operator timeout")))
+
+	t.Run("test nonce out of batch", func(t *testing.T) {
+		require.ErrorContains(t, journal.Start(13, id1, params.OperatorIDs), "out of the batch")
+		require.ErrorContains(t, journal.Start(9, id1, params.OperatorIDs), "out of the batch")
+	})
+	t.Run("test completed ceremony cant be restarted", func(t *testing.T) {
+		require.ErrorContains(t, journal.Start(10, id2, params.OperatorIDs), "already completed")
+	})
+	t.Run("test resume", func(t *testing.T) {
+		resumed, err := OpenJournal(dir, params)
+		require.NoError(t, err)
+		entry, ok := resumed.Completed(10)
+		require.True(t, ok)
+		id, err := entry.ID()
+		require.NoError(t, err)
+		require.Equal(t, id1, id)
+		require.Equal(t, depositData.PubKey, entry.DepositData.PubKey)
+		require.Equal(t, keyShares.Version, entry.KeyShares.Version)
+		require.Equal(t, params.Owner, common.Address(entry.Proofs[0].Proof.Owner))
+		require.Equal(t, params.OperatorIDs, entry.OperatorIDs)
+		_, ok = resumed.Completed(11)
+		require.False(t, ok)
+		_, ok = resumed.Completed(12)
+		require.False(t, ok)
+		// failed and interrupted ceremonies are run again with a new request ID
+		// operator 4 is substituted by a standby operator
+		substituted := []uint64{1, 2, 3, 5}
+		require.NoError(t, resumed.Start(11, id3, substituted))
+		require.NoError(t, resumed.Complete(11, id3, substituted, depositData, keyShares, proofs))
+		resumed, err = OpenJournal(dir, params)
+		require.NoError(t, err)
+		entry, ok = resumed.Completed(11)
+		require.True(t, ok)
+		require.Equal(t, substituted, entry.OperatorIDs)
+	})
+	t.Run("test resume with different parameters", func(t *testing.T) {
+		other := params
+		other.Nonce = 11
+		_, err := OpenJournal(dir, other)
+		require.ErrorContains(t, err, "different parameters")
+		other = params
+		other.OperatorIDs = []uint64{1, 2, 3, 5}
+		_, err = OpenJournal(dir, other)
+		require.ErrorContains(t, err, "different parameters")
+	})
+	t.Run("test resume with different threshold", func(t *testing.T) {
+		other := params
+		other.ThresholdPolicy = spec.CustomThresholdPolicy
+		_, err := OpenJournal(dir, other)
+		require.ErrorContains(t, err, "different parameters")
+		other = params
+		other.Threshold = 4
+		_, err = OpenJournal(dir, other)
+		require.ErrorContains(t, err, "different parameters")
+	})
+	writeJournal := func(t *testing.T, stored any) {
+		b, err := json.Marshal(stored)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, JournalFile), b, 0o600))
+	}
+	t.Run("test journal missing fields", func(t *testing.T) {
+		data, err := os.ReadFile(filepath.Join(dir, JournalFile))
+		require.NoError(t, err)
+		for _, field := range []string{"withdrawal_prefix", "deposit_amount", "threshold_policy", "threshold"} {
+			var stored map[string]any
+			require.NoError(t, json.Unmarshal(data, &stored))
+			delete(stored["params"].(map[string]any), field)
+			writeJournal(t, stored)
+			_, err := OpenJournal(dir, params)
+			require.ErrorContains(t, err, "ceremony journal is missing the "+field+" parameter")
+		}
+		var stored map[string]any
+		require.NoError(t, json.Unmarshal(data, &stored))
+		ceremonies := stored["ceremonies"].([]any)
+		delete(ceremonies[0].(map[string]any), "operator_ids")
+		writeJournal(t, stored)
+		_, err = OpenJournal(dir, params)
+		require.ErrorContains(t, err, "ceremony journal is missing operators of the nonce")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, JournalFile), data, 0o600))
+	})
+	t.Run("test existing journal isnt replaced", func(t *testing.T) {
+		_, err := NewJournal(dir, params, false)
+		require.ErrorContains(t, err, "ceremony journal already exists")
+		_, ok := journal.Completed(10)
+		require.True(t, ok)
+		resumed, err := OpenJournal(dir, params)
+		require.NoError(t, err)
+		_, ok = resumed.Completed(10)
+		require.True(t, ok)
+	})
+	t.Run("test overwrite existing journal", func(t *testing.T) {
+		overwriteDir := t.TempDir()
+		_, err := NewJournal(overwriteDir, params, false)
+		require.NoError(t, err)
+		replaced, err := NewJournal(overwriteDir, params, true)
+		require.NoError(t, err)
+		require.NoError(t, replaced.Complete(10, id1, params.OperatorIDs, depositData, keyShares, proofs))
+		_, err = NewJournal(overwriteDir, params, true)
+		require.NoError(t, err)
+		resumed, err := OpenJournal(overwriteDir, params)
+		require.NoError(t, err)
+		_, ok := resumed.Completed(10)
+		require.False(t, ok)
+	})
+	t.Run("test remove", func(t *testing.T) {
+		require.NoError(t, journal.Remove())
+		require.NoFileExists(t, filepath.Join(dir, JournalFile))
+		_, err := OpenJournal(dir, params)
+		require.ErrorContains(t, err, "failed to read ceremony journal")
+	})
+}
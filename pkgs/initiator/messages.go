@@ -92,3 +92,21 @@ func makeMultipleSignedTransports(privateKey *rsa.PrivateKey, id [24]byte, messa
 	final.Signature = sig
 	return final, nil
 }
+
+// filterMessagesByType returns only messages of the requested type from operator responses
+func filterMessagesByType(messages [][]byte, msgType wire.TransportType) ([][]byte, error) {
+	filtered := make([][]byte, 0, len(messages))
+	for _, msg := range messages {
+		tsp := &wire.SignedTransport{}
+		if err := tsp.UnmarshalSSZ(msg); err != nil {
+			return nil, err
+		}
+		if tsp.Message.Type == wire.ErrorMessageType {
+			return nil, fmt.Errorf("%s", string(tsp.Message.Data))
+		}
+		if tsp.Message.Type == msgType {
+			filtered = append(filtered, msg)
+		}
+	}
+	return filtered, nil
+}
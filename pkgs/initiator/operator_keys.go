@@ -0,0 +1,216 @@
+package initiator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec/eip1271"
+	"github.com/bloxapp/ssv/eth/contract"
+	"github.com/bloxapp/ssv/eth/eventparser"
+)
+
+// SSVContract is the SSV network contract of an ethereum network and the block it was deployed at
+type SSVContract struct {
+	Address     common.Address
+	DeployBlock uint64
+}
+
+// SSVContracts are SSV network contracts of known networks
+var SSVContracts = map[string]SSVContract{
+	"mainnet": {Address: common.HexToAddress("0xDD9BC35aE942eF0cFa76930954a156B3fF30a4E1"), DeployBlock: 17507487},
+	"holesky": {Address: common.HexToAddress("0x38A4794cCEd47d3baf7370CcC43B560D3a1beEFA"), DeployBlock: 181612},
+}
+
+// OperatorKeysRegistry provides RSA public keys of operators registered at the SSV network.
+// It is used to verify keys of operators information before encrypting shares to them.
+type OperatorKeysRegistry interface {
+	// OperatorKeys returns public keys of registered operators by IDs, unregistered operators are omitted
+	OperatorKeys(ctx context.Context, ids []uint64) (map[uint64]*rsa.PublicKey, error)
+}
+
+// VerifyOperatorKeys checks that every operator is registered at the SSV network with the same public key
+func VerifyOperatorKeys(ctx context.Context, registry OperatorKeysRegistry, ops []*wire.Operator) error {
+	ids := make([]uint64, 0, len(ops))
+	for _, op := range ops {
+		ids = append(ids, op.ID)
+	}
+	keys, err := registry.OperatorKeys(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get operator keys from the SSV network registry: %w", err)
+	}
+	for _, op := range ops {
+		registered, ok := keys[op.ID]
+		if !ok {
+			return fmt.Errorf("operator %d is not registered at the SSV network", op.ID)
+		}
+		pk, err := crypto.ParseRSAPublicKey(op.PubKey)
+		if err != nil {
+			return fmt.Errorf("invalid public key of operator %d: %w", op.ID, err)
+		}
+		if !pk.Equal(registered) {
+			return fmt.Errorf("public key of operator %d doesnt match the key registered at the SSV network", op.ID)
+		}
+	}
+	return nil
+}
+
+// DefaultLogsBlockRange is the number of blocks of a single request of contract events,
+// ethereum nodes limit the range of blocks or the number of logs of a request
+const DefaultLogsBlockRange = 10_000
+
+// ContractKeysRegistry reads operator keys from OperatorAdded and OperatorRemoved events of the SSV network contract
+type ContractKeysRegistry struct {
+	Client     eip1271.ETHClient
+	Contract   common.Address
+	FromBlock  uint64 // first block to look for events, i.e. the block the contract was deployed at
+	BlockRange uint64 // number of blocks of a single request of events, DefaultLogsBlockRange if not set
+}
+
+// OperatorKeys returns public keys of operators which were added and not removed from the contract.
+// Events are requested by ranges of BlockRange blocks from FromBlock up to the latest block.
+func (r *ContractKeysRegistry) OperatorKeys(ctx context.Context, ids []uint64) (map[uint64]*rsa.PublicKey, error) {
+	filterer, err := contract.NewContractFilterer(r.Contract, r.Client)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := r.Client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest block: %w", err)
+	}
+	blockRange := r.BlockRange
+	if blockRange == 0 {
+		blockRange = DefaultLogsBlockRange
+	}
+	parser := eventparser.New(filterer)
+	keys := make(map[uint64]*rsa.PublicKey, len(ids))
+	for start := r.FromBlock; start <= latest; start += blockRange {
+		end := min(start+blockRange-1, latest)
+		opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+		if err := filterOperatorKeys(filterer, parser, opts, ids, keys); err != nil {
+			return nil, fmt.Errorf("blocks %d-%d: %w", start, end, err)
+		}
+	}
+	return keys, nil
+}
+
+// filterOperatorKeys adds keys of operators added at the blocks of opts and removes keys of removed operators
+func filterOperatorKeys(filterer *contract.ContractFilterer, parser *eventparser.EventParser, opts *bind.FilterOpts, ids []uint64, keys map[uint64]*rsa.PublicKey) error {
+	added, err := filterer.FilterOperatorAdded(opts, ids, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter OperatorAdded events: %w", err)
+	}
+	defer added.Close()
+	for added.Next() {
+		// public key is ABI encoded inside of the event field
+		event, err := parser.ParseOperatorAdded(added.Event.Raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse OperatorAdded event: %w", err)
+		}
+		pk, err := crypto.ParseRSAPublicKey(event.PublicKey)
+		if err != nil {
+			return fmt.Errorf("invalid public key of operator %d at OperatorAdded event: %w", event.OperatorId, err)
+		}
+		keys[event.OperatorId] = pk
+	}
+	if err := added.Error(); err != nil {
+		return fmt.Errorf("failed to filter OperatorAdded events: %w", err)
+	}
+	removed, err := filterer.FilterOperatorRemoved(opts, ids)
+	if err != nil {
+		return fmt.Errorf("failed to filter OperatorRemoved events: %w", err)
+	}
+	defer removed.Close()
+	for removed.Next() {
+		delete(keys, removed.Event.OperatorId)
+	}
+	if err := removed.Error(); err != nil {
+		return fmt.Errorf("failed to filter OperatorRemoved events: %w", err)
+	}
+	return nil
+}
+
+// KeysSnapshot is a snapshot of operator keys registered at the SSV network at some block
+type KeysSnapshot struct {
+	Network   string             `json:"network"`
+	Block     uint64             `json:"block"`
+	Operators []SnapshotOperator `json:"operators"`
+}
+
+// SnapshotOperator is an operator of the keys snapshot
+type SnapshotOperator struct {
+	ID     uint64 `json:"id"`
+	PubKey string `json:"public_key"` // base64 encoded PEM, same as at operators info file
+}
+
+// signedKeysSnapshot is a keys snapshot file. The signature covers the exact bytes of the snapshot,
+// so the snapshot object must not be reformatted after signing.
+type signedKeysSnapshot struct {
+	Snapshot  json.RawMessage `json:"snapshot"`
+	Signature hexutil.Bytes   `json:"signature"`
+}
+
+// SignKeysSnapshot encodes the snapshot to a file signed by an ethereum key
+func SignKeysSnapshot(snapshot *KeysSnapshot, sk *ecdsa.PrivateKey) ([]byte, error) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := eth_crypto.Sign(eth_crypto.Keccak256(data), sk)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(signedKeysSnapshot{Snapshot: data, Signature: sig})
+}
+
+// LoadKeysSnapshot reads a keys snapshot file and verifies that it is signed by the signer
+func LoadKeysSnapshot(path string, signer common.Address) (*KeysSnapshot, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operator keys snapshot: %w", err)
+	}
+	var signed signedKeysSnapshot
+	if err := json.Unmarshal(data, &signed); err != nil {
+		return nil, fmt.Errorf("failed to parse operator keys snapshot: %w", err)
+	}
+	pub, err := eth_crypto.SigToPub(eth_crypto.Keccak256(signed.Snapshot), signed.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid operator keys snapshot signature: %w", err)
+	}
+	if eth_crypto.PubkeyToAddress(*pub) != signer {
+		return nil, fmt.Errorf("operator keys snapshot is not signed by %s", signer.Hex())
+	}
+	snapshot := &KeysSnapshot{}
+	if err := json.Unmarshal(signed.Snapshot, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse operator keys snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// OperatorKeys returns public keys of operators at the snapshot
+func (s *KeysSnapshot) OperatorKeys(ctx context.Context, ids []uint64) (map[uint64]*rsa.PublicKey, error) {
+	keys := make(map[uint64]*rsa.PublicKey, len(ids))
+	for _, op := range s.Operators {
+		if !slices.Contains(ids, op.ID) {
+			continue
+		}
+		pk, err := crypto.ParseRSAPublicKey([]byte(op.PubKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key of operator %d at the snapshot: %w", op.ID, err)
+		}
+		keys[op.ID] = pk
+	}
+	return keys, nil
+}
@@ -0,0 +1,161 @@
+package initiator
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec/testing/stubs"
+	"github.com/bloxapp/ssv/eth/contract"
+	"github.com/bloxapp/ssv/eth/eventparser"
+)
+
+func generateOperators(t *testing.T, n int) (wire.OperatorsCLI, []*wire.Operator) {
+	var operators wire.OperatorsCLI
+	var ops []*wire.Operator
+	for i := 1; i <= n; i++ {
+		_, pk, err := crypto.GenerateRSAKeys()
+		require.NoError(t, err)
+		encoded, err := crypto.EncodeRSAPublicKey(pk)
+		require.NoError(t, err)
+		operators = append(operators, wire.OperatorCLI{ID: uint64(i), Addr: "https://localhost:1", PubKey: pk})
+		ops = append(ops, &wire.Operator{ID: uint64(i), PubKey: encoded})
+	}
+	return operators, ops
+}
+
+func TestKeysSnapshot(t *testing.T) {
+	_, ops := generateOperators(t, 4)
+	snapshot := &KeysSnapshot{Network: "holesky", Block: 100}
+	for _, op := range ops[:3] {
+		snapshot.Operators = append(snapshot.Operators, SnapshotOperator{ID: op.ID, PubKey: string(op.PubKey)})
+	}
+	sk, err := eth_crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := eth_crypto.PubkeyToAddress(sk.PublicKey)
+	data, err := SignKeysSnapshot(snapshot, sk)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "keys_snapshot.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	t.Run("test signed snapshot", func(t *testing.T) {
+		loaded, err := LoadKeysSnapshot(path, signer)
+		require.NoError(t, err)
+		require.Equal(t, snapshot, loaded)
+		require.NoError(t, VerifyOperatorKeys(context.Background(), loaded, ops[:3]))
+	})
+	t.Run("test unknown signer", func(t *testing.T) {
+		_, err := LoadKeysSnapshot(path, common.HexToAddress("0x0000000000000000000000000000000000000007"))
+		require.ErrorContains(t, err, "operator keys snapshot is not signed by")
+	})
+	t.Run("test unregistered operator", func(t *testing.T) {
+		loaded, err := LoadKeysSnapshot(path, signer)
+		require.NoError(t, err)
+		err = VerifyOperatorKeys(context.Background(), loaded, ops)
+		require.ErrorContains(t, err, "operator 4 is not registered at the SSV network")
+	})
+	t.Run("test key mismatch", func(t *testing.T) {
+		loaded, err := LoadKeysSnapshot(path, signer)
+		require.NoError(t, err)
+		wrong := []*wire.Operator{ops[0], {ID: 2, PubKey: ops[3].PubKey}}
+		err = VerifyOperatorKeys(context.Background(), loaded, wrong)
+		require.ErrorContains(t, err, "public key of operator 2 doesnt match the key registered at the SSV network")
+	})
+}
+
+// operatorLogs returns logs of the SSV contract registering operators and removing some of them, one log per block from fromBlock
+func operatorLogs(t *testing.T, address common.Address, fromBlock uint64, ops []*wire.Operator, removed ...uint64) []types.Log {
+	contractABI, err := contract.ContractMetaData.GetAbi()
+	require.NoError(t, err)
+	added := contractABI.Events["OperatorAdded"]
+	var logs []types.Log
+	for _, op := range ops {
+		packed, err := eventparser.PackOperatorPublicKey(op.PubKey)
+		require.NoError(t, err)
+		data, err := added.Inputs.NonIndexed().Pack(packed, big.NewInt(0))
+		require.NoError(t, err)
+		logs = append(logs, types.Log{
+			Address:     address,
+			Topics:      []common.Hash{added.ID, common.BigToHash(new(big.Int).SetUint64(op.ID)), common.BytesToHash(address.Bytes())},
+			Data:        data,
+			BlockNumber: fromBlock + uint64(len(logs)),
+		})
+	}
+	for _, id := range removed {
+		logs = append(logs, types.Log{
+			Address:     address,
+			Topics:      []common.Hash{contractABI.Events["OperatorRemoved"].ID, common.BigToHash(new(big.Int).SetUint64(id))},
+			BlockNumber: fromBlock + uint64(len(logs)),
+		})
+	}
+	return logs
+}
+
+func TestContractKeysRegistry(t *testing.T) {
+	operators, ops := generateOperators(t, 4)
+	address := SSVContracts["holesky"].Address
+	deployBlock := SSVContracts["holesky"].DeployBlock
+	logs := operatorLogs(t, address, deployBlock, ops, 4)
+	var ranges [][2]uint64
+	client := &stubs.Client{
+		LatestBlock: deployBlock + 10,
+		FilterLogsF: func(query ethereum.FilterQuery) ([]types.Log, error) {
+			require.Equal(t, []common.Address{address}, query.Addresses)
+			require.NotNil(t, query.ToBlock)
+			from, to := query.FromBlock.Uint64(), query.ToBlock.Uint64()
+			require.LessOrEqual(t, from, to)
+			require.Less(t, to-from, uint64(2))
+			ranges = append(ranges, [2]uint64{from, to})
+			var res []types.Log
+			for _, log := range logs {
+				if log.Topics[0] == query.Topics[0][0] && log.BlockNumber >= from && log.BlockNumber <= to {
+					res = append(res, log)
+				}
+			}
+			return res, nil
+		},
+	}
+	registry := &ContractKeysRegistry{Client: client, Contract: address, FromBlock: deployBlock, BlockRange: 2}
+	t.Run("test registered operators", func(t *testing.T) {
+		keys, err := registry.OperatorKeys(context.Background(), []uint64{1, 2, 3, 4})
+		require.NoError(t, err)
+		require.Len(t, keys, 3)
+		require.True(t, keys[1].Equal(operators[0].PubKey))
+		require.NoError(t, VerifyOperatorKeys(context.Background(), registry, ops[:3]))
+	})
+	t.Run("test events are requested by block ranges", func(t *testing.T) {
+		ranges = nil
+		_, err := registry.OperatorKeys(context.Background(), []uint64{1, 2, 3, 4})
+		require.NoError(t, err)
+		// added and removed events of 11 blocks by 2 blocks
+		require.Len(t, ranges, 12)
+		require.Equal(t, [2]uint64{deployBlock, deployBlock + 1}, ranges[0])
+		require.Equal(t, [2]uint64{deployBlock + 10, deployBlock + 10}, ranges[len(ranges)-1])
+	})
+	t.Run("test removed operator", func(t *testing.T) {
+		err := VerifyOperatorKeys(context.Background(), registry, ops)
+		require.ErrorContains(t, err, "operator 4 is not registered at the SSV network")
+	})
+	t.Run("test initiator refuses to start on mismatch", func(t *testing.T) {
+		_, otherPK, err := crypto.GenerateRSAKeys()
+		require.NoError(t, err)
+		mismatched := operators.Clone()
+		mismatched[2] = wire.OperatorCLI{ID: 3, Addr: "https://localhost:1", PubKey: otherPK}
+		c, err := New(mismatched, zap.NewNop(), "test.version", nil)
+		require.NoError(t, err)
+		c.KeysRegistry = registry
+		_, _, _, err = c.StartDKG(crypto.NewID(), common.HexToAddress("0x0000000000000000000000000000000000000009").Bytes(), []uint64{1, 2, 3, 4}, "holesky", common.HexToAddress("0x0000000000000000000000000000000000000007"), 0)
+		require.ErrorContains(t, err, "public key of operator 3 doesnt match the key registered at the SSV network")
+	})
+}
@@ -1,13 +1,16 @@
 package initiator
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
 )
 
 // opReqResult structure to represent http communication messages incoming to initiator from operators
@@ -17,9 +20,63 @@ type opReqResult struct {
 	result     []byte
 }
 
+// OperatorError is an error of a request to an operator which didnt respond, i.e. the operator is offline
+type OperatorError struct {
+	OperatorID uint64
+	Err        error
+}
+
+func (e *OperatorError) Error() string {
+	return fmt.Sprintf("operator ID: %d, %s", e.OperatorID, e.Err)
+}
+
+func (e *OperatorError) Unwrap() error {
+	return e.Err
+}
+
+// responseError is an error message an operator responded with, the operator is online
+type responseError struct {
+	err error
+}
+
+func (e *responseError) Error() string {
+	return e.err.Error()
+}
+
+func (e *responseError) Unwrap() error {
+	return e.err
+}
+
+// offlineOperators returns IDs of operators which didnt respond to requests failed with the error
+func offlineOperators(err error) []uint64 {
+	switch e := err.(type) {
+	case *OperatorError:
+		var resErr *responseError
+		if errors.As(e.Err, &resErr) {
+			return nil
+		}
+		return []uint64{e.OperatorID}
+	case interface{ Unwrap() []error }:
+		var ids []uint64
+		for _, err := range e.Unwrap() {
+			ids = append(ids, offlineOperators(err)...)
+		}
+		return ids
+	case interface{ Unwrap() error }:
+		return offlineOperators(e.Unwrap())
+	}
+	return nil
+}
+
 // SendAndCollect ssends http message to operator and read the response
 func (c *Initiator) SendAndCollect(op wire.OperatorCLI, method string, data []byte, checkError bool) ([]byte, error) {
+	return c.SendAndCollectWithContext(context.Background(), op, method, data, checkError)
+}
+
+// SendAndCollectWithContext sends http message to operator and read the response, the request is aborted when ctx is done
+func (c *Initiator) SendAndCollectWithContext(ctx context.Context, op wire.OperatorCLI, method string, data []byte, checkError bool) ([]byte, error) {
 	r := c.Client.R()
+	r.SetContext(ctx)
 	r.SetBodyBytes(data)
 	res, err := r.Post(fmt.Sprintf("%v/%v", op.Addr, method))
 	if err != nil {
@@ -34,9 +91,9 @@ func (c *Initiator) SendAndCollect(op wire.OperatorCLI, method string, data []by
 		if res.StatusCode < 200 || res.StatusCode >= 300 {
 			errmsg, parseErr := wire.ParseAsError(resdata)
 			if parseErr == nil {
-				return nil, fmt.Errorf("%v", errmsg)
+				return nil, &responseError{err: fmt.Errorf("%v", errmsg)}
 			}
-			return nil, fmt.Errorf("operator %d failed with: %w", op.ID, errors.New(string(resdata)))
+			return nil, &responseError{err: fmt.Errorf("operator %d failed with: %w", op.ID, errors.New(string(resdata)))}
 		}
 	}
 	return resdata, nil
@@ -57,8 +114,122 @@ func (c *Initiator) GetAndCollect(op wire.OperatorCLI, method string) ([]byte, e
 	return resdata, nil
 }
 
+// GetWithToken requests Get at operator route authorized by the bearer token and checks the response status
+func (c *Initiator) GetWithToken(op wire.OperatorCLI, method, token string) ([]byte, error) {
+	r := c.Client.R()
+	r.SetBearerAuthToken(token)
+	res, err := r.Get(fmt.Sprintf("%v/%v", op.Addr, method))
+	if err != nil {
+		return nil, err
+	}
+	resdata, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.Logger.Debug("operator responded", zap.String("IP", op.Addr), zap.String("method", method), zap.Int("status", res.StatusCode))
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		errmsg, parseErr := wire.ParseAsError(resdata)
+		if parseErr == nil {
+			return nil, fmt.Errorf("%v", errmsg)
+		}
+		return nil, fmt.Errorf("operator %s failed with: %w", op.Addr, errors.New(string(resdata)))
+	}
+	return resdata, nil
+}
+
 // SendToAll sends http messages to all operators. Makes sure that all responses are received
 func (c *Initiator) SendToAll(method string, msg []byte, operators []*wire.Operator, checkError bool) ([][]byte, error) {
+	return c.SendToAllWithContext(context.Background(), method, msg, operators, checkError)
+}
+
+// SendToAllWithContext sends http messages to all operators as a ceremony phase: requests are aborted
+// when ctx is done or the phase takes longer than PhaseTimeout
+func (c *Initiator) SendToAllWithContext(ctx context.Context, method string, msg []byte, operators []*wire.Operator, checkError bool) ([][]byte, error) {
+	ctx, cancel := c.phaseContext(ctx)
+	defer cancel()
+	results, err := c.sendToOperators(ctx, method, msg, operators, checkError)
+	if err != nil {
+		return nil, err
+	}
+	final := make([][]byte, 0, len(operators))
+
+	errarr := make([]error, 0)
+
+	for _, res := range results {
+		if res.err != nil {
+			errarr = append(errarr, &OperatorError{OperatorID: res.operatorID, Err: res.err})
+			continue
+		}
+		final = append(final, res.result)
+	}
+
+	finalerr := error(nil)
+
+	if len(errarr) > 0 {
+		finalerr = errors.Join(errarr...)
+	}
+
+	return final, finalerr
+}
+
+// SendToAllWithRetry sends http messages to all operators and resends the message with backoff to operators
+// which failed to respond, i.e. timed out. Operators answer a resent message of a ceremony phase with their
+// previous response, so it should be used only for phases of an already initialized ceremony.
+func (c *Initiator) SendToAllWithRetry(method string, msg []byte, operators []*wire.Operator) ([][]byte, error) {
+	return c.SendToAllWithRetryWithContext(context.Background(), method, msg, operators)
+}
+
+// SendToAllWithRetryWithContext sends http messages to all operators with resends as a ceremony phase:
+// requests and resends are aborted when ctx is done or the phase takes longer than PhaseTimeout
+func (c *Initiator) SendToAllWithRetryWithContext(ctx context.Context, method string, msg []byte, operators []*wire.Operator) ([][]byte, error) {
+	ctx, cancel := c.phaseContext(ctx)
+	defer cancel()
+	final := make([][]byte, 0, len(operators))
+	pending := operators
+	backoff := c.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		results, err := c.sendToOperators(ctx, method, msg, pending, false)
+		if err != nil {
+			return nil, err
+		}
+		failed := make([]*wire.Operator, 0)
+		errarr := make([]error, 0)
+		for _, res := range results {
+			if res.err != nil {
+				failed = append(failed, spec.GetOperator(pending, res.operatorID))
+				errarr = append(errarr, &OperatorError{OperatorID: res.operatorID, Err: res.err})
+				continue
+			}
+			final = append(final, res.result)
+		}
+		if len(failed) == 0 {
+			return final, nil
+		}
+		if attempt >= c.PhaseRetries || ctx.Err() != nil {
+			return final, errors.Join(errarr...)
+		}
+		failedIDs := make([]uint64, 0, len(failed))
+		for _, op := range failed {
+			failedIDs = append(failedIDs, op.ID)
+		}
+		c.Logger.Warn("🔁 resending message to operators which failed to respond",
+			zap.String("method", method),
+			zap.Uint64s("operators", failedIDs),
+			zap.Int("attempt", attempt+1),
+			zap.Duration("backoff", backoff),
+			zap.Error(errors.Join(errarr...)))
+		select {
+		case <-ctx.Done():
+			return final, errors.Join(append(errarr, ctx.Err())...)
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		pending = failed
+	}
+}
+
+// sendToOperators sends http messages to operators in parallel and collects result of each operator
+func (c *Initiator) sendToOperators(ctx context.Context, method string, msg []byte, operators []*wire.Operator, checkError bool) ([]opReqResult, error) {
 	resc := make(chan opReqResult, len(operators))
 	for _, wireOp := range operators {
 		operator := c.Operators.ByID(wireOp.ID)
@@ -66,7 +237,7 @@ func (c *Initiator) SendToAll(method string, msg []byte, operators []*wire.Opera
 			return nil, fmt.Errorf("operator ID: %d not found in operators list", wireOp.ID)
 		}
 		go func() {
-			res, err := c.SendAndCollect(*operator, method, msg, checkError)
+			res, err := c.SendAndCollectWithContext(ctx, *operator, method, msg, checkError)
 			resc <- opReqResult{
 				operatorID: operator.ID,
 				err:        err,
@@ -74,24 +245,25 @@ func (c *Initiator) SendToAll(method string, msg []byte, operators []*wire.Opera
 			}
 		}()
 	}
-	final := make([][]byte, 0, len(operators))
-
-	errarr := make([]error, 0)
-
+	results := make([]opReqResult, 0, len(operators))
 	for i := 0; i < len(operators); i++ {
-		res := <-resc
-		if res.err != nil {
-			errarr = append(errarr, fmt.Errorf("operator ID: %d, %w", res.operatorID, res.err))
-			continue
-		}
-		final = append(final, res.result)
+		results = append(results, <-resc)
 	}
+	return results, nil
+}
 
-	finalerr := error(nil)
-
-	if len(errarr) > 0 {
-		finalerr = errors.Join(errarr...)
+// phaseContext returns a context of a ceremony phase bounded by PhaseTimeout, if set
+func (c *Initiator) phaseContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.PhaseTimeout > 0 {
+		return context.WithTimeout(ctx, c.PhaseTimeout)
 	}
+	return context.WithCancel(ctx)
+}
 
-	return final, finalerr
+// ceremonyContext returns a context of a whole ceremony bounded by CeremonyTimeout, if set
+func (c *Initiator) ceremonyContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.CeremonyTimeout > 0 {
+		return context.WithTimeout(ctx, c.CeremonyTimeout)
+	}
+	return context.WithCancel(ctx)
 }
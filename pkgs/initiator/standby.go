@@ -0,0 +1,51 @@
+package initiator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// InitError is returned when operators dont respond to the init message of a DKG ceremony, i.e. they are offline
+type InitError struct {
+	Offline []uint64 // IDs of operators which didnt respond
+	Err     error
+}
+
+func (e *InitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *InitError) Unwrap() error {
+	return e.Err
+}
+
+// SubstituteOperators replaces offline operators of a failed DKG ceremony by standby operators in order of preference.
+// Returns operator IDs of the next attempt ordered by ID, standby operators which are left and the substitutions.
+func SubstituteOperators(id [24]byte, nonce uint64, ids, offline, standby []uint64) ([]uint64, []uint64, []wire.Substitution, error) {
+	if len(offline) > len(standby) {
+		return nil, nil, nil, fmt.Errorf("not enough standby operators to replace offline operators %v", offline)
+	}
+	next := slices.Clone(ids)
+	substitutions := make([]wire.Substitution, 0, len(offline))
+	for i, opID := range offline {
+		idx := slices.Index(next, opID)
+		if idx == -1 {
+			return nil, nil, nil, fmt.Errorf("offline operator %d doesnt participate in the ceremony", opID)
+		}
+		next[idx] = standby[i]
+		substitutions = append(substitutions, wire.Substitution{
+			Nonce:     nonce,
+			RequestID: hex.EncodeToString(id[:]),
+			Offline:   opID,
+			Standby:   standby[i],
+		})
+	}
+	slices.Sort(next)
+	for i := range substitutions {
+		substitutions[i].OperatorIDs = next
+	}
+	return next, standby[len(offline):], substitutions, nil
+}
@@ -0,0 +1,44 @@
+package initiator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+func TestSubstituteOperators(t *testing.T) {
+	id := [24]byte{1, 2, 3}
+	t.Run("offline operators replaced in order of preference", func(t *testing.T) {
+		next, left, subs, err := SubstituteOperators(id, 5, []uint64{1, 2, 3, 4}, []uint64{2, 4}, []uint64{7, 5, 6})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 3, 5, 7}, next)
+		require.Equal(t, []uint64{6}, left)
+		require.Equal(t, []wire.Substitution{
+			{Nonce: 5, RequestID: "010203000000000000000000000000000000000000000000", Offline: 2, Standby: 7, OperatorIDs: []uint64{1, 3, 5, 7}},
+			{Nonce: 5, RequestID: "010203000000000000000000000000000000000000000000", Offline: 4, Standby: 5, OperatorIDs: []uint64{1, 3, 5, 7}},
+		}, subs)
+	})
+	t.Run("not enough standby operators", func(t *testing.T) {
+		_, _, _, err := SubstituteOperators(id, 5, []uint64{1, 2, 3, 4}, []uint64{2, 4}, []uint64{5})
+		require.EqualError(t, err, "not enough standby operators to replace offline operators [2 4]")
+	})
+	t.Run("offline operator doesnt participate", func(t *testing.T) {
+		_, _, _, err := SubstituteOperators(id, 5, []uint64{1, 2, 3, 4}, []uint64{6}, []uint64{5})
+		require.EqualError(t, err, "offline operator 6 doesnt participate in the ceremony")
+	})
+}
+
+func TestOfflineOperators(t *testing.T) {
+	err := errors.Join(
+		&OperatorError{OperatorID: 3, Err: errors.New("connection refused")},
+		&OperatorError{OperatorID: 1, Err: &responseError{err: errors.New("wrong version")}},
+		&OperatorError{OperatorID: 2, Err: errors.New("context deadline exceeded")},
+	)
+	require.Equal(t, []uint64{3, 2}, offlineOperators(fmt.Errorf("failed to send init: %w", err)))
+	require.Empty(t, offlineOperators(errors.New("failed to send init")))
+	require.Contains(t, err.Error(), "operator ID: 1, wrong version")
+}
@@ -0,0 +1,208 @@
+package operator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+const metricsNamespace = "ssv_dkg_operator"
+
+// ceremony phases at operator
+const (
+	phaseInit          = "init"
+	phaseExchange      = "exchange"
+	phaseDeal          = "deal"
+	phaseResponse      = "response"
+	phaseJustification = "justification"
+	phaseResult        = "result"
+	phaseResign        = "resign"
+	phaseExit          = "exit"
+)
+
+// Metrics holds prometheus metrics of the operator. Metrics are registered at the own registry
+// of the operator, so that several operators can run in one process. Methods of nil Metrics do nothing.
+type Metrics struct {
+	Registry            *prometheus.Registry
+	ceremoniesStarted   prometheus.Counter
+	ceremoniesCompleted prometheus.Counter
+	ceremoniesFailed    *prometheus.CounterVec
+	signings            *prometheus.CounterVec
+	phaseDuration       *prometheus.HistogramVec
+	rateLimited         *prometheus.CounterVec
+	errors              *prometheus.CounterVec
+}
+
+// NewMetrics creates operator metrics. Active instances are read from the Switch at every scrape.
+func NewMetrics(s *Switch) *Metrics {
+	m := &Metrics{
+		Registry: prometheus.NewRegistry(),
+		ceremoniesStarted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "ceremonies_started_total",
+			Help:      "Number of DKG and reshare ceremonies started at the operator",
+		}),
+		ceremoniesCompleted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "ceremonies_completed_total",
+			Help:      "Number of DKG and reshare ceremonies which produced a result at the operator",
+		}),
+		ceremoniesFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "ceremonies_failed_total",
+			Help:      "Number of DKG and reshare ceremonies failed at the operator by phase",
+		}, []string{"phase"}),
+		signings: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "signings_total",
+			Help:      "Number of resign and exit requests signed in one round at the operator by type and result",
+		}, []string{"type", "result"}),
+		phaseDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "phase_duration_seconds",
+			Help:      "Duration of successfully processed ceremony phases",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"phase"}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rate_limited_requests_total",
+			Help:      "Number of requests rejected by the rate limiter by route",
+		}, []string{"route"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "errors_total",
+			Help:      "Number of error responses by route and error type",
+		}, []string{"route", "type"}),
+	}
+	activeInstances := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "active_instances",
+		Help:      "Number of ceremony instances kept at the operator",
+	}, func() float64 {
+		s.Mtx.RLock()
+		defer s.Mtx.RUnlock()
+		return float64(len(s.Instances))
+	})
+	m.Registry.MustRegister(
+		m.ceremoniesStarted,
+		m.ceremoniesCompleted,
+		m.ceremoniesFailed,
+		m.signings,
+		m.phaseDuration,
+		m.rateLimited,
+		m.errors,
+		activeInstances,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// CeremonyStarted counts a new ceremony instance and observes duration of the init phase
+func (m *Metrics) CeremonyStarted(start time.Time) {
+	if m == nil {
+		return
+	}
+	m.ceremoniesStarted.Inc()
+	m.phaseDuration.WithLabelValues(phaseInit).Observe(time.Since(start).Seconds())
+}
+
+// CeremonyCompleted counts a ceremony which produced a result at the deal phase
+func (m *Metrics) CeremonyCompleted() {
+	if m == nil {
+		return
+	}
+	m.ceremoniesCompleted.Inc()
+}
+
+// CeremonyFailed counts a ceremony failed at the phase
+func (m *Metrics) CeremonyFailed(phase string) {
+	if m == nil {
+		return
+	}
+	m.ceremoniesFailed.WithLabelValues(phase).Inc()
+}
+
+// SigningCompleted counts a resign or exit signing, which is done in one round, and observes duration of its phase
+func (m *Metrics) SigningCompleted(phase string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.signings.WithLabelValues(phase, "completed").Inc()
+	m.phaseDuration.WithLabelValues(phase).Observe(time.Since(start).Seconds())
+}
+
+// SigningFailed counts a failed resign or exit signing
+func (m *Metrics) SigningFailed(phase string) {
+	if m == nil {
+		return
+	}
+	m.signings.WithLabelValues(phase, "failed").Inc()
+}
+
+// PhaseProcessed observes duration of a successfully processed phase
+func (m *Metrics) PhaseProcessed(phase string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.phaseDuration.WithLabelValues(phase).Observe(time.Since(start).Seconds())
+}
+
+// RateLimited counts a request rejected by the rate limiter
+func (m *Metrics) RateLimited(route string) {
+	if m == nil {
+		return
+	}
+	m.rateLimited.WithLabelValues(route).Inc()
+}
+
+// Error counts an error response of the route by the error type
+func (m *Metrics) Error(route string, err error) {
+	if m == nil {
+		return
+	}
+	m.errors.WithLabelValues(route, errorType(err)).Inc()
+}
+
+// errorType classifies errors returned by the operator for the errors metric
+func errorType(err error) string {
+	var policyErr *PolicyError
+	switch {
+	case errors.Is(err, utils.ErrMaxInstances):
+		return "max_instances"
+	case errors.Is(err, utils.ErrAlreadyExists):
+		return "instance_exists"
+	case errors.Is(err, utils.ErrMissingInstance):
+		return "missing_instance"
+	case errors.Is(err, utils.ErrShuttingDown):
+		return "shutting_down"
+	case errors.As(err, &policyErr):
+		return "policy"
+	default:
+		return "other"
+	}
+}
+
+// messagePhase returns a ceremony phase by the type of messages incoming to /dkg route, kyber rounds are
+// identified by the type of the kyber message, see phaseType
+func messagePhase(t wire.TransportType) string {
+	switch t {
+	case wire.ExchangeMessageType:
+		return phaseExchange
+	case wire.KyberDealBundleMessageType:
+		return phaseDeal
+	case wire.KyberResponseBundleMessageType:
+		return phaseResponse
+	case wire.KyberJustificationBundleMessageType:
+		return phaseJustification
+	case wire.ResultMessageType:
+		return phaseResult
+	default:
+		return t.String()
+	}
+}
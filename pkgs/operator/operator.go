@@ -1,16 +1,23 @@
 package operator
 
 import (
+	"context"
 	"crypto/rsa"
+	"crypto/subtle"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/httprate"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 
 	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
@@ -25,13 +32,26 @@ const (
 	timePeriod   = time.Minute
 )
 
+// shutdown timeouts
+const (
+	HttpShutdownTimeout = 10 * time.Second // time for in-flight requests to finish after instances are drained
+	drainInterval       = time.Second
+)
+
 // Server structure for operator to store http server and DKG ceremony instances
 type Server struct {
-	Logger     *zap.Logger  // logger
-	HttpServer *http.Server // http server
-	Router     chi.Router   // http router
-	State      *Switch      // structure to store instances of DKG ceremonies
-	OutputPath string
+	Logger      *zap.Logger  // logger
+	HttpServer  *http.Server // http server
+	Router      chi.Router   // http router
+	State       *Switch      // structure to store instances of DKG ceremonies
+	OutputPath  string
+	StatusToken string // bearer token authorizing requests to ceremonies status routes, the routes are disabled if empty
+	// MetricsToken is a bearer token authorizing requests to /metrics at the operator port, the route is disabled there if empty.
+	// Metrics can be served without the token at a separate address, see StartMetrics.
+	MetricsToken  string
+	httpMtx       sync.Mutex
+	metricsServer *http.Server
+	closed        bool // the server is shut down, Start returns right away
 }
 
 // TODO: either do all json or all SSZ
@@ -40,25 +60,25 @@ const ErrTooManyRouteRequests = `{"error": "too many requests to /route"}`
 // RegisterRoutes creates routes at operator to process messages incoming from initiator
 func RegisterRoutes(s *Server) {
 	// Add general rate limiter
-	s.Router.Use(rateLimit(s.Logger, generalLimit))
+	s.Router.Use(rateLimit(s.Logger, s.State.Metrics, generalLimit))
 
-	s.Router.With(rateLimit(s.Logger, routeLimit)).
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit)).
 		Post("/init", func(writer http.ResponseWriter, request *http.Request) {
 			s.Logger.Debug("incoming INIT msg")
 			rawdata, err := io.ReadAll(request.Body)
 			if err != nil {
-				utils.WriteErrorResponse(s.Logger, writer, fmt.Errorf("operator %d, failed to read request body, err: %v", s.State.OperatorID, err), http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/init", fmt.Errorf("operator %d, failed to read request body, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
 				return
 			}
 			signedInitMsg := &wire.SignedTransport{}
 			if err := signedInitMsg.UnmarshalSSZ(rawdata); err != nil {
-				utils.WriteErrorResponse(s.Logger, writer, fmt.Errorf("operator %d, failed to unmarshal SSZ, err: %v", s.State.OperatorID, err), http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/init", fmt.Errorf("operator %d, failed to unmarshal SSZ, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
 				return
 			}
 
 			// Validate that incoming message is an init message
-			if signedInitMsg.Message.Type != wire.InitMessageType {
-				utils.WriteErrorResponse(s.Logger, writer, fmt.Errorf("operator %d, received non-init message to init route, err: %v", s.State.OperatorID, errors.New("not init message to init route")), http.StatusBadRequest)
+			if signedInitMsg.Message.Type != wire.InitMessageType && signedInitMsg.Message.Type != wire.InitV2MessageType {
+				s.writeErrorResponse(writer, "/init", fmt.Errorf("operator %d, received non-init message to init route, err: %v", s.State.OperatorID, errors.New("not init message to init route")), http.StatusBadRequest)
 				return
 			}
 			reqid := signedInitMsg.Message.Identifier
@@ -66,7 +86,7 @@ func RegisterRoutes(s *Server) {
 			logger.Debug("initiating instance with init data")
 			b, err := s.State.InitInstance(reqid, signedInitMsg.Message, signedInitMsg.Signer, signedInitMsg.Signature)
 			if err != nil {
-				utils.WriteErrorResponse(s.Logger, writer, fmt.Errorf("operator %d, failed to initialize instance, err: %v", s.State.OperatorID, err), http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/init", fmt.Errorf("operator %d, failed to initialize instance, err: %w", s.State.OperatorID, err), initErrorStatus(err))
 				return
 			}
 			logger.Info("✅ Instance started successfully")
@@ -78,17 +98,123 @@ func RegisterRoutes(s *Server) {
 			}
 		})
 
-	s.Router.With(rateLimit(s.Logger, routeLimit)).
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit)).
+		Post("/reshare", func(writer http.ResponseWriter, request *http.Request) {
+			s.Logger.Debug("incoming RESHARE msg")
+			rawdata, err := io.ReadAll(request.Body)
+			if err != nil {
+				s.writeErrorResponse(writer, "/reshare", fmt.Errorf("operator %d, failed to read request body, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
+				return
+			}
+			signedReshareMsg := &wire.SignedTransport{}
+			if err := signedReshareMsg.UnmarshalSSZ(rawdata); err != nil {
+				s.writeErrorResponse(writer, "/reshare", fmt.Errorf("operator %d, failed to unmarshal SSZ, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
+				return
+			}
+
+			// Validate that incoming message is a reshare message
+			if signedReshareMsg.Message.Type != wire.ReshareMessageType && signedReshareMsg.Message.Type != wire.ReshareV2MessageType {
+				s.writeErrorResponse(writer, "/reshare", fmt.Errorf("operator %d, received non-reshare message to reshare route, err: %v", s.State.OperatorID, errors.New("not reshare message to reshare route")), http.StatusBadRequest)
+				return
+			}
+			reqid := signedReshareMsg.Message.Identifier
+			logger := s.Logger.With(zap.String("reqid", hex.EncodeToString(reqid[:])))
+			logger.Debug("initiating instance with reshare data")
+			b, err := s.State.InitReshareInstance(reqid, signedReshareMsg.Message, signedReshareMsg.Signer, signedReshareMsg.Signature)
+			if err != nil {
+				s.writeErrorResponse(writer, "/reshare", fmt.Errorf("operator %d, failed to initialize reshare instance, err: %w", s.State.OperatorID, err), initErrorStatus(err))
+				return
+			}
+			logger.Info("✅ Resharing instance started successfully")
+
+			writer.WriteHeader(http.StatusOK)
+			if _, err := writer.Write(b); err != nil {
+				logger.Error("error writing reshare response: " + err.Error())
+				return
+			}
+		})
+
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit)).
+		Post("/resign", func(writer http.ResponseWriter, request *http.Request) {
+			s.Logger.Debug("incoming RESIGN msg")
+			rawdata, err := io.ReadAll(request.Body)
+			if err != nil {
+				s.writeErrorResponse(writer, "/resign", fmt.Errorf("operator %d, failed to read request body, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
+				return
+			}
+			signedResignMsg := &wire.SignedTransport{}
+			if err := signedResignMsg.UnmarshalSSZ(rawdata); err != nil {
+				s.writeErrorResponse(writer, "/resign", fmt.Errorf("operator %d, failed to unmarshal SSZ, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
+				return
+			}
+
+			// Validate that incoming message is a resign message
+			if signedResignMsg.Message.Type != wire.ResignMessageType && signedResignMsg.Message.Type != wire.ResignV2MessageType {
+				s.writeErrorResponse(writer, "/resign", fmt.Errorf("operator %d, received non-resign message to resign route, err: %v", s.State.OperatorID, errors.New("not resign message to resign route")), http.StatusBadRequest)
+				return
+			}
+			reqid := signedResignMsg.Message.Identifier
+			logger := s.Logger.With(zap.String("reqid", hex.EncodeToString(reqid[:])))
+			b, err := s.State.ProcessResign(reqid, signedResignMsg.Message, signedResignMsg.Signer, signedResignMsg.Signature)
+			if err != nil {
+				s.writeErrorResponse(writer, "/resign", fmt.Errorf("operator %d, failed to resign, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
+				return
+			}
+			logger.Info("✅ Resigned owner and nonce successfully")
+
+			writer.WriteHeader(http.StatusOK)
+			if _, err := writer.Write(b); err != nil {
+				logger.Error("error writing resign response: " + err.Error())
+				return
+			}
+		})
+
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit)).
+		Post("/exit", func(writer http.ResponseWriter, request *http.Request) {
+			s.Logger.Debug("incoming EXIT msg")
+			rawdata, err := io.ReadAll(request.Body)
+			if err != nil {
+				s.writeErrorResponse(writer, "/exit", fmt.Errorf("operator %d, failed to read request body, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
+				return
+			}
+			signedExitMsg := &wire.SignedTransport{}
+			if err := signedExitMsg.UnmarshalSSZ(rawdata); err != nil {
+				s.writeErrorResponse(writer, "/exit", fmt.Errorf("operator %d, failed to unmarshal SSZ, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
+				return
+			}
+
+			// Validate that incoming message is an exit message
+			if signedExitMsg.Message.Type != wire.ExitMessageType && signedExitMsg.Message.Type != wire.ExitV2MessageType {
+				s.writeErrorResponse(writer, "/exit", fmt.Errorf("operator %d, received non-exit message to exit route, err: %v", s.State.OperatorID, errors.New("not exit message to exit route")), http.StatusBadRequest)
+				return
+			}
+			reqid := signedExitMsg.Message.Identifier
+			logger := s.Logger.With(zap.String("reqid", hex.EncodeToString(reqid[:])))
+			b, err := s.State.ProcessExit(reqid, signedExitMsg.Message, signedExitMsg.Signer, signedExitMsg.Signature)
+			if err != nil {
+				s.writeErrorResponse(writer, "/exit", fmt.Errorf("operator %d, failed to sign voluntary exit, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
+				return
+			}
+			logger.Info("✅ Signed voluntary exit successfully")
+
+			writer.WriteHeader(http.StatusOK)
+			if _, err := writer.Write(b); err != nil {
+				logger.Error("error writing exit response: " + err.Error())
+				return
+			}
+		})
+
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit)).
 		Post("/dkg", func(writer http.ResponseWriter, request *http.Request) {
 			s.Logger.Debug("received a dkg protocol message")
 			rawdata, err := io.ReadAll(request.Body)
 			if err != nil {
-				utils.WriteErrorResponse(s.Logger, writer, fmt.Errorf("operator %d, err: %v", s.State.OperatorID, err), http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/dkg", fmt.Errorf("operator %d, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
 				return
 			}
 			b, err := s.State.ProcessMessage(rawdata)
 			if err != nil {
-				utils.WriteErrorResponse(s.Logger, writer, fmt.Errorf("operator %d, err: %v", s.State.OperatorID, err), http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/dkg", fmt.Errorf("operator %d, err: %w", s.State.OperatorID, err), http.StatusBadRequest)
 				return
 			}
 			writer.WriteHeader(http.StatusOK)
@@ -98,11 +224,11 @@ func RegisterRoutes(s *Server) {
 			}
 		})
 
-	s.Router.With(rateLimit(s.Logger, routeLimit)).
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit)).
 		Get("/health_check", func(writer http.ResponseWriter, request *http.Request) {
 			b, err := s.State.Pong()
 			if err != nil {
-				utils.WriteErrorResponse(s.Logger, writer, err, http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/health_check", err, http.StatusBadRequest)
 				return
 			}
 			writer.WriteHeader(http.StatusOK)
@@ -112,31 +238,58 @@ func RegisterRoutes(s *Server) {
 			}
 		})
 
-	s.Router.With(rateLimit(s.Logger, routeLimit)).
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit), s.authorize("/ceremonies", "ceremonies status API", func() string { return s.StatusToken })).
+		Get("/ceremonies", func(writer http.ResponseWriter, request *http.Request) {
+			writeJSONResponse(s.Logger, writer, s.State.Ceremonies())
+		})
+
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit), s.authorize("/ceremonies", "ceremonies status API", func() string { return s.StatusToken })).
+		Get("/ceremonies/{id}", func(writer http.ResponseWriter, request *http.Request) {
+			reqid, err := hex.DecodeString(chi.URLParam(request, "id"))
+			if err != nil || len(reqid) != len(InstanceID{}) {
+				s.writeErrorResponse(writer, "/ceremonies", fmt.Errorf("operator %d, invalid ceremony ID", s.State.OperatorID), http.StatusBadRequest)
+				return
+			}
+			status, ok := s.State.Ceremony(InstanceID(reqid))
+			if !ok {
+				s.writeErrorResponse(writer, "/ceremonies", fmt.Errorf("operator %d, err: %w", s.State.OperatorID, utils.ErrMissingInstance), http.StatusNotFound)
+				return
+			}
+			writeJSONResponse(s.Logger, writer, status)
+		})
+
+	if s.State.Metrics != nil {
+		s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit), s.authorize("/metrics", "metrics", func() string { return s.MetricsToken })).
+			Get("/metrics", promhttp.HandlerFor(s.State.Metrics.Registry, promhttp.HandlerOpts{}).ServeHTTP)
+	}
+
+	s.Router.With(rateLimit(s.Logger, s.State.Metrics, routeLimit)).
 		Post("/results", func(writer http.ResponseWriter, request *http.Request) {
 			rawdata, err := io.ReadAll(request.Body)
 			if err != nil {
-				utils.WriteErrorResponse(s.Logger, writer, err, http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/results", err, http.StatusBadRequest)
 				return
 			}
 			signedResultMsg := &wire.SignedTransport{}
 			if err := signedResultMsg.UnmarshalSSZ(rawdata); err != nil {
-				utils.WriteErrorResponse(s.Logger, writer, err, http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/results", err, http.StatusBadRequest)
 				return
 			}
 
 			// Validate that incoming message is a result message
 			if signedResultMsg.Message.Type != wire.ResultMessageType {
-				utils.WriteErrorResponse(s.Logger, writer, errors.New("received wrong message type"), http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/results", errors.New("received wrong message type"), http.StatusBadRequest)
 				return
 			}
 			s.Logger.Debug("received a result message")
+			start := time.Now()
 			err = s.State.SaveResultData(signedResultMsg, s.OutputPath)
 			if err != nil {
 				err := &utils.SensitiveError{Err: err, PresentedErr: "failed to write results"}
-				utils.WriteErrorResponse(s.Logger, writer, err, http.StatusBadRequest)
+				s.writeErrorResponse(writer, "/results", err, http.StatusBadRequest)
 				return
 			}
+			s.State.Metrics.PhaseProcessed(phaseResult, start)
 			writer.WriteHeader(http.StatusOK)
 		})
 }
@@ -150,6 +303,11 @@ func New(key *rsa.PrivateKey, logger *zap.Logger, ver []byte, id uint64, outputP
 		return nil, err
 	}
 	swtch := NewSwitch(key, logger, ver, pkBytes, id)
+	swtch.Metrics = NewMetrics(swtch)
+	swtch.Shares, err = NewShareStore(filepath.Join(outputPath, SharesDir))
+	if err != nil {
+		return nil, err
+	}
 	s := &Server{
 		Logger:     logger,
 		Router:     r,
@@ -160,19 +318,139 @@ func New(key *rsa.PrivateKey, logger *zap.Logger, ver []byte, id uint64, outputP
 	return s, nil
 }
 
-// Start runs a http server to listen for incoming messages at specified port
+// Start runs a http server to listen for incoming messages at specified port. It returns nil after the server is shut down,
+// or right away if Shutdown was called before.
 func (s *Server) Start(port uint16, cert, key string) error {
 	srv := &http.Server{Addr: fmt.Sprintf(":%v", port), Handler: s.Router, ReadHeaderTimeout: 10_000 * time.Millisecond}
+	s.httpMtx.Lock()
+	if s.closed {
+		s.httpMtx.Unlock()
+		return nil
+	}
 	s.HttpServer = srv
-	err := s.HttpServer.ListenAndServeTLS(cert, key)
-	if err != nil {
+	s.httpMtx.Unlock()
+	s.Logger.Info("✅ Server is listening for incoming requests", zap.Uint16("port", port))
+	err := srv.ListenAndServeTLS(cert, key)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
-	s.Logger.Info("✅ Server is listening for incoming requests", zap.Uint16("port", port))
 	return nil
 }
 
-func rateLimit(logger *zap.Logger, limit int) func(http.Handler) http.Handler {
+// StartMetrics serves /metrics over plain HTTP at a separate address, i.e. a private interface scraped by prometheus.
+// Requests are authorized with MetricsToken if set. It returns nil after the server is shut down.
+func (s *Server) StartMetrics(addr string) error {
+	if s.State.Metrics == nil {
+		return fmt.Errorf("operator metrics are disabled")
+	}
+	r := chi.NewRouter()
+	handler := promhttp.HandlerFor(s.State.Metrics.Registry, promhttp.HandlerOpts{})
+	if s.MetricsToken != "" {
+		handler = s.authorize("/metrics", "metrics", func() string { return s.MetricsToken })(handler)
+	}
+	r.With(rateLimit(s.Logger, s.State.Metrics, routeLimit)).Get("/metrics", handler.ServeHTTP)
+	srv := &http.Server{Addr: addr, Handler: r, ReadHeaderTimeout: 10_000 * time.Millisecond}
+	s.httpMtx.Lock()
+	if s.closed {
+		s.httpMtx.Unlock()
+		return nil
+	}
+	s.metricsServer = srv
+	s.httpMtx.Unlock()
+	s.Logger.Info("📈 Serving metrics", zap.String("address", addr))
+	err := srv.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Shutdown stops accepting new ceremonies and waits until active instances finish or the context is done.
+// Then the http server is shut down, waiting for in-flight requests, i.e. results being written, up to HttpShutdownTimeout.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.State.Drain()
+	ticker := time.NewTicker(drainInterval)
+	defer ticker.Stop()
+	for active := s.State.ActiveInstances(); active > 0; active = s.State.ActiveInstances() {
+		s.Logger.Info("⏳ waiting for active instances to finish", zap.Int("active", active))
+		select {
+		case <-ctx.Done():
+			s.Logger.Warn("⚠️ shutdown deadline reached, dropping active instances", zap.Int("active", active))
+			return s.shutdownHttpServer()
+		case <-ticker.C:
+		}
+	}
+	s.Logger.Info("✅ all instances finished")
+	return s.shutdownHttpServer()
+}
+
+func (s *Server) shutdownHttpServer() error {
+	s.httpMtx.Lock()
+	s.closed = true
+	srv, metricsSrv := s.HttpServer, s.metricsServer
+	s.httpMtx.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), HttpShutdownTimeout)
+	defer cancel()
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(ctx); err != nil {
+			s.Logger.Error("failed to shut down metrics server", zap.Error(err))
+		}
+	}
+	if srv == nil {
+		return nil
+	}
+	return srv.Shutdown(ctx)
+}
+
+// initErrorStatus returns the status code of a failed request to start a new ceremony. Unavailable status
+// lets the initiator know that the operator is restarting.
+func initErrorStatus(err error) int {
+	if errors.Is(err, utils.ErrShuttingDown) {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadRequest
+}
+
+// authorize allows requests to the route only with the bearer token of the operator, the route is disabled if the token is empty
+func (s *Server) authorize(route, api string, token func() string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			expected := token()
+			if expected == "" {
+				s.writeErrorResponse(writer, route, fmt.Errorf("operator %d, %s is disabled", s.State.OperatorID, api), http.StatusNotFound)
+				return
+			}
+			got, ok := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(expected)) != 1 {
+				s.writeErrorResponse(writer, route, fmt.Errorf("operator %d, unauthorized", s.State.OperatorID), http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
+
+// writeJSONResponse writes the data as JSON response
+func writeJSONResponse(logger *zap.Logger, writer http.ResponseWriter, data any) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		utils.WriteErrorResponse(logger, writer, err, http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+	if _, err := writer.Write(b); err != nil {
+		logger.Error("error writing json response: " + err.Error())
+	}
+}
+
+// writeErrorResponse counts the error at metrics and writes it to the response
+func (s *Server) writeErrorResponse(writer http.ResponseWriter, route string, err error, statusCode int) {
+	s.State.Metrics.Error(route, err)
+	utils.WriteErrorResponse(s.Logger, writer, err, statusCode)
+}
+
+func rateLimit(logger *zap.Logger, metrics *Metrics, limit int) func(http.Handler) http.Handler {
 	return httprate.Limit(
 		limit,
 		timePeriod,
@@ -180,6 +458,7 @@ func rateLimit(logger *zap.Logger, limit int) func(http.Handler) http.Handler {
 			logger.Debug("rate limit exceeded",
 				zap.String("ip", r.RemoteAddr),
 				zap.String("path", r.URL.Path))
+			metrics.RateLimited(r.URL.Path)
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusTooManyRequests)
 			_, err := w.Write([]byte(ErrTooManyRouteRequests))
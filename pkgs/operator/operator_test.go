@@ -2,6 +2,7 @@ package operator_test
 
 import (
 	"bytes"
+	"context"
 	"crypto/rsa"
 	"encoding/hex"
 	"encoding/json"
@@ -74,6 +75,8 @@ func TestRateLimit(t *testing.T) {
 			Operators:             parts,
 			T:                     3,
 			WithdrawalCredentials: common.HexToAddress("0x0000000000000000000000000000000000000009").Bytes(),
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
 			Fork:                  [4]byte{0, 0, 0, 0},
 			Owner:                 common.HexToAddress("0x0000000000000000000000000000000000000007"),
 			Nonce:                 0,
@@ -214,6 +217,8 @@ func TestWrongInitiatorSignature(t *testing.T) {
 			Operators:             parts,
 			T:                     uint64(threshold),
 			WithdrawalCredentials: withdraw.Bytes(),
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
 			Fork:                  [4]byte{0, 0, 0, 0},
 			Owner:                 owner,
 			Nonce:                 0,
@@ -427,3 +432,158 @@ func TestRecoverSharesData(t *testing.T) {
 		require.True(t, pubShare.V.Equal(expShare), "share %s give pub %s vs exp %s", share.V.String(), pubShare.V.String(), expShare.String())
 	}
 }
+
+func TestMetrics(t *testing.T) {
+	version := "test.version"
+	srv := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	defer srv.HttpSrv.Close()
+	client := req.C()
+	client.SetRootCertsFromFile(rootCert...)
+	res, err := client.R().SetBodyBytes([]byte{}).Post(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "dkg"))
+	require.NoError(t, err)
+	require.Equal(t, 400, res.StatusCode)
+
+	// metrics are disabled at the operator port without the token
+	res, err = client.R().Get(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "metrics"))
+	require.NoError(t, err)
+	require.Equal(t, 404, res.StatusCode)
+	srv.Srv.MetricsToken = "token"
+	res, err = client.R().SetBearerAuthToken("wrong").Get(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "metrics"))
+	require.NoError(t, err)
+	require.Equal(t, 401, res.StatusCode)
+
+	srv.Srv.State.Metrics.CeremonyStarted(time.Now())
+	srv.Srv.State.Metrics.CeremonyCompleted()
+	srv.Srv.State.Metrics.SigningCompleted("exit", time.Now())
+	srv.Srv.State.Metrics.SigningFailed("resign")
+	res, err = client.R().SetBearerAuthToken("token").Get(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "metrics"))
+	require.NoError(t, err)
+	require.Equal(t, 200, res.StatusCode)
+	metrics := res.String()
+	require.Contains(t, metrics, `ssv_dkg_operator_errors_total{route="/dkg",type="other"} 1`)
+	require.Contains(t, metrics, "ssv_dkg_operator_active_instances 0")
+	require.Contains(t, metrics, "ssv_dkg_operator_ceremonies_started_total 1")
+	require.Contains(t, metrics, "ssv_dkg_operator_ceremonies_completed_total 1")
+	require.Contains(t, metrics, `ssv_dkg_operator_signings_total{result="completed",type="exit"} 1`)
+	require.Contains(t, metrics, `ssv_dkg_operator_signings_total{result="failed",type="resign"} 1`)
+	require.NotContains(t, metrics, "ssv_dkg_operator_ceremonies_failed_total{")
+	require.Contains(t, metrics, `ssv_dkg_operator_phase_duration_seconds_count{phase="exit"} 1`)
+	require.Contains(t, metrics, `ssv_dkg_operator_errors_total{route="/metrics",type="other"} 2`)
+}
+
+func TestCeremoniesStatus(t *testing.T) {
+	version := "test.version"
+	srv := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	defer srv.HttpSrv.Close()
+	client := req.C()
+	client.SetRootCertsFromFile(rootCert...)
+	t.Run("test status API is disabled without token", func(t *testing.T) {
+		res, err := client.R().SetBearerAuthToken("token").Get(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "ceremonies"))
+		require.NoError(t, err)
+		require.Equal(t, 404, res.StatusCode)
+	})
+	srv.Srv.StatusToken = "token"
+	t.Run("test unauthorized", func(t *testing.T) {
+		res, err := client.R().Get(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "ceremonies"))
+		require.NoError(t, err)
+		require.Equal(t, 401, res.StatusCode)
+		res, err = client.R().SetBearerAuthToken("wrong").Get(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "ceremonies"))
+		require.NoError(t, err)
+		require.Equal(t, 401, res.StatusCode)
+	})
+	t.Run("test ceremonies", func(t *testing.T) {
+		res, err := client.R().SetBearerAuthToken("token").Get(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "ceremonies"))
+		require.NoError(t, err)
+		require.Equal(t, 200, res.StatusCode)
+		var statuses []*wire.CeremonyStatus
+		require.NoError(t, json.Unmarshal(res.Bytes(), &statuses))
+		require.Empty(t, statuses)
+	})
+	t.Run("test missing ceremony", func(t *testing.T) {
+		id := crypto.NewID()
+		res, err := client.R().SetBearerAuthToken("token").Get(fmt.Sprintf("%v/%v/%x", srv.HttpSrv.URL, "ceremonies", id))
+		require.NoError(t, err)
+		require.Equal(t, 404, res.StatusCode)
+		res, err = client.R().SetBearerAuthToken("token").Get(fmt.Sprintf("%v/%v/%v", srv.HttpSrv.URL, "ceremonies", "invalid"))
+		require.NoError(t, err)
+		require.Equal(t, 400, res.StatusCode)
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	version := "test.version"
+	srv := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	defer srv.HttpSrv.Close()
+	// initiator key
+	_, pv, err := rsaencryption.GenerateKeys()
+	require.NoError(t, err)
+	priv, err := rsaencryption.ConvertPemToPrivateKey(string(pv))
+	require.NoError(t, err)
+	initPubBytes, err := crypto.EncodeRSAPublicKey(&priv.PublicKey)
+	require.NoError(t, err)
+	// a valid init message of a 4 operators cluster with the operator
+	ops := make([]*wire.Operator, 0, 4)
+	for id := uint64(1); id <= 4; id++ {
+		pubKey := &srv.PrivKey.PublicKey
+		if id != srv.ID {
+			_, opPv, err := rsaencryption.GenerateKeys()
+			require.NoError(t, err)
+			opPriv, err := rsaencryption.ConvertPemToPrivateKey(string(opPv))
+			require.NoError(t, err)
+			pubKey = &opPriv.PublicKey
+		}
+		pkBytes, err := crypto.EncodeRSAPublicKey(pubKey)
+		require.NoError(t, err)
+		ops = append(ops, &wire.Operator{ID: id, PubKey: pkBytes})
+	}
+	init := &wire.Init{
+		Operators:             ops,
+		T:                     3,
+		WithdrawalCredentials: common.HexToAddress("0x0000000000000000000000000000000000000009").Bytes(),
+		WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+		Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
+		Fork:                  [4]byte{0, 0, 0, 0},
+		Owner:                 common.HexToAddress("0x0000000000000000000000000000000000000007"),
+		Nonce:                 0,
+	}
+	sszinit, err := init.MarshalSSZ()
+	require.NoError(t, err)
+	ts := &wire.Transport{
+		Type:       wire.InitMessageType,
+		Identifier: crypto.NewID(),
+		Data:       sszinit,
+		Version:    []byte(version),
+	}
+	tsssz, err := ts.MarshalSSZ()
+	require.NoError(t, err)
+	sig, err := crypto.SignRSA(priv, tsssz)
+	require.NoError(t, err)
+	msg, err := (&wire.SignedTransport{Message: ts, Signer: initPubBytes, Signature: sig}).MarshalSSZ()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, srv.Srv.Shutdown(ctx))
+	require.NoError(t, ctx.Err())
+	t.Run("test init is rejected after shutdown", func(t *testing.T) {
+		client := req.C()
+		client.SetRootCertsFromFile(rootCert...)
+		res, err := client.R().SetBodyBytes(msg).Post(fmt.Sprintf("%v/%v", srv.HttpSrv.URL, "init"))
+		require.NoError(t, err)
+		require.Equal(t, 503, res.StatusCode)
+		require.Contains(t, res.String(), utils.ErrShuttingDown.Error())
+		require.Zero(t, srv.Srv.State.ActiveInstances())
+	})
+	t.Run("test start after shutdown", func(t *testing.T) {
+		errC := make(chan error, 1)
+		go func() {
+			errC <- srv.Srv.Start(0, operatorCert, operatorKey)
+		}()
+		select {
+		case err := <-errC:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("server was started after shutdown")
+		}
+	})
+}
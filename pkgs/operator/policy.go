@@ -0,0 +1,159 @@
+package operator
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	e2m_core "github.com/bloxapp/eth2-key-manager/core"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
+)
+
+// PolicyWindow is a period at which validators per owner are limited by the policy
+const PolicyWindow = 24 * time.Hour
+
+// PolicyError is returned to initiator when its request is rejected by the operator policy
+type PolicyError struct {
+	Reason string
+}
+
+func (e *PolicyError) Error() string {
+	return "rejected by operator policy: " + e.Reason
+}
+
+// Policy restricts init, reshare, resign and exit requests accepted by the operator. Empty lists allow any value,
+// zero MaxValidatorsPerOwnerPerDay doesnt limit the number of validators.
+type Policy struct {
+	Owners                      []common.Address `json:"owners"`
+	WithdrawAddresses           []common.Address `json:"withdraw_addresses"`
+	InitiatorPubKeys            []string         `json:"initiator_pub_keys"` // base64 encoded PEM, same as operator public keys at operators info
+	Networks                    []string         `json:"networks"`
+	MaxValidatorsPerOwnerPerDay uint64           `json:"max_validators_per_owner_per_day"`
+
+	mtx        sync.Mutex
+	initiators []*rsa.PublicKey
+	history    map[common.Address][]time.Time // reserved and successful ceremonies per owner at the last PolicyWindow
+}
+
+// LoadPolicy reads a JSON policy file
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+	p := &Policy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy file: %w", err)
+	}
+	if err := p.init(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// init parses initiator public keys and checks networks of the policy
+func (p *Policy) init() error {
+	p.initiators = make([]*rsa.PublicKey, 0, len(p.InitiatorPubKeys))
+	for _, pk := range p.InitiatorPubKeys {
+		pubKey, err := crypto.ParseRSAPublicKey([]byte(pk))
+		if err != nil {
+			return fmt.Errorf("failed to parse initiator public key %s: %w", pk, err)
+		}
+		p.initiators = append(p.initiators, pubKey)
+	}
+	for _, network := range p.Networks {
+		if !utils.IsKnownNetwork(e2m_core.Network(network)) {
+			return fmt.Errorf("unknown network %s at policy", network)
+		}
+	}
+	p.history = make(map[common.Address][]time.Time)
+	return nil
+}
+
+// Allow checks an init request against the policy and reserves a slot of the daily limit of the owner at now.
+// The slot is kept when the ceremony succeeds and is given back by Release when it fails, so failed ceremonies
+// dont use the limit, while concurrent requests of the owner cant pass it.
+func (p *Policy) Allow(init *wire.Init, initiatorPubKey *rsa.PublicKey, now time.Time) error {
+	if len(p.WithdrawAddresses) != 0 && init.WithdrawalPrefix == crypto.BLSWithdrawalPrefixByte {
+		return &PolicyError{Reason: "BLS withdrawal credentials are not allowed"}
+	}
+	withdrawAddress := spec.InitWithdrawalAddress(init)
+	if len(p.WithdrawAddresses) != 0 && !slices.Contains(p.WithdrawAddresses, withdrawAddress) {
+		return &PolicyError{Reason: fmt.Sprintf("withdrawal address %s is not allowed", withdrawAddress.Hex())}
+	}
+	if len(p.Networks) != 0 {
+		network, err := utils.GetNetworkByFork(init.Fork)
+		if err != nil {
+			return &PolicyError{Reason: fmt.Sprintf("network fork %x is unknown", init.Fork)}
+		}
+		if !slices.Contains(p.Networks, string(network)) {
+			return &PolicyError{Reason: fmt.Sprintf("network %s is not allowed", network)}
+		}
+	}
+	return p.AllowOwner(init.Owner, initiatorPubKey, now)
+}
+
+// AllowOwner checks the owner and the initiator of a request against the policy and reserves a slot of the daily
+// limit of the owner at now, same as Allow. Reshare and resign requests keep withdrawal credentials and network
+// of the validator, so only the new owner and the initiator are checked.
+func (p *Policy) AllowOwner(owner common.Address, initiatorPubKey *rsa.PublicKey, now time.Time) error {
+	if err := p.CheckOwner(owner, initiatorPubKey); err != nil {
+		return err
+	}
+	if p.MaxValidatorsPerOwnerPerDay == 0 {
+		return nil
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	recent := p.recent(owner, now)
+	if uint64(len(recent)) >= p.MaxValidatorsPerOwnerPerDay {
+		return &PolicyError{Reason: fmt.Sprintf("owner %s reached the limit of %d validators per day", owner.Hex(), p.MaxValidatorsPerOwnerPerDay)}
+	}
+	p.history[owner] = append(recent, now)
+	return nil
+}
+
+// CheckOwner checks the owner and the initiator of a request against the policy without using the daily limit.
+// Exit requests dont create validators, so they are checked by CheckOwner only.
+func (p *Policy) CheckOwner(owner common.Address, initiatorPubKey *rsa.PublicKey) error {
+	if len(p.Owners) != 0 && !slices.Contains(p.Owners, owner) {
+		return &PolicyError{Reason: fmt.Sprintf("owner %s is not allowed", owner.Hex())}
+	}
+	if len(p.initiators) != 0 && !slices.ContainsFunc(p.initiators, func(pk *rsa.PublicKey) bool { return pk.Equal(initiatorPubKey) }) {
+		return &PolicyError{Reason: "initiator public key is not allowed"}
+	}
+	return nil
+}
+
+// Release gives back the slot of the owner reserved at the time by Allow or AllowOwner, when the ceremony fails
+func (p *Policy) Release(owner common.Address, reserved time.Time) {
+	if p.MaxValidatorsPerOwnerPerDay == 0 {
+		return
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if i := slices.IndexFunc(p.history[owner], reserved.Equal); i != -1 {
+		p.history[owner] = slices.Delete(p.history[owner], i, i+1)
+	}
+}
+
+// recent drops ceremonies of the owner older than the window and returns the rest, p.mtx should be locked
+func (p *Policy) recent(owner common.Address, now time.Time) []time.Time {
+	if p.history == nil {
+		p.history = make(map[common.Address][]time.Time)
+	}
+	p.history[owner] = slices.DeleteFunc(p.history[owner], func(t time.Time) bool {
+		return !now.Before(t.Add(PolicyWindow))
+	})
+	return p.history[owner]
+}
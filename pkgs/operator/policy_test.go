@@ -0,0 +1,126 @@
+package operator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+func writePolicy(t *testing.T, policy any) string {
+	data, err := json.Marshal(policy)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestPolicy(t *testing.T) {
+	owner := common.HexToAddress("0x81592c3de184a3e2c0dcb5a261bc107bfa91f494")
+	withdrawAddress := common.HexToAddress("0x5cC0DdE14E7256340CC820415a6022a7d1c93A35")
+	initiator := singleOperatorKeys(t)
+	initiatorPubKey, err := crypto.EncodeRSAPublicKey(&initiator.PublicKey)
+	require.NoError(t, err)
+	path := writePolicy(t, map[string]any{
+		"owners":                           []string{owner.Hex()},
+		"withdraw_addresses":               []string{withdrawAddress.Hex()},
+		"initiator_pub_keys":               []string{string(initiatorPubKey)},
+		"networks":                         []string{"holesky"},
+		"max_validators_per_owner_per_day": 2,
+	})
+	policy, err := LoadPolicy(path)
+	require.NoError(t, err)
+	newInit := func() *wire.Init {
+		return &wire.Init{
+			WithdrawalCredentials: withdrawAddress.Bytes(),
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
+			Fork:                  [4]byte{0x01, 0x01, 0x70, 0x00},
+			Owner:                 owner,
+		}
+	}
+	now := time.Now()
+	t.Run("test not allowed owner", func(t *testing.T) {
+		init := newInit()
+		init.Owner = common.HexToAddress("0x01")
+		err := policy.Allow(init, &initiator.PublicKey, now)
+		require.ErrorContains(t, err, "owner 0x0000000000000000000000000000000000000001 is not allowed")
+		var policyErr *PolicyError
+		require.ErrorAs(t, err, &policyErr)
+	})
+	t.Run("test not allowed withdrawal address", func(t *testing.T) {
+		init := newInit()
+		init.WithdrawalCredentials = common.HexToAddress("0x02").Bytes()
+		err := policy.Allow(init, &initiator.PublicKey, now)
+		require.ErrorContains(t, err, "withdrawal address 0x0000000000000000000000000000000000000002 is not allowed")
+	})
+	t.Run("test not allowed BLS withdrawal credentials", func(t *testing.T) {
+		init := newInit()
+		init.WithdrawalPrefix = crypto.BLSWithdrawalPrefixByte
+		init.WithdrawalCredentials = make([]byte, 32)
+		err := policy.Allow(init, &initiator.PublicKey, now)
+		require.ErrorContains(t, err, "BLS withdrawal credentials are not allowed")
+	})
+	t.Run("test not allowed initiator", func(t *testing.T) {
+		err := policy.Allow(newInit(), &singleOperatorKeys(t).PublicKey, now)
+		require.ErrorContains(t, err, "initiator public key is not allowed")
+	})
+	t.Run("test not allowed network", func(t *testing.T) {
+		init := newInit()
+		init.Fork = [4]byte{0, 0, 0, 0}
+		err := policy.Allow(init, &initiator.PublicKey, now)
+		require.ErrorContains(t, err, "network mainnet is not allowed")
+		init.Fork = [4]byte{0xff, 0, 0, 0}
+		err = policy.Allow(init, &initiator.PublicKey, now)
+		require.ErrorContains(t, err, "network fork ff000000 is unknown")
+	})
+	t.Run("test validators per owner per day", func(t *testing.T) {
+		// accepted requests reserve slots of the limit
+		require.NoError(t, policy.Allow(newInit(), &initiator.PublicKey, now))
+		require.NoError(t, policy.Allow(newInit(), &initiator.PublicKey, now.Add(time.Hour)))
+		err := policy.Allow(newInit(), &initiator.PublicKey, now.Add(2*time.Hour))
+		require.ErrorContains(t, err, "reached the limit of 2 validators per day")
+		// reshare and resign requests of the owner use the same limit, exit requests dont
+		err = policy.AllowOwner(owner, &initiator.PublicKey, now.Add(2*time.Hour))
+		require.ErrorContains(t, err, "reached the limit of 2 validators per day")
+		require.NoError(t, policy.CheckOwner(owner, &initiator.PublicKey))
+		// a failed ceremony gives its slot back
+		policy.Release(owner, now.Add(time.Hour))
+		require.NoError(t, policy.Allow(newInit(), &initiator.PublicKey, now.Add(2*time.Hour)))
+		// the first ceremony leaves the window
+		require.NoError(t, policy.Allow(newInit(), &initiator.PublicKey, now.Add(PolicyWindow)))
+		err = policy.Allow(newInit(), &initiator.PublicKey, now.Add(PolicyWindow))
+		require.ErrorContains(t, err, "reached the limit of 2 validators per day")
+	})
+	t.Run("test reshare and resign owner", func(t *testing.T) {
+		err := policy.AllowOwner(common.HexToAddress("0x01"), &initiator.PublicKey, now)
+		require.ErrorContains(t, err, "owner 0x0000000000000000000000000000000000000001 is not allowed")
+		err = policy.AllowOwner(owner, &singleOperatorKeys(t).PublicKey, now)
+		require.ErrorContains(t, err, "initiator public key is not allowed")
+		err = policy.CheckOwner(owner, &singleOperatorKeys(t).PublicKey)
+		require.ErrorContains(t, err, "initiator public key is not allowed")
+	})
+	t.Run("test empty policy", func(t *testing.T) {
+		policy, err := LoadPolicy(writePolicy(t, map[string]any{}))
+		require.NoError(t, err)
+		init := newInit()
+		init.Owner = common.HexToAddress("0x01")
+		init.Fork = [4]byte{0, 0, 0, 0}
+		for i := 0; i < 10; i++ {
+			require.NoError(t, policy.Allow(init, &singleOperatorKeys(t).PublicKey, now))
+		}
+	})
+	t.Run("test invalid policy", func(t *testing.T) {
+		_, err := LoadPolicy(writePolicy(t, map[string]any{"networks": []string{"goerli"}}))
+		require.ErrorContains(t, err, "unknown network goerli at policy")
+		_, err = LoadPolicy(writePolicy(t, map[string]any{"initiator_pub_keys": []string{"invalid"}}))
+		require.ErrorContains(t, err, "failed to parse initiator public key")
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/bloxapp/ssv-dkg/pkgs/utils"
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
 	"github.com/bloxapp/ssv-dkg/spec"
+	"github.com/bloxapp/ssv-dkg/spec/eip1271"
 	"github.com/bloxapp/ssv/utils/rsaencryption"
 )
 
@@ -66,6 +68,29 @@ func (iw *instWrapper) ReadError() error {
 	return <-iw.errChan
 }
 
+// PhaseResponse is an operator response to a ceremony phase message. It is kept for the lifetime
+// of the instance, so that a resent phase message is answered without processing it twice.
+type PhaseResponse struct {
+	msgHash [32]byte
+	done    chan struct{}
+	resp    []byte
+	err     error
+}
+
+// PhaseResponses maps ceremony phases, types of phase messages, to operator responses
+type PhaseResponses map[wire.TransportType]*PhaseResponse
+
+// Wait returns the response when the phase message is processed
+func (p *PhaseResponse) Wait() ([]byte, error) {
+	<-p.done
+	return p.resp, p.err
+}
+
+func (p *PhaseResponse) finish(resp []byte, err error) {
+	p.resp, p.err = resp, err
+	close(p.done)
+}
+
 // InstanceID each new DKG ceremony has a unique random ID that we can identify messages and be able to process them in parallel
 type InstanceID [24]byte
 
@@ -79,6 +104,21 @@ type Switch struct {
 	Version          []byte
 	PubKeyBytes      []byte
 	OperatorID       uint64
+	EthClient        eip1271.ETHClient             // optional ethereum client to verify owner signatures of smart contract accounts
+	Shares           *ShareStore                   // optional store of operator's key shares, shares arent persisted and are decrypted from proofs sent by initiator if not set
+	ThresholdPolicy  spec.ThresholdPolicy          // accepted number of operators and threshold, SSV clusters by default
+	Phases           map[InstanceID]PhaseResponses // responses to ceremony phases to answer initiator resends
+	Policy           *Policy                       // optional policy restricting init, reshare, resign and exit requests, any request is accepted if not set
+	Metrics          *Metrics                      // optional prometheus metrics of the operator
+	Phaser           wire.PhaserConfig             // phaser of the kyber protocol of ceremonies, event phaser by default
+	draining         bool                          // new instances arent created while the operator shuts down
+	slots            map[InstanceID]policySlot     // slots of the policy daily limit reserved by instances, released when their ceremonies fail
+}
+
+// policySlot is a slot of the daily limit of the owner reserved by the policy at the time of the request
+type policySlot struct {
+	owner common.Address
+	at    time.Time
 }
 
 // CreateInstance creates a LocalOwner instance with the DKG ceremony ID, that we can identify it later. Initiator public key identifies an initiator for
@@ -92,6 +132,44 @@ func (s *Switch) CreateInstance(reqID [24]byte, init *wire.Init, initiatorPublic
 	if s.OperatorID != operatorID {
 		return nil, nil, fmt.Errorf("wrong operator ID")
 	}
+	owner, bchan := s.newLocalOwner(reqID, operatorID, initiatorPublicKey)
+	// wait for exchange msg
+	resp, err := owner.Init(reqID, init)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := owner.Broadcast(resp); err != nil {
+		return nil, nil, err
+	}
+	res := <-bchan
+	return &instWrapper{owner, initiatorPublicKey, bchan, owner.ErrorChan}, res, nil
+}
+
+// CreateReshareInstance creates a LocalOwner instance for a resharing ceremony. Operator should be at old or new operators set.
+func (s *Switch) CreateReshareInstance(reqID [24]byte, reshare *wire.ReshareMessage, initiatorPublicKey *rsa.PublicKey) (Instance, []byte, error) {
+	operatorID, err := spec.OperatorIDByPubKey(spec.ReshareOperators(&reshare.SignedReshare.Reshare), s.PubKeyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	// sanity check of operator ID
+	if s.OperatorID != operatorID {
+		return nil, nil, fmt.Errorf("wrong operator ID")
+	}
+	owner, bchan := s.newLocalOwner(reqID, operatorID, initiatorPublicKey)
+	// wait for exchange msg
+	resp, err := owner.InitReshare(reqID, reshare)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := owner.Broadcast(resp); err != nil {
+		return nil, nil, err
+	}
+	res := <-bchan
+	return &instWrapper{owner, initiatorPublicKey, bchan, owner.ErrorChan}, res, nil
+}
+
+// newLocalOwner creates a LocalOwner which broadcasts its messages to the returned channel
+func (s *Switch) newLocalOwner(reqID [24]byte, operatorID uint64, initiatorPublicKey *rsa.PublicKey) (*dkg.LocalOwner, chan []byte) {
 	bchan := make(chan []byte, 1)
 	broadcast := func(msg []byte) error {
 		bchan <- msg
@@ -108,18 +186,28 @@ func (s *Switch) CreateInstance(reqID [24]byte, init *wire.Init, initiatorPublic
 		InitiatorPublicKey: initiatorPublicKey,
 		OperatorPublicKey:  &s.PrivateKey.PublicKey,
 		Version:            s.Version,
+		Phaser:             s.Phaser,
 	}
-	owner := dkg.New(&opts)
-	// wait for exchange msg
-	resp, err := owner.Init(reqID, init)
-	if err != nil {
-		return nil, nil, err
-	}
-	if err := owner.Broadcast(resp); err != nil {
-		return nil, nil, err
+	if s.Shares != nil {
+		opts.StoreShareF = func(reqID [24]byte, nonce uint64, proof *wire.SignedProof) error {
+			return s.Shares.Save(&StoredShare{
+				RequestID:  reqID,
+				OperatorID: operatorID,
+				Nonce:      nonce,
+				CreatedAt:  time.Now().UTC(),
+				Proof:      proof,
+			})
+		}
+		opts.LoadShareF = func(validatorPubKey, sharePubKey []byte) (*wire.SignedProof, error) {
+			share, ok := s.Shares.ByShare(validatorPubKey, sharePubKey)
+			if !ok {
+				// shares of validators created before the store are taken from the proofs sent by initiator
+				return nil, nil
+			}
+			return share.Proof, nil
+		}
 	}
-	res := <-bchan
-	return &instWrapper{owner, initiatorPublicKey, bchan, owner.ErrorChan}, res, nil
+	return dkg.New(&opts), bchan
 }
 
 // Sign creates a RSA signature for the message at operator before sending it to initiator
@@ -144,6 +232,7 @@ func NewSwitch(pv *rsa.PrivateKey, logger *zap.Logger, ver, pkBytes []byte, id u
 		Mtx:              sync.RWMutex{},
 		InstanceInitTime: make(map[InstanceID]time.Time, MaxInstances),
 		Instances:        make(map[InstanceID]Instance, MaxInstances),
+		Phases:           make(map[InstanceID]PhaseResponses, MaxInstances),
 		PrivateKey:       pv,
 		Version:          ver,
 		PubKeyBytes:      pkBytes,
@@ -158,56 +247,495 @@ func (s *Switch) InitInstance(reqID [24]byte, initMsg *wire.Transport, initiator
 	}
 	logger := s.Logger.With(zap.String("reqid", hex.EncodeToString(reqID[:])))
 	logger.Info("🚀 Initializing DKG instance")
-	init := &wire.Init{}
-	if err := init.UnmarshalSSZ(initMsg.Data); err != nil {
+	start := time.Now()
+	init, err := decodeInit(initMsg)
+	if err != nil {
 		return nil, fmt.Errorf("init: failed to unmarshal init message: %s", err.Error())
 	}
-	if err := spec.ValidateInitMessage(init); err != nil {
+	if err := spec.ValidateInitMessageWithPolicy(init, s.ThresholdPolicy); err != nil {
 		return nil, err
 	}
 	// Check that incoming message signature is valid
+	initiatorPubKey, err := s.verifyInitiatorSignature(initMsg, initiatorPub, initiatorSignature)
+	if err != nil {
+		return nil, fmt.Errorf("init: %s", err.Error())
+	}
+	slot := policySlot{owner: init.Owner, at: time.Now()}
+	if s.Policy != nil {
+		if err := s.Policy.Allow(init, initiatorPubKey, slot.at); err != nil {
+			logger.Warn("⛔ init request rejected by policy", zap.Error(err))
+			return nil, fmt.Errorf("init: %w", err)
+		}
+	}
+	if err := s.reserveInstance(reqID); err != nil {
+		s.releaseSlot(slot)
+		return nil, err
+	}
+	inst, resp, err := s.CreateInstance(reqID, init, initiatorPubKey)
+	if err != nil {
+		s.releaseSlot(slot)
+		s.Metrics.CeremonyFailed(phaseInit)
+		return nil, fmt.Errorf("init: failed to create instance: %s", err.Error())
+	}
+	s.storeInstance(reqID, inst, slot)
+	s.Metrics.CeremonyStarted(start)
+	return resp, nil
+}
+
+// decodeInit unmarshals init message by its version: InitV2 carries clusters larger than wire.MaxInitOperators
+func decodeInit(initMsg *wire.Transport) (*wire.Init, error) {
+	switch initMsg.Type {
+	case wire.InitMessageType:
+		init := &wire.Init{}
+		if err := init.UnmarshalSSZ(initMsg.Data); err != nil {
+			return nil, err
+		}
+		return init, nil
+	case wire.InitV2MessageType:
+		initV2 := &wire.InitV2{}
+		if err := initV2.UnmarshalSSZ(initMsg.Data); err != nil {
+			return nil, err
+		}
+		return initV2.ToInit(), nil
+	default:
+		return nil, fmt.Errorf("unknown init message type %s", initMsg.Type)
+	}
+}
+
+// decodeReshare unmarshals reshare message by its version: ReshareMessageV2 carries clusters larger than wire.MaxInitOperators
+func decodeReshare(reshareMsg *wire.Transport) (*wire.ReshareMessage, error) {
+	switch reshareMsg.Type {
+	case wire.ReshareMessageType:
+		reshare := &wire.ReshareMessage{}
+		if err := reshare.UnmarshalSSZ(reshareMsg.Data); err != nil {
+			return nil, err
+		}
+		return reshare, nil
+	case wire.ReshareV2MessageType:
+		reshareV2 := &wire.ReshareMessageV2{}
+		if err := reshareV2.UnmarshalSSZ(reshareMsg.Data); err != nil {
+			return nil, err
+		}
+		return reshareV2.ToReshareMessage(), nil
+	default:
+		return nil, fmt.Errorf("unknown reshare message type %s", reshareMsg.Type)
+	}
+}
+
+// decodeResign unmarshals resign message by its version: ResignMessageV2 carries clusters larger than wire.MaxInitOperators
+func decodeResign(resignMsg *wire.Transport) (*wire.ResignMessage, error) {
+	switch resignMsg.Type {
+	case wire.ResignMessageType:
+		resign := &wire.ResignMessage{}
+		if err := resign.UnmarshalSSZ(resignMsg.Data); err != nil {
+			return nil, err
+		}
+		return resign, nil
+	case wire.ResignV2MessageType:
+		resignV2 := &wire.ResignMessageV2{}
+		if err := resignV2.UnmarshalSSZ(resignMsg.Data); err != nil {
+			return nil, err
+		}
+		return resignV2.ToResignMessage(), nil
+	default:
+		return nil, fmt.Errorf("unknown resign message type %s", resignMsg.Type)
+	}
+}
+
+// decodeExit unmarshals exit message by its version: ExitMessageV2 carries clusters larger than wire.MaxInitOperators
+func decodeExit(exitMsg *wire.Transport) (*wire.ExitMessage, error) {
+	switch exitMsg.Type {
+	case wire.ExitMessageType:
+		exit := &wire.ExitMessage{}
+		if err := exit.UnmarshalSSZ(exitMsg.Data); err != nil {
+			return nil, err
+		}
+		return exit, nil
+	case wire.ExitV2MessageType:
+		exitV2 := &wire.ExitMessageV2{}
+		if err := exitV2.UnmarshalSSZ(exitMsg.Data); err != nil {
+			return nil, err
+		}
+		return exitV2.ToExitMessage(), nil
+	default:
+		return nil, fmt.Errorf("unknown exit message type %s", exitMsg.Type)
+	}
+}
+
+// InitReshareInstance verifies a reshare message signed by the validator owner and creates a LocalOwner instance for resharing
+func (s *Switch) InitReshareInstance(reqID [24]byte, reshareMsg *wire.Transport, initiatorPub, initiatorSignature []byte) ([]byte, error) {
+	if !bytes.Equal(reshareMsg.Version, s.Version) {
+		return nil, fmt.Errorf("wrong version: remote %s local %s", reshareMsg.Version, s.Version)
+	}
+	logger := s.Logger.With(zap.String("reqid", hex.EncodeToString(reqID[:])))
+	logger.Info("🚀 Initializing resharing instance")
+	start := time.Now()
+	reshare, err := decodeReshare(reshareMsg)
+	if err != nil {
+		return nil, fmt.Errorf("reshare: failed to unmarshal reshare message: %s", err.Error())
+	}
+	if reshare.SignedReshare == nil {
+		return nil, fmt.Errorf("reshare: missing signed reshare message")
+	}
+	if len(reshare.Proofs) != len(reshare.SignedReshare.Reshare.OldOperators) {
+		return nil, fmt.Errorf("reshare: proofs count doesnt match old operators count")
+	}
+	proofs := make(map[*wire.Operator]wire.SignedProof, len(reshare.Proofs))
+	for i, op := range reshare.SignedReshare.Reshare.OldOperators {
+		proofs[op] = *reshare.Proofs[i]
+	}
+	if err := spec.ValidateReshareMessageWithPolicy(&reshare.SignedReshare.Reshare, proofs, s.ThresholdPolicy); err != nil {
+		return nil, err
+	}
+	if _, _, err := spec.AddressWithdrawalCredentials(reshare.WithdrawalPrefix, reshare.Amount, reshare.WithdrawalCredentials); err != nil {
+		return nil, fmt.Errorf("reshare: %w", err)
+	}
+	hash, err := reshare.SignedReshare.Reshare.SigningRoot()
+	if err != nil {
+		return nil, fmt.Errorf("reshare: failed to hash reshare message: %s", err.Error())
+	}
+	if err := s.verifyOwnerSignature(reshare.SignedReshare.Reshare.Owner, hash, reshare.SignedReshare.Signature); err != nil {
+		return nil, fmt.Errorf("reshare: owner signature isn't valid: %s", err.Error())
+	}
+	// Check that incoming message signature is valid
+	initiatorPubKey, err := s.verifyInitiatorSignature(reshareMsg, initiatorPub, initiatorSignature)
+	if err != nil {
+		return nil, fmt.Errorf("reshare: %s", err.Error())
+	}
+	slot := policySlot{owner: reshare.SignedReshare.Reshare.Owner, at: time.Now()}
+	if s.Policy != nil {
+		if err := s.Policy.AllowOwner(slot.owner, initiatorPubKey, slot.at); err != nil {
+			logger.Warn("⛔ reshare request rejected by policy", zap.Error(err))
+			return nil, fmt.Errorf("reshare: %w", err)
+		}
+	}
+	if err := s.reserveInstance(reqID); err != nil {
+		s.releaseSlot(slot)
+		return nil, err
+	}
+	inst, resp, err := s.CreateReshareInstance(reqID, reshare, initiatorPubKey)
+	if err != nil {
+		s.releaseSlot(slot)
+		s.Metrics.CeremonyFailed(phaseInit)
+		return nil, fmt.Errorf("reshare: failed to create instance: %s", err.Error())
+	}
+	s.storeInstance(reqID, inst, slot)
+	s.Metrics.CeremonyStarted(start)
+	return resp, nil
+}
+
+// ProcessResign verifies a resign message signed by the validator owner and signs the new owner and nonce with the operator's key share.
+// Resigning is done in one round, so no instance is stored at Switch.
+func (s *Switch) ProcessResign(reqID [24]byte, resignMsg *wire.Transport, initiatorPub, initiatorSignature []byte) ([]byte, error) {
+	if !bytes.Equal(resignMsg.Version, s.Version) {
+		return nil, fmt.Errorf("wrong version: remote %s local %s", resignMsg.Version, s.Version)
+	}
+	logger := s.Logger.With(zap.String("reqid", hex.EncodeToString(reqID[:])))
+	logger.Info("🚀 Resigning owner and nonce")
+	start := time.Now()
+	resign, err := decodeResign(resignMsg)
+	if err != nil {
+		return nil, fmt.Errorf("resign: failed to unmarshal resign message: %s", err.Error())
+	}
+	if resign.SignedResign == nil {
+		return nil, fmt.Errorf("resign: missing signed resign message")
+	}
+	if len(resign.Proofs) != len(resign.SignedResign.Resign.Operators) {
+		return nil, fmt.Errorf("resign: proofs count doesnt match operators count")
+	}
+	proofs := make(map[*wire.Operator]wire.SignedProof, len(resign.Proofs))
+	for i, op := range resign.SignedResign.Resign.Operators {
+		proofs[op] = *resign.Proofs[i]
+	}
+	if err := spec.ValidateResignMessage(&resign.SignedResign.Resign, proofs); err != nil {
+		return nil, err
+	}
+	if _, _, err := spec.AddressWithdrawalCredentials(resign.WithdrawalPrefix, resign.Amount, resign.WithdrawalCredentials); err != nil {
+		return nil, fmt.Errorf("resign: %w", err)
+	}
+	// resign should be signed by the current owner of the validator
+	validatorOwner, err := spec.ProofsOwner(proofs)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := resign.SignedResign.Resign.SigningRoot()
+	if err != nil {
+		return nil, fmt.Errorf("resign: failed to hash resign message: %s", err.Error())
+	}
+	if err := s.verifyOwnerSignature(validatorOwner, hash, resign.SignedResign.Signature); err != nil {
+		return nil, fmt.Errorf("resign: owner signature isn't valid: %s", err.Error())
+	}
+	// Check that incoming message signature is valid
+	initiatorPubKey, err := s.verifyInitiatorSignature(resignMsg, initiatorPub, initiatorSignature)
+	if err != nil {
+		return nil, fmt.Errorf("resign: %s", err.Error())
+	}
+	operatorID, err := spec.OperatorIDByPubKey(resign.SignedResign.Resign.Operators, s.PubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	// sanity check of operator ID
+	if s.OperatorID != operatorID {
+		return nil, fmt.Errorf("wrong operator ID")
+	}
+	slot := policySlot{owner: resign.SignedResign.Resign.Owner, at: time.Now()}
+	if s.Policy != nil {
+		if err := s.Policy.AllowOwner(slot.owner, initiatorPubKey, slot.at); err != nil {
+			logger.Warn("⛔ resign request rejected by policy", zap.Error(err))
+			return nil, fmt.Errorf("resign: %w", err)
+		}
+	}
+	owner, bchan := s.newLocalOwner(reqID, operatorID, initiatorPubKey)
+	if err := owner.Resign(reqID, resign); err != nil {
+		s.releaseSlot(slot)
+		s.Metrics.SigningFailed(phaseResign)
+		return nil, fmt.Errorf("resign: %s", err.Error())
+	}
+	resp := <-bchan
+	if done, ok := ceremonyOutcome(resp, nil); done && !ok {
+		s.releaseSlot(slot)
+		s.Metrics.SigningFailed(phaseResign)
+		return resp, nil
+	}
+	s.Metrics.SigningCompleted(phaseResign, start)
+	return resp, nil
+}
+
+// ProcessExit verifies a voluntary exit message signed by the validator owner and signs the exit with the operator's key share.
+// Exit signing is done in one round, so no instance is stored at Switch.
+func (s *Switch) ProcessExit(reqID [24]byte, exitMsg *wire.Transport, initiatorPub, initiatorSignature []byte) ([]byte, error) {
+	if !bytes.Equal(exitMsg.Version, s.Version) {
+		return nil, fmt.Errorf("wrong version: remote %s local %s", exitMsg.Version, s.Version)
+	}
+	logger := s.Logger.With(zap.String("reqid", hex.EncodeToString(reqID[:])))
+	logger.Info("🚀 Signing voluntary exit")
+	start := time.Now()
+	exit, err := decodeExit(exitMsg)
+	if err != nil {
+		return nil, fmt.Errorf("exit: failed to unmarshal exit message: %s", err.Error())
+	}
+	if exit.SignedExit == nil {
+		return nil, fmt.Errorf("exit: missing signed exit message")
+	}
+	if len(exit.Proofs) != len(exit.SignedExit.Exit.Operators) {
+		return nil, fmt.Errorf("exit: proofs count doesnt match operators count")
+	}
+	proofs := make(map[*wire.Operator]wire.SignedProof, len(exit.Proofs))
+	for i, op := range exit.SignedExit.Exit.Operators {
+		proofs[op] = *exit.Proofs[i]
+	}
+	if err := spec.ValidateExitMessage(&exit.SignedExit.Exit, proofs); err != nil {
+		return nil, err
+	}
+	// exit should be signed by the current owner of the validator
+	validatorOwner, err := spec.ProofsOwner(proofs)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := exit.SignedExit.Exit.SigningRoot()
+	if err != nil {
+		return nil, fmt.Errorf("exit: failed to hash exit message: %s", err.Error())
+	}
+	if err := s.verifyOwnerSignature(validatorOwner, hash, exit.SignedExit.Signature); err != nil {
+		return nil, fmt.Errorf("exit: owner signature isn't valid: %s", err.Error())
+	}
+	// Check that incoming message signature is valid
+	initiatorPubKey, err := s.verifyInitiatorSignature(exitMsg, initiatorPub, initiatorSignature)
+	if err != nil {
+		return nil, fmt.Errorf("exit: %s", err.Error())
+	}
+	// exit doesnt create a validator, so the owner and the initiator are checked without the daily limit
+	if s.Policy != nil {
+		if err := s.Policy.CheckOwner(validatorOwner, initiatorPubKey); err != nil {
+			logger.Warn("⛔ exit request rejected by policy", zap.Error(err))
+			return nil, fmt.Errorf("exit: %w", err)
+		}
+	}
+	operatorID, err := spec.OperatorIDByPubKey(exit.SignedExit.Exit.Operators, s.PubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	// sanity check of operator ID
+	if s.OperatorID != operatorID {
+		return nil, fmt.Errorf("wrong operator ID")
+	}
+	owner, bchan := s.newLocalOwner(reqID, operatorID, initiatorPubKey)
+	if err := owner.SignExit(reqID, exit); err != nil {
+		s.Metrics.SigningFailed(phaseExit)
+		return nil, fmt.Errorf("exit: %s", err.Error())
+	}
+	resp := <-bchan
+	if done, ok := ceremonyOutcome(resp, nil); done && !ok {
+		s.Metrics.SigningFailed(phaseExit)
+		return resp, nil
+	}
+	s.Metrics.SigningCompleted(phaseExit, start)
+	return resp, nil
+}
+
+// verifyOwnerSignature verifies owner signature over the message hash. Signatures of smart contract
+// accounts (EIP-1271) can be verified only if the operator is connected to an ethereum node.
+func (s *Switch) verifyOwnerSignature(owner [20]byte, hash [32]byte, signature []byte) error {
+	if s.EthClient == nil {
+		return spec.VerifyEOASignature(owner, hash, signature)
+	}
+	return spec.VerifyOwnerSignature(s.EthClient, owner, hash, signature)
+}
+
+// verifyInitiatorSignature parses initiator public key and verifies its signature over the message
+func (s *Switch) verifyInitiatorSignature(msg *wire.Transport, initiatorPub, initiatorSignature []byte) (*rsa.PublicKey, error) {
 	initiatorPubKey, err := crypto.ParseRSAPublicKey(initiatorPub)
 	if err != nil {
-		return nil, fmt.Errorf("init: failed parse initiator public key: %s", err.Error())
+		return nil, fmt.Errorf("failed parse initiator public key: %s", err.Error())
 	}
-	marshalledWireMsg, err := initMsg.MarshalSSZ()
+	marshalledWireMsg, err := msg.MarshalSSZ()
 	if err != nil {
-		return nil, fmt.Errorf("init: failed to marshal transport message: %s", err.Error())
+		return nil, fmt.Errorf("failed to marshal transport message: %s", err.Error())
 	}
 	err = crypto.VerifyRSA(initiatorPubKey, marshalledWireMsg, initiatorSignature)
 	if err != nil {
-		return nil, fmt.Errorf("init: initiator signature isn't valid: %s", err.Error())
+		return nil, fmt.Errorf("initiator signature isn't valid: %s", err.Error())
 	}
 	s.Logger.Info("✅ init message signature is successfully verified", zap.String("from initiator", fmt.Sprintf("%x", initiatorPubKey.N.Bytes())))
+	return initiatorPubKey, nil
+}
+
+// reserveInstance checks that a new instance can be created: the limit of instances is not reached
+// and there is no active instance with the same ID
+func (s *Switch) reserveInstance(reqID [24]byte) error {
 	s.Mtx.Lock()
+	defer s.Mtx.Unlock()
+	if s.draining {
+		return utils.ErrShuttingDown
+	}
 	l := len(s.Instances)
 	if l >= MaxInstances {
 		cleaned := s.CleanInstances()
 		if l-cleaned >= MaxInstances {
-			s.Mtx.Unlock()
-			return nil, utils.ErrMaxInstances
+			return utils.ErrMaxInstances
 		}
 	}
 	_, ok := s.Instances[reqID]
 	if ok {
 		tm := s.InstanceInitTime[reqID]
 		if time.Now().Before(tm.Add(MaxInstanceTime)) {
-			s.Mtx.Unlock()
-			return nil, utils.ErrAlreadyExists
+			return utils.ErrAlreadyExists
 		}
 		delete(s.Instances, reqID)
 		delete(s.InstanceInitTime, reqID)
+		delete(s.Phases, reqID)
+		s.releaseInstanceSlot(reqID)
+	}
+	return nil
+}
+
+// storeInstance saves the instance, its creation time and its policy slot
+func (s *Switch) storeInstance(reqID [24]byte, inst Instance, slot policySlot) {
+	s.Mtx.Lock()
+	s.Instances[reqID] = inst
+	s.InstanceInitTime[reqID] = time.Now()
+	if s.slots == nil {
+		s.slots = make(map[InstanceID]policySlot)
 	}
+	s.slots[reqID] = slot
 	s.Mtx.Unlock()
-	inst, resp, err := s.CreateInstance(reqID, init, initiatorPubKey)
+}
+
+// ceremonyOutcome returns whether a ceremony is done after the operator's response to a phase,
+// and whether it succeeded, i.e. the operator responded with the ceremony output
+func ceremonyOutcome(resp []byte, err error) (done, ok bool) {
 	if err != nil {
-		return nil, fmt.Errorf("init: failed to create instance: %s", err.Error())
+		return true, false
+	}
+	respType, err := responseType(resp)
+	if err != nil {
+		return true, false
+	}
+	switch respType {
+	case wire.OutputMessageType:
+		return true, true
+	case wire.ErrorMessageType, wire.BlameMessageType:
+		return true, false
+	default:
+		return false, false
+	}
+}
+
+// settleSlot keeps the policy slot of the instance when its ceremony succeeds and releases it when the ceremony fails
+func (s *Switch) settleSlot(id InstanceID, resp []byte, err error) {
+	done, ok := ceremonyOutcome(resp, err)
+	if !done {
+		return
 	}
 	s.Mtx.Lock()
-	s.Instances[reqID] = inst
-	s.InstanceInitTime[reqID] = time.Now()
+	defer s.Mtx.Unlock()
+	if ok {
+		delete(s.slots, id)
+		return
+	}
+	s.releaseInstanceSlot(id)
+}
+
+// releaseInstanceSlot releases the policy slot of an instance which ceremony didnt succeed, s.Mtx should be locked
+func (s *Switch) releaseInstanceSlot(id InstanceID) {
+	if slot, ok := s.slots[id]; ok {
+		s.releaseSlot(slot)
+		delete(s.slots, id)
+	}
+}
+
+// releaseSlot gives back a slot of the policy daily limit of a failed ceremony
+func (s *Switch) releaseSlot(slot policySlot) {
+	if s.Policy != nil {
+		s.Policy.Release(slot.owner, slot.at)
+	}
+}
+
+// responseType returns the type of a signed operator response, the response or justification phase is answered
+// with an output message when the ceremony is completed or with an error message
+func responseType(resp []byte) (wire.TransportType, error) {
+	signed := &wire.SignedTransport{}
+	if err := signed.UnmarshalSSZ(resp); err != nil {
+		return 0, err
+	}
+	return signed.Message.Type, nil
+}
+
+// Drain stops creating new instances, so that active instances can finish before the operator shuts down
+func (s *Switch) Drain() {
+	s.Mtx.Lock()
+	s.draining = true
 	s.Mtx.Unlock()
-	return resp, nil
+}
+
+// ActiveInstances returns the number of instances which arent finished yet: the operator runs the ceremony protocol
+// or waits for the initiator to send results of a completed DKG ceremony. Expired instances arent active.
+func (s *Switch) ActiveInstances() int {
+	s.Mtx.RLock()
+	defer s.Mtx.RUnlock()
+	active := 0
+	for id, inst := range s.Instances {
+		if time.Now().After(s.InstanceInitTime[id].Add(MaxInstanceTime)) {
+			continue
+		}
+		owner := inst.GetLocalOwner()
+		if owner == nil {
+			continue
+		}
+		status := owner.Status()
+		if !status.Done {
+			active++
+			continue
+		}
+		// initiator sends results only for successful DKG ceremonies
+		if status.Reshare || status.Err != nil {
+			continue
+		}
+		if _, ok := s.Phases[id][wire.ResultMessageType]; !ok {
+			active++
+		}
+	}
+	return active
 }
 
 // CleanInstances removes all instances at Switch
@@ -217,6 +745,8 @@ func (s *Switch) CleanInstances() int {
 		if time.Now().After(instime.Add(MaxInstanceTime)) {
 			delete(s.Instances, id)
 			delete(s.InstanceInitTime, id)
+			delete(s.Phases, id)
+			s.releaseInstanceSlot(id)
 			count++
 		}
 	}
@@ -254,15 +784,94 @@ func (s *Switch) ProcessMessage(dkgMsg []byte) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("process message: failed to verify initiator signature: %s", err.Error())
 	}
-	for _, ts := range st.Messages {
-		err = inst.Process(ts)
-		if err != nil {
+	if len(st.Messages) == 0 {
+		return nil, fmt.Errorf("process message: no dkg messages")
+	}
+	// Initiator resends a phase message to operators which didnt respond in time,
+	// respond to the resend with the response to the first message instead of processing it again
+	phaseT := phaseType(st.Messages[0].Message)
+	phase, resend, err := s.startPhase(id, phaseT, dkgMsg)
+	if err != nil {
+		return nil, err
+	}
+	if resend {
+		s.Logger.Info("🔁 received a resent phase message, responding with the previous response", zap.String("reqid", hex.EncodeToString(id[:])), zap.String("phase", messagePhase(phaseT)))
+		return phase.Wait()
+	}
+	start := time.Now()
+	resp, err := processMessages(inst, st.Messages)
+	phase.finish(resp, err)
+	s.observePhase(messagePhase(phaseT), start, resp, err)
+	s.settleSlot(id, resp, err)
+	return resp, err
+}
+
+// phaseType returns the type of a phase message. All kyber rounds are relayed as kyber messages,
+// so the phase of a kyber message is the type of the bundle it carries.
+func phaseType(ts *wire.Transport) wire.TransportType {
+	if ts.Type != wire.KyberMessageType {
+		return ts.Type
+	}
+	kyberMsg := &wire.KyberMessage{}
+	if err := kyberMsg.UnmarshalSSZ(ts.Data); err != nil {
+		return ts.Type
+	}
+	return kyberMsg.Type
+}
+
+// observePhase updates metrics after processing a ceremony phase: the ceremony is failed if the operator
+// responds with an error or a blame, and is completed if the operator responds with the ceremony output
+func (s *Switch) observePhase(phase string, start time.Time, resp []byte, err error) {
+	if s.Metrics == nil {
+		return
+	}
+	if err != nil {
+		s.Metrics.CeremonyFailed(phase)
+		return
+	}
+	respType, err := responseType(resp)
+	if err != nil || respType == wire.ErrorMessageType || respType == wire.BlameMessageType {
+		s.Metrics.CeremonyFailed(phase)
+		return
+	}
+	s.Metrics.PhaseProcessed(phase, start)
+	if respType == wire.OutputMessageType {
+		s.Metrics.CeremonyCompleted()
+	}
+}
+
+// processMessages processes phase messages at the instance and waits for the instance response
+func processMessages(inst Instance, messages []*wire.SignedTransport) ([]byte, error) {
+	for _, ts := range messages {
+		if err := inst.Process(ts); err != nil {
 			return nil, fmt.Errorf("process message: failed to process dkg message: %s", err.Error())
 		}
 	}
-	resp := inst.ReadResponse()
+	return inst.ReadResponse(), nil
+}
 
-	return resp, nil
+// startPhase returns a response to the phase of the instance. If the phase message was already received,
+// the previous response is returned with resend set to true, and the caller should wait for it.
+// Otherwise the caller processes the message and finishes the response.
+func (s *Switch) startPhase(id InstanceID, phase wire.TransportType, msg []byte) (resp *PhaseResponse, resend bool, err error) {
+	hash := sha256.Sum256(msg)
+	s.Mtx.Lock()
+	defer s.Mtx.Unlock()
+	if s.Phases == nil {
+		s.Phases = make(map[InstanceID]PhaseResponses)
+	}
+	if s.Phases[id] == nil {
+		s.Phases[id] = make(PhaseResponses)
+	}
+	if prev, ok := s.Phases[id][phase]; ok {
+		if prev.msgHash != hash {
+			return nil, false, fmt.Errorf("process message: phase %s already received with a different message", phase)
+		}
+		return prev, true, nil
+	}
+	resp = &PhaseResponse{msgHash: hash, done: make(chan struct{})}
+	s.Phases[id][phase] = resp
+	return resp, false, nil
 }
 
 func (s *Switch) MarshallAndSign(msg wire.SSZMarshaller, msgType wire.TransportType, operatorID uint64, id [24]byte) ([]byte, error) {
@@ -340,11 +949,11 @@ func (s *Switch) SaveResultData(incMsg *wire.SignedTransport, outputPath string)
 	if err != nil {
 		return fmt.Errorf("failed to decode withdrawal credentials: %s", err.Error())
 	}
-	withdrawPrefix, withdrawAddress := crypto.ParseWithdrawalCredentials(withdrawCreds)
-	if withdrawPrefix != crypto.ETH1WithdrawalPrefixByte {
+	withdrawPrefix, _ := crypto.ParseWithdrawalCredentials(withdrawCreds)
+	if withdrawPrefix != crypto.BLSWithdrawalPrefixByte && withdrawPrefix != crypto.ETH1WithdrawalPrefixByte && withdrawPrefix != crypto.CompoundingWithdrawalPrefixByte {
 		return fmt.Errorf("invalid withdrawal prefix: %x", withdrawPrefix)
 	}
-	return cli_utils.WriteResults(
+	err = cli_utils.WriteResults(
 		s.Logger,
 		depositDataArr,
 		keySharesArr,
@@ -353,9 +962,26 @@ func (s *Switch) SaveResultData(incMsg *wire.SignedTransport, outputPath string)
 		1,
 		common.HexToAddress(keySharesArr[0].Shares[0].OwnerAddress),
 		keySharesArr[0].Shares[0].OwnerNonce,
-		common.BytesToAddress(withdrawAddress),
+		withdrawCreds,
 		outputPath,
 	)
+	if err != nil {
+		return err
+	}
+	s.finishResult(resData.Identifier)
+	return nil
+}
+
+// finishResult records that results of the ceremony are saved, so that the instance isnt active anymore
+func (s *Switch) finishResult(id InstanceID) {
+	s.Mtx.Lock()
+	defer s.Mtx.Unlock()
+	if s.Phases[id] == nil {
+		s.Phases[id] = make(PhaseResponses)
+	}
+	resp := &PhaseResponse{done: make(chan struct{})}
+	resp.finish(nil, nil)
+	s.Phases[id][wire.ResultMessageType] = resp
 }
 
 func (s *Switch) VerifyIncomingMessage(incMsg *wire.SignedTransport) (uint64, error) {
@@ -3,8 +3,10 @@ package operator
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -125,6 +127,8 @@ func TestInitInstance(t *testing.T) {
 		Nonce:                 1,
 		T:                     3,
 		WithdrawalCredentials: []byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+		Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
 	}
 
 	initmsg, err := init.MarshalSSZ()
@@ -177,6 +181,77 @@ func TestInitInstance(t *testing.T) {
 
 }
 
+func TestInitInstancePolicyLimit(t *testing.T) {
+	err := logging.SetGlobalLogger("info", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("state-tests")
+	privateKey, ops := generateOperatorsData(t, 4)
+	swtch, err := New(privateKey, logger, []byte("test.version"), 1, t.TempDir())
+	require.NoError(t, err)
+	swtch.State.Policy = &Policy{MaxValidatorsPerOwnerPerDay: 2}
+	initiator := singleOperatorKeys(t)
+	encPubKey, err := crypto.EncodeRSAPublicKey(&initiator.PublicKey)
+	require.NoError(t, err)
+	init := &wire.Init{
+		Operators:             ops,
+		Owner:                 common.HexToAddress("0x0000001"),
+		Nonce:                 1,
+		T:                     3,
+		WithdrawalCredentials: common.HexToAddress("0x0000002").Bytes(),
+		WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+		Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
+	}
+	initmsg, err := init.MarshalSSZ()
+	require.NoError(t, err)
+	initInstance := func(i int) (InstanceID, error) {
+		var reqID InstanceID
+		copy(reqID[:], fmt.Sprintf("policyRequestID%d", i))
+		initMessage := &wire.Transport{Type: wire.InitMessageType, Identifier: reqID, Data: initmsg, Version: []byte("test.version")}
+		tsssz, err := initMessage.MarshalSSZ()
+		require.NoError(t, err)
+		sig, err := crypto.SignRSA(initiator, tsssz)
+		require.NoError(t, err)
+		_, err = swtch.State.InitInstance(reqID, initMessage, encPubKey, sig)
+		return reqID, err
+	}
+
+	// concurrent init requests of one owner cant pass the daily limit
+	var wg sync.WaitGroup
+	var mtx sync.Mutex
+	var accepted []InstanceID
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			reqID, err := initInstance(i)
+			if err != nil {
+				require.ErrorContains(t, err, "reached the limit of 2 validators per day")
+				return
+			}
+			mtx.Lock()
+			accepted = append(accepted, reqID)
+			mtx.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	require.Len(t, accepted, 2)
+
+	// a failed ceremony releases its slot
+	swtch.State.settleSlot(accepted[0], nil, fmt.Errorf("ceremony failed"))
+	_, err = initInstance(10)
+	require.NoError(t, err)
+	_, err = initInstance(11)
+	require.ErrorContains(t, err, "reached the limit of 2 validators per day")
+
+	// an abandoned ceremony releases its slot when the instance expires
+	swtch.State.Mtx.Lock()
+	swtch.State.InstanceInitTime[accepted[1]] = time.Now().Add(-MaxInstanceTime - time.Minute)
+	swtch.State.CleanInstances()
+	swtch.State.Mtx.Unlock()
+	_, err = initInstance(12)
+	require.NoError(t, err)
+}
+
 func TestSwitch_cleanInstances(t *testing.T) {
 	privateKey, ops := generateOperatorsData(t, 4)
 	err := logging.SetGlobalLogger("info", "capital", "console", nil)
@@ -202,6 +277,8 @@ func TestSwitch_cleanInstances(t *testing.T) {
 		Owner:                 common.HexToAddress("0x0000001"),
 		Nonce:                 1,
 		WithdrawalCredentials: []byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+		Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
 		T:                     3,
 	}
 
@@ -230,3 +307,180 @@ func TestSwitch_cleanInstances(t *testing.T) {
 	require.Len(t, swtch.Instances, 0)
 
 }
+
+func TestSwitch_startPhase(t *testing.T) {
+	privateKey := singleOperatorKeys(t)
+	err := logging.SetGlobalLogger("info", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("state-tests")
+	pkBytes, err := crypto.EncodeRSAPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	swtch := NewSwitch(privateKey, logger, []byte("test.version"), pkBytes, 1)
+	var reqID InstanceID
+	copy(reqID[:], "testRequestID1234567890")
+	msg := []byte("exchange phase message")
+
+	phase, resend, err := swtch.startPhase(reqID, wire.ExchangeMessageType, msg)
+	require.NoError(t, err)
+	require.False(t, resend)
+
+	t.Run("resend waits for the first response", func(t *testing.T) {
+		replayed, resend, err := swtch.startPhase(reqID, wire.ExchangeMessageType, msg)
+		require.NoError(t, err)
+		require.True(t, resend)
+		respC := make(chan []byte)
+		errC := make(chan error, 1)
+		go func() {
+			resp, err := replayed.Wait()
+			errC <- err
+			respC <- resp
+		}()
+		phase.finish([]byte("exchange response"), nil)
+		require.Equal(t, []byte("exchange response"), <-respC)
+		require.NoError(t, <-errC)
+	})
+	t.Run("resend with a different message", func(t *testing.T) {
+		_, _, err := swtch.startPhase(reqID, wire.ExchangeMessageType, []byte("other message"))
+		require.ErrorContains(t, err, "phase ExchangeMessageType already received with a different message")
+	})
+	t.Run("next phase", func(t *testing.T) {
+		_, resend, err := swtch.startPhase(reqID, wire.KyberDealBundleMessageType, []byte("deal bundles"))
+		require.NoError(t, err)
+		require.False(t, resend)
+	})
+	t.Run("kyber rounds are different phases", func(t *testing.T) {
+		kyberMsg := &wire.KyberMessage{Type: wire.KyberResponseBundleMessageType, Data: []byte("response bundles")}
+		data, err := kyberMsg.MarshalSSZ()
+		require.NoError(t, err)
+		phaseT := phaseType(&wire.Transport{Type: wire.KyberMessageType, Data: data})
+		require.Equal(t, wire.KyberResponseBundleMessageType, phaseT)
+		require.Equal(t, phaseResponse, messagePhase(phaseT))
+		_, resend, err := swtch.startPhase(reqID, phaseT, []byte("response bundles"))
+		require.NoError(t, err)
+		require.False(t, resend)
+	})
+	t.Run("first response error is returned to resend", func(t *testing.T) {
+		var otherID InstanceID
+		copy(otherID[:], "testRequestID0987654321")
+		phase, _, err := swtch.startPhase(otherID, wire.ExchangeMessageType, msg)
+		require.NoError(t, err)
+		phase.finish(nil, fmt.Errorf("failed to process"))
+		replayed, resend, err := swtch.startPhase(otherID, wire.ExchangeMessageType, msg)
+		require.NoError(t, err)
+		require.True(t, resend)
+		_, err = replayed.Wait()
+		require.EqualError(t, err, "failed to process")
+	})
+	t.Run("phases are cleaned with instances", func(t *testing.T) {
+		swtch.InstanceInitTime[reqID] = time.Now().Add(-time.Minute * 6)
+		swtch.CleanInstances()
+		_, ok := swtch.Phases[reqID]
+		require.False(t, ok)
+	})
+}
+
+func TestSwitch_Ceremonies(t *testing.T) {
+	privateKey, ops := generateOperatorsData(t, 4)
+	err := logging.SetGlobalLogger("info", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("state-tests")
+	pkBytes, err := crypto.EncodeRSAPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	swtch := NewSwitch(privateKey, logger, []byte("test.version"), pkBytes, 1)
+	var reqID InstanceID
+	copy(reqID[:], "testRequestID1234567890")
+	_, pv, err := rsaencryption.GenerateKeys()
+	require.NoError(t, err)
+	priv, err := rsaencryption.ConvertPemToPrivateKey(string(pv))
+	require.NoError(t, err)
+	init := &wire.Init{
+		Operators:             ops,
+		Owner:                 common.HexToAddress("0x0000001"),
+		Nonce:                 1,
+		WithdrawalCredentials: common.HexToAddress("0x0000002").Bytes(),
+		WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+		Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
+		T:                     3,
+	}
+	inst, _, err := swtch.CreateInstance(reqID, init, &priv.PublicKey)
+	require.NoError(t, err)
+	swtch.storeInstance(reqID, inst, policySlot{owner: init.Owner, at: time.Now()})
+
+	_, ok := swtch.Ceremony(InstanceID{})
+	require.False(t, ok)
+	status, ok := swtch.Ceremony(reqID)
+	require.True(t, ok)
+	require.Equal(t, hex.EncodeToString(reqID[:]), status.ID)
+	require.Equal(t, "dkg", status.Type)
+	require.Equal(t, phaseInit, status.Phase)
+	require.Equal(t, []uint64{1, 2, 3, 4}, status.Participants)
+	require.Empty(t, status.Exchanges)
+	require.False(t, status.StartedDKG)
+	require.Empty(t, status.LastError)
+
+	phase, _, err := swtch.startPhase(reqID, wire.ExchangeMessageType, []byte("exchange phase message"))
+	require.NoError(t, err)
+	status, _ = swtch.Ceremony(reqID)
+	require.Equal(t, phaseExchange, status.Phase)
+	require.Empty(t, status.LastError)
+	phase.finish(nil, fmt.Errorf("failed to process"))
+	statuses := swtch.Ceremonies()
+	require.Len(t, statuses, 1)
+	require.Equal(t, phaseExchange, statuses[0].Phase)
+	require.Equal(t, "failed to process", statuses[0].LastError)
+}
+
+func TestSwitch_Drain(t *testing.T) {
+	privateKey, ops := generateOperatorsData(t, 4)
+	err := logging.SetGlobalLogger("info", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("state-tests")
+	pkBytes, err := crypto.EncodeRSAPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	swtch := NewSwitch(privateKey, logger, []byte("test.version"), pkBytes, 1)
+	_, pv, err := rsaencryption.GenerateKeys()
+	require.NoError(t, err)
+	priv, err := rsaencryption.ConvertPemToPrivateKey(string(pv))
+	require.NoError(t, err)
+	encPubKey, err := crypto.EncodeRSAPublicKey(&priv.PublicKey)
+	require.NoError(t, err)
+	initInstance := func(reqID [24]byte) error {
+		init := &wire.Init{
+			Operators:             ops,
+			Owner:                 common.HexToAddress("0x0000001"),
+			Nonce:                 1,
+			WithdrawalCredentials: common.HexToAddress("0x0000002").Bytes(),
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(crypto.MaxEffectiveBalanceInGwei),
+			T:                     3,
+		}
+		initmsg, err := init.MarshalSSZ()
+		require.NoError(t, err)
+		initMessage := &wire.Transport{
+			Type:       wire.InitMessageType,
+			Identifier: reqID,
+			Data:       initmsg,
+			Version:    []byte("test.version"),
+		}
+		tsssz, err := initMessage.MarshalSSZ()
+		require.NoError(t, err)
+		sig, err := crypto.SignRSA(priv, tsssz)
+		require.NoError(t, err)
+		_, err = swtch.InitInstance(reqID, initMessage, encPubKey, sig)
+		return err
+	}
+	var reqID [24]byte
+	copy(reqID[:], "testRequestID1234567890")
+	require.NoError(t, initInstance(reqID))
+	require.Equal(t, 1, swtch.ActiveInstances())
+
+	swtch.Drain()
+	var otherID [24]byte
+	copy(otherID[:], "testRequestID0987654321")
+	require.ErrorIs(t, initInstance(otherID), utils.ErrShuttingDown)
+	require.Equal(t, 1, swtch.ActiveInstances())
+
+	// expired instances arent active
+	swtch.InstanceInitTime[reqID] = time.Now().Add(-time.Minute * 6)
+	require.Equal(t, 0, swtch.ActiveInstances())
+}
@@ -0,0 +1,98 @@
+package operator
+
+import (
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// final states of a ceremony at operator
+const (
+	phaseCompleted = "completed"
+	phaseFailed    = "failed"
+)
+
+// Ceremonies returns statuses of all ceremony instances at Switch ordered by creation time
+func (s *Switch) Ceremonies() []*wire.CeremonyStatus {
+	s.Mtx.RLock()
+	defer s.Mtx.RUnlock()
+	statuses := make([]*wire.CeremonyStatus, 0, len(s.Instances))
+	for id, inst := range s.Instances {
+		statuses = append(statuses, s.ceremonyStatus(id, inst))
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].CreatedAt.Before(statuses[j].CreatedAt) })
+	return statuses
+}
+
+// Ceremony returns status of the ceremony instance, false if there is no instance with the ID
+func (s *Switch) Ceremony(id InstanceID) (*wire.CeremonyStatus, bool) {
+	s.Mtx.RLock()
+	defer s.Mtx.RUnlock()
+	inst, ok := s.Instances[id]
+	if !ok {
+		return nil, false
+	}
+	return s.ceremonyStatus(id, inst), true
+}
+
+// ceremonyStatus builds the status from the instance and its phase responses, Mtx should be held by the caller
+func (s *Switch) ceremonyStatus(id InstanceID, inst Instance) *wire.CeremonyStatus {
+	createdAt := s.InstanceInitTime[id]
+	status := &wire.CeremonyStatus{
+		ID:        hex.EncodeToString(id[:]),
+		Type:      "dkg",
+		Phase:     phaseInit,
+		CreatedAt: createdAt,
+		Age:       time.Since(createdAt).Round(time.Second).String(),
+	}
+	// the latest phase received from initiator
+	for _, t := range []wire.TransportType{
+		wire.ExchangeMessageType,
+		wire.KyberDealBundleMessageType,
+		wire.KyberResponseBundleMessageType,
+		wire.KyberJustificationBundleMessageType,
+		wire.ResultMessageType,
+	} {
+		resp, ok := s.Phases[id][t]
+		if !ok {
+			continue
+		}
+		status.Phase = messagePhase(t)
+		if err := resp.finishedErr(); err != nil {
+			status.LastError = err.Error()
+		}
+	}
+	owner := inst.GetLocalOwner()
+	if owner == nil {
+		return status
+	}
+	ownerStatus := owner.Status()
+	if ownerStatus.Reshare {
+		status.Type = "reshare"
+	}
+	status.Participants = ownerStatus.Participants
+	status.Exchanges = ownerStatus.Exchanges
+	status.StartedDKG = ownerStatus.StartedDKG
+	if ownerStatus.Err != nil {
+		status.LastError = ownerStatus.Err.Error()
+	}
+	if ownerStatus.Done {
+		status.Phase = phaseCompleted
+		if ownerStatus.Err != nil {
+			status.Phase = phaseFailed
+		}
+	}
+	return status
+}
+
+// finishedErr returns the error of a processed phase, nil if the phase is not processed yet or succeeded
+func (p *PhaseResponse) finishedErr() error {
+	select {
+	case <-p.done:
+		return p.err
+	default:
+		return nil
+	}
+}
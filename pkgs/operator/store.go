@@ -0,0 +1,211 @@
+package operator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// SharesDir is a directory name at operator's output path where key shares are stored
+const SharesDir = "shares"
+
+// StoredShare is a key share of a validator kept by the operator after a ceremony. The share is stored
+// only as a part of the signed ceremony proof, where it is encrypted with the operator's RSA public key.
+type StoredShare struct {
+	RequestID  [24]byte
+	OperatorID uint64
+	Nonce      uint64
+	CreatedAt  time.Time
+	Proof      *wire.SignedProof
+}
+
+type storedShareJSON struct {
+	RequestID  string            `json:"request_id"`
+	OperatorID uint64            `json:"operator_id"`
+	Nonce      uint64            `json:"nonce"`
+	CreatedAt  time.Time         `json:"created_at"`
+	Proof      *wire.SignedProof `json:"proof"`
+}
+
+func (s *StoredShare) MarshalJSON() ([]byte, error) {
+	return json.Marshal(storedShareJSON{
+		RequestID:  hex.EncodeToString(s.RequestID[:]),
+		OperatorID: s.OperatorID,
+		Nonce:      s.Nonce,
+		CreatedAt:  s.CreatedAt,
+		Proof:      s.Proof,
+	})
+}
+
+func (s *StoredShare) UnmarshalJSON(data []byte) error {
+	var share storedShareJSON
+	if err := json.Unmarshal(data, &share); err != nil {
+		return err
+	}
+	reqID, err := hex.DecodeString(share.RequestID)
+	if err != nil {
+		return fmt.Errorf("invalid request ID: %w", err)
+	}
+	if len(reqID) != len(s.RequestID) {
+		return fmt.Errorf("invalid request ID length %d", len(reqID))
+	}
+	if share.Proof == nil || share.Proof.Proof == nil {
+		return fmt.Errorf("missing ceremony proof")
+	}
+	copy(s.RequestID[:], reqID)
+	s.OperatorID = share.OperatorID
+	s.Nonce = share.Nonce
+	s.CreatedAt = share.CreatedAt
+	s.Proof = share.Proof
+	return nil
+}
+
+// ShareStore persists operator's key shares on disk, one JSON file per ceremony,
+// and keeps them indexed by validator public key, owner and request ID
+type ShareStore struct {
+	mtx         sync.RWMutex
+	dir         string
+	byRequestID map[[24]byte]*StoredShare
+	byValidator map[string][]*StoredShare
+	byOwner     map[[20]byte][]*StoredShare
+}
+
+// NewShareStore creates a share store at the directory and loads previously stored shares
+func NewShareStore(dir string) (*ShareStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create shares directory: %w", err)
+	}
+	s := &ShareStore{
+		dir:         dir,
+		byRequestID: make(map[[24]byte]*StoredShare),
+		byValidator: make(map[string][]*StoredShare),
+		byOwner:     make(map[[20]byte][]*StoredShare),
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read shares directory: %w", err)
+	}
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Clean(filepath.Join(dir, f.Name())))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stored share %s: %w", f.Name(), err)
+		}
+		share := &StoredShare{}
+		if err := json.Unmarshal(data, share); err != nil {
+			return nil, fmt.Errorf("failed to parse stored share %s: %w", f.Name(), err)
+		}
+		s.index(share)
+	}
+	s.sort()
+	return s, nil
+}
+
+// Save writes the share to disk and adds it to the indexes. Shares are never overwritten:
+// saving a share for an existing request ID returns an error.
+func (s *ShareStore) Save(share *StoredShare) error {
+	if share.Proof == nil || share.Proof.Proof == nil {
+		return fmt.Errorf("missing ceremony proof")
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if _, ok := s.byRequestID[share.RequestID]; ok {
+		return fmt.Errorf("share for request %x already stored", share.RequestID)
+	}
+	data, err := json.Marshal(share)
+	if err != nil {
+		return fmt.Errorf("failed to marshal share: %w", err)
+	}
+	// write to a temporary file first so that a crash doesnt leave a partially written share
+	path := filepath.Join(s.dir, hex.EncodeToString(share.RequestID[:])+".json")
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write share: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write share: %w", err)
+	}
+	s.index(share)
+	s.sort()
+	return nil
+}
+
+// ByRequestID returns a share created at the ceremony with the request ID
+func (s *ShareStore) ByRequestID(reqID [24]byte) (*StoredShare, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	share, ok := s.byRequestID[reqID]
+	return share, ok
+}
+
+// ByValidator returns all shares of the validator public key ordered by creation time
+func (s *ShareStore) ByValidator(validatorPubKey []byte) []*StoredShare {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return append([]*StoredShare(nil), s.byValidator[hex.EncodeToString(validatorPubKey)]...)
+}
+
+// ByOwner returns all shares of validators of the owner ordered by creation time
+func (s *ShareStore) ByOwner(owner [20]byte) []*StoredShare {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return append([]*StoredShare(nil), s.byOwner[owner]...)
+}
+
+// Latest returns the most recent share of the validator public key
+func (s *ShareStore) Latest(validatorPubKey []byte) (*StoredShare, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	shares := s.byValidator[hex.EncodeToString(validatorPubKey)]
+	if len(shares) == 0 {
+		return nil, false
+	}
+	return shares[len(shares)-1], true
+}
+
+// ByShare returns the most recent share of the validator public key with the share public key
+func (s *ShareStore) ByShare(validatorPubKey, sharePubKey []byte) (*StoredShare, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	shares := s.byValidator[hex.EncodeToString(validatorPubKey)]
+	for i := len(shares) - 1; i >= 0; i-- {
+		if bytes.Equal(shares[i].Proof.Proof.SharePubKey, sharePubKey) {
+			return shares[i], true
+		}
+	}
+	return nil, false
+}
+
+func (s *ShareStore) index(share *StoredShare) {
+	s.byRequestID[share.RequestID] = share
+	validator := hex.EncodeToString(share.Proof.Proof.ValidatorPubKey)
+	s.byValidator[validator] = append(s.byValidator[validator], share)
+	s.byOwner[share.Proof.Proof.Owner] = append(s.byOwner[share.Proof.Proof.Owner], share)
+}
+
+func (s *ShareStore) sort() {
+	less := func(shares []*StoredShare) func(i, j int) bool {
+		return func(i, j int) bool {
+			if shares[i].CreatedAt.Equal(shares[j].CreatedAt) {
+				return bytes.Compare(shares[i].RequestID[:], shares[j].RequestID[:]) < 0
+			}
+			return shares[i].CreatedAt.Before(shares[j].CreatedAt)
+		}
+	}
+	for _, shares := range s.byValidator {
+		sort.SliceStable(shares, less(shares))
+	}
+	for _, shares := range s.byOwner {
+		sort.SliceStable(shares, less(shares))
+	}
+}
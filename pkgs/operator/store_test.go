@@ -0,0 +1,109 @@
+package operator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+func testStoredShare(reqID byte, validator byte, owner common.Address, createdAt time.Time) *StoredShare {
+	var id [24]byte
+	id[0] = reqID
+	validatorPubKey := make([]byte, 48)
+	validatorPubKey[0] = validator
+	return &StoredShare{
+		RequestID:  id,
+		OperatorID: 1,
+		Nonce:      uint64(reqID),
+		CreatedAt:  createdAt,
+		Proof: &wire.SignedProof{
+			Proof: &wire.Proof{
+				ValidatorPubKey: validatorPubKey,
+				EncryptedShare:  []byte{reqID, 1, 2, 3},
+				SharePubKey:     make([]byte, 48),
+				Owner:           owner,
+			},
+			Signature: make([]byte, 256),
+		},
+	}
+}
+
+func TestShareStore(t *testing.T) {
+	dir, err := os.MkdirTemp("", "dkg-shares")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store, err := NewShareStore(dir)
+	require.NoError(t, err)
+
+	ownerA := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	ownerB := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	now := time.Now().UTC()
+	share1 := testStoredShare(1, 1, ownerA, now)
+	share2 := testStoredShare(2, 1, ownerB, now.Add(time.Minute))
+	share3 := testStoredShare(3, 2, ownerA, now.Add(2*time.Minute))
+	require.NoError(t, store.Save(share2))
+	require.NoError(t, store.Save(share1))
+	require.NoError(t, store.Save(share3))
+
+	t.Run("test duplicate request ID", func(t *testing.T) {
+		require.ErrorContains(t, store.Save(testStoredShare(1, 3, ownerB, now)), "already stored")
+	})
+	t.Run("test missing proof", func(t *testing.T) {
+		share := testStoredShare(4, 1, ownerA, now)
+		share.Proof = nil
+		require.ErrorContains(t, store.Save(share), "missing ceremony proof")
+	})
+	check := func(t *testing.T, store *ShareStore) {
+		share, ok := store.ByRequestID(share2.RequestID)
+		require.True(t, ok)
+		require.Equal(t, share2.Proof.Proof.EncryptedShare, share.Proof.Proof.EncryptedShare)
+		require.Equal(t, share2.Nonce, share.Nonce)
+
+		byValidator := store.ByValidator(share1.Proof.Proof.ValidatorPubKey)
+		require.Len(t, byValidator, 2)
+		require.Equal(t, share1.RequestID, byValidator[0].RequestID)
+		require.Equal(t, share2.RequestID, byValidator[1].RequestID)
+
+		byOwner := store.ByOwner(ownerA)
+		require.Len(t, byOwner, 2)
+		require.Equal(t, share1.RequestID, byOwner[0].RequestID)
+		require.Equal(t, share3.RequestID, byOwner[1].RequestID)
+
+		latest, ok := store.Latest(share1.Proof.Proof.ValidatorPubKey)
+		require.True(t, ok)
+		require.Equal(t, share2.RequestID, latest.RequestID)
+
+		byShare, ok := store.ByShare(share1.Proof.Proof.ValidatorPubKey, share1.Proof.Proof.SharePubKey)
+		require.True(t, ok)
+		require.Equal(t, share2.RequestID, byShare.RequestID)
+		_, ok = store.ByShare(share1.Proof.Proof.ValidatorPubKey, []byte{1})
+		require.False(t, ok)
+
+		_, ok = store.ByRequestID([24]byte{9})
+		require.False(t, ok)
+		_, ok = store.Latest(make([]byte, 48))
+		require.False(t, ok)
+	}
+	t.Run("test indexes", func(t *testing.T) {
+		check(t, store)
+	})
+	t.Run("test reload after restart", func(t *testing.T) {
+		reloaded, err := NewShareStore(dir)
+		require.NoError(t, err)
+		check(t, reloaded)
+	})
+	t.Run("test corrupted share file", func(t *testing.T) {
+		corruptedDir, err := os.MkdirTemp("", "dkg-shares")
+		require.NoError(t, err)
+		defer os.RemoveAll(corruptedDir)
+		require.NoError(t, os.WriteFile(filepath.Join(corruptedDir, "broken.json"), []byte("{"), 0o600))
+		_, err = NewShareStore(corruptedDir)
+		require.ErrorContains(t, err, "failed to parse stored share")
+	})
+}
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"context"
+	"sync"
+)
+
+// OperatorLimiter bounds the number of ceremonies each operator runs concurrently, it can be shared by several batches
+// running with the same operators. A ceremony takes a slot of all its operators at once, so that ceremonies of
+// overlapping clusters dont deadlock.
+type OperatorLimiter struct {
+	max      int
+	mtx      sync.Mutex
+	running  map[uint64]int
+	released chan struct{}
+}
+
+// NewOperatorLimiter creates a limiter of max concurrent ceremonies per operator
+func NewOperatorLimiter(max int) *OperatorLimiter {
+	return &OperatorLimiter{
+		max:      max,
+		running:  make(map[uint64]int),
+		released: make(chan struct{}),
+	}
+}
+
+// Acquire blocks until every operator has a free slot and takes the slots, or until ctx is done
+func (l *OperatorLimiter) Acquire(ctx context.Context, ids []uint64) error {
+	for {
+		l.mtx.Lock()
+		if l.free(ids) {
+			for _, id := range ids {
+				l.running[id]++
+			}
+			l.mtx.Unlock()
+			return nil
+		}
+		released := l.released
+		l.mtx.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// Release frees slots of the operators taken by Acquire
+func (l *OperatorLimiter) Release(ids []uint64) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for _, id := range ids {
+		l.running[id]--
+		if l.running[id] <= 0 {
+			delete(l.running, id)
+		}
+	}
+	// wake up all waiting ceremonies to check their operators again
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+func (l *OperatorLimiter) free(ids []uint64) bool {
+	for _, id := range ids {
+		if l.running[id] >= l.max {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,274 @@
+package sdk
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/validator"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// ReportFile is a file name of the manifest report at the output directory
+const ReportFile = "report.json"
+
+// ManifestEntry is a row of a batch manifest: validators of an owner created by a cluster of operators
+type ManifestEntry struct {
+	OperatorIDs     []uint64       `json:"operator_ids"`
+	Owner           common.Address `json:"owner"`
+	WithdrawAddress common.Address `json:"withdraw_address"`
+	Nonce           uint64         `json:"nonce"`
+	Validators      int            `json:"validators"`
+}
+
+// manifestColumns are required columns of a CSV manifest
+var manifestColumns = []string{"operator_ids", "owner", "withdraw_address", "nonce", "validators"}
+
+// LoadManifest reads a manifest from a .csv or .json file. A CSV manifest has a header row with manifestColumns
+// in any order, operator IDs of a row are separated by spaces, semicolons or commas of a quoted field.
+// A JSON manifest is an array of entries.
+func LoadManifest(path string) ([]ManifestEntry, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest: %w", err)
+	}
+	defer f.Close()
+	var entries []ManifestEntry
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		entries, err = parseCSVManifest(f)
+	case ".json":
+		err = json.NewDecoder(f).Decode(&entries)
+	default:
+		return nil, fmt.Errorf("manifest should be a .csv or .json file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := ValidateManifest(entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func parseCSVManifest(r io.Reader) ([]ManifestEntry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range manifestColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("column %s is missing", name)
+		}
+	}
+	var entries []ManifestEntry
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry, err := parseCSVEntry(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func parseCSVEntry(record []string, columns map[string]int) (ManifestEntry, error) {
+	var entry ManifestEntry
+	value := func(name string) string { return strings.TrimSpace(record[columns[name]]) }
+	ids := strings.FieldsFunc(value("operator_ids"), func(r rune) bool { return r == ' ' || r == ';' || r == ',' })
+	for _, id := range ids {
+		operatorID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return entry, fmt.Errorf("failed to parse operator ID %s: %w", id, err)
+		}
+		entry.OperatorIDs = append(entry.OperatorIDs, operatorID)
+	}
+	var err error
+	if entry.Owner, err = utils.HexToAddress(value("owner")); err != nil {
+		return entry, fmt.Errorf("failed to parse owner address: %w", err)
+	}
+	if entry.WithdrawAddress, err = utils.HexToAddress(value("withdraw_address")); err != nil {
+		return entry, fmt.Errorf("failed to parse withdrawal address: %w", err)
+	}
+	if entry.Nonce, err = strconv.ParseUint(value("nonce"), 10, 64); err != nil {
+		return entry, fmt.Errorf("failed to parse nonce: %w", err)
+	}
+	if entry.Validators, err = strconv.Atoi(value("validators")); err != nil {
+		return entry, fmt.Errorf("failed to parse validators: %w", err)
+	}
+	return entry, nil
+}
+
+// ValidateManifest checks entries of a manifest. Nonces of entries of the same owner shouldnt overlap,
+// otherwise several validators would be created for one owner nonce.
+func ValidateManifest(entries []ManifestEntry) error {
+	if len(entries) == 0 {
+		return fmt.Errorf("manifest is empty")
+	}
+	for i, entry := range entries {
+		row := i + 1
+		if len(entry.OperatorIDs) == 0 {
+			return fmt.Errorf("row %d: operator IDs are empty", row)
+		}
+		if entry.Owner == (common.Address{}) {
+			return fmt.Errorf("row %d: owner address is empty", row)
+		}
+		if entry.WithdrawAddress == (common.Address{}) {
+			return fmt.Errorf("row %d: withdrawal address is empty", row)
+		}
+		if entry.Validators < 1 || entry.Validators > MaxValidators {
+			return fmt.Errorf("row %d: amount of generated validators should be 1 to %d", row, MaxValidators)
+		}
+		for j, other := range entries[:i] {
+			if other.Owner == entry.Owner && entry.Nonce < other.Nonce+uint64(other.Validators) && other.Nonce < entry.Nonce+uint64(entry.Validators) {
+				return fmt.Errorf("row %d: nonces of owner %s overlap with row %d", row, entry.Owner.Hex(), j+1)
+			}
+		}
+	}
+	return nil
+}
+
+// ManifestResult is a result of a manifest entry. Batch is nil if the entry failed before its ceremonies completed.
+type ManifestResult struct {
+	Entry ManifestEntry
+	Batch *Batch
+	Err   error
+}
+
+// RunManifest runs batches of all manifest entries concurrently. Entries set operators, owner, withdrawal address,
+// nonce and number of validators of opts, the rest of options is shared by all entries. Ceremonies of all entries
+// share opts.OperatorLimiter, a limiter of DefaultMaxConcurrency ceremonies per operator is used if not set.
+// A failed entry doesnt abort other entries. Sinks of an entry are returned by sinks, which can be nil.
+func RunManifest(ctx context.Context, registry OperatorRegistry, entries []ManifestEntry, opts Options, sinks func(row int, entry ManifestEntry) ([]Sink, error)) ([]*ManifestResult, error) {
+	if err := ValidateManifest(entries); err != nil {
+		return nil, err
+	}
+	if opts.OperatorLimiter == nil {
+		opts.OperatorLimiter = NewOperatorLimiter(DefaultMaxConcurrency)
+	}
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	// entries dont share a journal, an interrupted manifest is run again without completed rows
+	opts.Journal = nil
+	results := make([]*ManifestResult, len(entries))
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		row := i + 1
+		results[i] = &ManifestResult{Entry: entry}
+		entryOpts := opts
+		entryOpts.OperatorIDs = entry.OperatorIDs
+		entryOpts.Owner = entry.Owner
+		entryOpts.WithdrawAddress = entry.WithdrawAddress
+		entryOpts.WithdrawPubKey = nil
+		entryOpts.Nonce = entry.Nonce
+		entryOpts.Validators = entry.Validators
+		entryOpts.Logger = opts.Logger.With(zap.Int("row", row))
+		var entrySinks []Sink
+		if sinks != nil {
+			var err error
+			if entrySinks, err = sinks(row, entry); err != nil {
+				results[i].Err = err
+				continue
+			}
+		}
+		wg.Add(1)
+		go func(res *ManifestResult) {
+			defer wg.Done()
+			res.Batch, res.Err = Run(ctx, registry, entryOpts, entrySinks...)
+		}(results[i])
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// ManifestReport is a consolidated report of all entries of a manifest
+type ManifestReport struct {
+	Succeeded int                   `json:"succeeded"` // number of created validators
+	Failed    int                   `json:"failed"`    // number of failed ceremonies, including ceremonies of failed entries
+	Entries   []ManifestReportEntry `json:"entries"`
+}
+
+// ManifestReportEntry reports validators created for a manifest entry and failed ceremonies
+type ManifestReportEntry struct {
+	Row             int                        `json:"row"`
+	OperatorIDs     []uint64                   `json:"operator_ids"`
+	Owner           common.Address             `json:"owner"`
+	WithdrawAddress common.Address             `json:"withdraw_address"`
+	Dir             string                     `json:"dir,omitempty"`           // output directory of the entry
+	Nonces          []uint64                   `json:"nonces"`                  // contiguous nonces of created validators
+	Pending         []uint64                   `json:"pending,omitempty"`       // nonces of successful ceremonies after a failed nonce, not written
+	Failed          []validator.FailedCeremony `json:"failed,omitempty"`        // failed ceremonies of a partially successful entry
+	Substitutions   []wire.Substitution        `json:"substitutions,omitempty"` // offline operators replaced by standby operators
+	Error           string                     `json:"error,omitempty"`         // error of a failed entry, its nonces are not used
+}
+
+// NewManifestReport builds a report of manifest results, dirs are output directories of entries by row and can be nil
+func NewManifestReport(results []*ManifestResult, dirs map[int]string) *ManifestReport {
+	report := &ManifestReport{Entries: make([]ManifestReportEntry, 0, len(results))}
+	for i, res := range results {
+		row := i + 1
+		entry := ManifestReportEntry{
+			Row:             row,
+			OperatorIDs:     res.Entry.OperatorIDs,
+			Owner:           res.Entry.Owner,
+			WithdrawAddress: res.Entry.WithdrawAddress,
+			Nonces:          []uint64{},
+		}
+		if res.Batch != nil {
+			for _, r := range res.Batch.Contiguous() {
+				entry.Nonces = append(entry.Nonces, r.Nonce)
+			}
+			for _, r := range res.Batch.Pending() {
+				entry.Pending = append(entry.Pending, r.Nonce)
+			}
+			for _, r := range res.Batch.Failed() {
+				entry.Failed = append(entry.Failed, validator.FailedCeremony{
+					Nonce:     r.Nonce,
+					RequestID: hex.EncodeToString(r.RequestID[:]),
+					Error:     r.Err.Error(),
+				})
+			}
+			entry.Substitutions = res.Batch.Substitutions()
+		}
+		if res.Err != nil {
+			entry.Error = res.Err.Error()
+		}
+		if res.Err != nil && res.Batch == nil {
+			report.Failed += res.Entry.Validators
+		} else {
+			report.Failed += len(entry.Failed)
+		}
+		if res.Err == nil || res.Batch != nil {
+			entry.Dir = dirs[row]
+		}
+		report.Succeeded += len(entry.Nonces)
+		report.Entries = append(report.Entries, entry)
+	}
+	return report
+}
@@ -0,0 +1,268 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// DefaultAPIURL is the ssv network API serving operators registered at the SSV contract
+const DefaultAPIURL = "https://api.ssv.network/api/v4"
+
+// maxAPIResponseSize limits the size of an operator read from the API
+const maxAPIResponseSize = 1 << 20
+
+// OperatorRegistry provides information of operators participating in ceremonies: IDs, RSA public keys and endpoints.
+// Implementations can load operators from a file, a database or the ssv network API.
+type OperatorRegistry interface {
+	// Operators returns information of operators by their IDs, an error if some of the operators are unknown
+	Operators(ctx context.Context, ids []uint64) (wire.OperatorsCLI, error)
+}
+
+// StaticRegistry is an operator registry backed by a fixed list of operators, i.e. an operators info JSON file
+type StaticRegistry wire.OperatorsCLI
+
+// NewStaticRegistry creates a registry of operators. Only HTTPS operator endpoints are accepted.
+func NewStaticRegistry(operators wire.OperatorsCLI) (StaticRegistry, error) {
+	if len(operators) == 0 {
+		return nil, fmt.Errorf("no information about operators is provided")
+	}
+	if err := CheckOperatorsHTTPS(operators); err != nil {
+		return nil, err
+	}
+	return StaticRegistry(operators), nil
+}
+
+// LoadOperatorsJSON creates a registry of operators from the raw content of an operators info JSON file
+func LoadOperatorsJSON(data []byte) (StaticRegistry, error) {
+	var operators wire.OperatorsCLI
+	if err := json.Unmarshal(data, &operators); err != nil {
+		return nil, fmt.Errorf("failed to load operators: %w", err)
+	}
+	return NewStaticRegistry(operators)
+}
+
+// LoadOperatorsFile creates a registry of operators from an operators info JSON file
+func LoadOperatorsFile(path string) (StaticRegistry, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operators info file: %w", err)
+	}
+	return LoadOperatorsJSON(data)
+}
+
+// Operators returns information of operators by their IDs
+func (r StaticRegistry) Operators(ctx context.Context, ids []uint64) (wire.OperatorsCLI, error) {
+	operators := make(wire.OperatorsCLI, 0, len(ids))
+	for _, id := range ids {
+		op := wire.OperatorsCLI(r).ByID(id)
+		if op == nil {
+			return nil, fmt.Errorf("operator %d is not in the registry", id)
+		}
+		operators = append(operators, *op)
+	}
+	return operators, nil
+}
+
+// APIRegistry resolves operators by IDs at the ssv network API: GET <URL>/<Network>/operators/<ID>.
+// Operators without a DKG endpoint registered at the API are rejected.
+type APIRegistry struct {
+	URL     string       // base URL of the API, DefaultAPIURL if not set
+	Network string       // network of the SSV contract, i.e. mainnet or holesky
+	Client  *http.Client // http.DefaultClient if not set
+}
+
+// apiOperator is an operator at the ssv network API response, only fields used by DKG are parsed
+type apiOperator struct {
+	ID         uint64 `json:"id"`
+	PublicKey  string `json:"public_key"`
+	DKGAddress string `json:"dkg_address"`
+}
+
+// Operators fetches information of operators by their IDs from the API
+func (r *APIRegistry) Operators(ctx context.Context, ids []uint64) (wire.OperatorsCLI, error) {
+	if r.Network == "" {
+		return nil, fmt.Errorf("network of the operators API is not set")
+	}
+	operators := make(wire.OperatorsCLI, 0, len(ids))
+	for _, id := range ids {
+		op, err := r.operator(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		operators = append(operators, *op)
+	}
+	if err := CheckOperatorsHTTPS(operators); err != nil {
+		return nil, err
+	}
+	return operators, nil
+}
+
+func (r *APIRegistry) operator(ctx context.Context, id uint64) (*wire.OperatorCLI, error) {
+	baseURL := r.URL
+	if baseURL == "" {
+		baseURL = DefaultAPIURL
+	}
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	endpoint, err := url.JoinPath(baseURL, r.Network, "operators", strconv.FormatUint(id, 10))
+	if err != nil {
+		return nil, fmt.Errorf("invalid operators API URL: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch operator %d: %w", id, err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("operator %d is not in the registry", id)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("failed to fetch operator %d: unexpected response status %s", id, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxAPIResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operator %d: %w", id, err)
+	}
+	var op apiOperator
+	if err := json.Unmarshal(data, &op); err != nil {
+		return nil, fmt.Errorf("failed to parse operator %d: %w", id, err)
+	}
+	if op.ID != id {
+		return nil, fmt.Errorf("operators API returned operator %d instead of %d", op.ID, id)
+	}
+	if op.DKGAddress == "" {
+		return nil, fmt.Errorf("operator %d has no DKG endpoint registered", id)
+	}
+	if _, err := url.ParseRequestURI(op.DKGAddress); err != nil {
+		return nil, fmt.Errorf("invalid DKG endpoint of operator %d: %w", id, err)
+	}
+	pk, err := wire.ParseRSAPublicKey([]byte(op.PublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key of operator %d: %w", id, err)
+	}
+	return &wire.OperatorCLI{
+		Addr:   strings.TrimRight(op.DKGAddress, "/"),
+		ID:     id,
+		PubKey: pk,
+	}, nil
+}
+
+// SnapshotRegistry resolves operators from a snapshot file and fetches operators missing at the snapshot from
+// the source registry, fetched operators are saved to the snapshot. The snapshot is an operators info JSON file,
+// so it can be reviewed and used as operatorsInfoPath later. A snapshot older than MaxAge is refreshed from
+// the source, but it is still used when the source is unavailable.
+type SnapshotRegistry struct {
+	Source OperatorRegistry
+	Path   string
+	MaxAge time.Duration // snapshot never expires if not set
+
+	mtx sync.Mutex
+}
+
+// Operators returns information of operators by their IDs from the snapshot or the source registry
+func (r *SnapshotRegistry) Operators(ctx context.Context, ids []uint64) (wire.OperatorsCLI, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	snapshot, fresh, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	missing := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if !fresh || snapshot.ByID(id) == nil {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return StaticRegistry(snapshot).Operators(ctx, ids)
+	}
+	fetched, err := r.Source.Operators(ctx, missing)
+	if err != nil {
+		// operators of a stale snapshot are better than none when the source is down
+		if cached, cacheErr := StaticRegistry(snapshot).Operators(ctx, ids); cacheErr == nil {
+			return cached, nil
+		}
+		return nil, err
+	}
+	for _, op := range fetched {
+		snapshot = slices.DeleteFunc(snapshot, func(cached wire.OperatorCLI) bool { return cached.ID == op.ID })
+		snapshot = append(snapshot, op)
+	}
+	if err := r.save(snapshot); err != nil {
+		return nil, err
+	}
+	return StaticRegistry(snapshot).Operators(ctx, ids)
+}
+
+// load reads operators of the snapshot and reports whether the snapshot is younger than MaxAge
+func (r *SnapshotRegistry) load() (wire.OperatorsCLI, bool, error) {
+	stat, err := os.Stat(r.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, true, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read operators snapshot: %w", err)
+	}
+	data, err := os.ReadFile(filepath.Clean(r.Path))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read operators snapshot: %w", err)
+	}
+	var operators wire.OperatorsCLI
+	if err := json.Unmarshal(data, &operators); err != nil {
+		return nil, false, fmt.Errorf("failed to load operators snapshot: %w", err)
+	}
+	fresh := r.MaxAge == 0 || time.Since(stat.ModTime()) <= r.MaxAge
+	return operators, fresh, nil
+}
+
+// save writes operators ordered by ID to the snapshot
+func (r *SnapshotRegistry) save(operators wire.OperatorsCLI) error {
+	sort.Slice(operators, func(i, j int) bool { return operators[i].ID < operators[j].ID })
+	data, err := json.MarshalIndent(operators, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal operators snapshot: %w", err)
+	}
+	tmp := r.Path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write operators snapshot: %w", err)
+	}
+	if err := os.Rename(tmp, r.Path); err != nil {
+		return fmt.Errorf("failed to write operators snapshot: %w", err)
+	}
+	return nil
+}
+
+// CheckOperatorsHTTPS checks that initiator talks to every operator over HTTPS
+func CheckOperatorsHTTPS(ops wire.OperatorsCLI) error {
+	for _, op := range ops {
+		url, err := url.Parse(op.Addr)
+		if err != nil {
+			return fmt.Errorf("parsing IP address: %s, err: %w", op.Addr, err)
+		}
+		if url.Scheme != "https" {
+			return fmt.Errorf("only HTTPS scheme is allowed at operator address %s, got: %s", op.Addr, url.Scheme)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,382 @@
+// Package sdk runs DKG ceremonies from Go applications without the CLI. Ceremonies are configured with Options,
+// operators are provided by an OperatorRegistry and results of a batch are passed to Sinks.
+// The package keeps no global state, several batches can run in one process.
+package sdk
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/zap"
+
+	eth2_key_manager_core "github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/initiator"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
+)
+
+const (
+	// DefaultMaxConcurrency is a default number of ceremonies of a batch running concurrently
+	DefaultMaxConcurrency = 20
+	// MaxValidators is the maximum number of validators created by a batch
+	MaxValidators = 100
+)
+
+// ErrAllFailed is returned when no ceremony of a partially successful batch succeeded
+var ErrAllFailed = errors.New("all DKG ceremonies failed")
+
+// Options of a batch of DKG ceremonies, one ceremony per validator
+type Options struct {
+	OperatorIDs      []uint64                       // operators participating in ceremonies
+	StandbyOperators []uint64                       // optional standby operators replacing operators which dont respond to the init message, in order of preference
+	Owner            common.Address                 // owner of validators at the SSV contract
+	WithdrawAddress  common.Address                 // address where rewards of validators are sent
+	WithdrawPubKey   []byte                         // BLS withdrawal public key of validators with 0x00 withdrawal credentials, instead of WithdrawAddress
+	Network          eth2_key_manager_core.Network  // ethereum network of validators
+	Nonce            uint64                         // owner nonce of the first validator
+	Validators       int                            // number of validators, nonces are incremented by 1, 1 if not set
+	ThresholdPolicy  spec.ThresholdPolicy           // accepted number of operators and threshold, SSV clusters by default
+	Threshold        uint64                         // optional DKG threshold at the custom policy, computed following 3f+1 tolerance if not set
+	WithdrawalPrefix byte                           // withdrawal credentials type of validators, 0x01 if not set or 0x02 for compounding validators
+	DepositAmount    phase0.Gwei                    // deposit amount of each validator, 32 ETH if not set
+	PartialSuccess   bool                           // keep results of successful ceremonies when some ceremonies fail instead of failing the batch
+	MaxConcurrency   int                            // number of ceremonies running concurrently, DefaultMaxConcurrency if not set
+	OperatorLimiter  *OperatorLimiter               // optional limit of ceremonies each operator runs concurrently, shared by batches
+	PhaseTimeout     time.Duration                  // optional deadline of each ceremony phase
+	CeremonyTimeout  time.Duration                  // optional deadline of each ceremony
+	CACertPaths      []string                       // CA certificates of operator endpoints, system certificates are used if not set
+	Version          string                         // version of the initiator sent to operators
+	Journal          *initiator.Journal             // optional journal to record ceremonies and skip nonces completed by a previous run
+	KeysRegistry     initiator.OperatorKeysRegistry // optional registry to verify operator public keys against the SSV network before the batch
+	Logger           *zap.Logger                    // optional logger
+}
+
+// validate checks options and sets defaults
+func (o *Options) validate() error {
+	if len(o.OperatorIDs) == 0 {
+		return fmt.Errorf("operator IDs are empty")
+	}
+	for i, id := range o.StandbyOperators {
+		if slices.Contains(o.OperatorIDs, id) || slices.Contains(o.StandbyOperators[:i], id) {
+			return fmt.Errorf("standby operator %d is duplicated", id)
+		}
+	}
+	if o.Owner == (common.Address{}) {
+		return fmt.Errorf("owner address is empty")
+	}
+	if o.WithdrawAddress == (common.Address{}) && len(o.WithdrawPubKey) == 0 {
+		return fmt.Errorf("withdrawal address is empty")
+	}
+	if len(o.WithdrawPubKey) != 0 {
+		if o.WithdrawAddress != (common.Address{}) {
+			return fmt.Errorf("withdrawal address and BLS withdrawal public key are both set")
+		}
+		if o.WithdrawalPrefix != 0 && o.WithdrawalPrefix != crypto.BLSWithdrawalPrefixByte {
+			return fmt.Errorf("withdrawal credentials type %#x requires a withdrawal address", o.WithdrawalPrefix)
+		}
+		if err := crypto.ValidateBLSWithdrawalPubKey(o.WithdrawPubKey); err != nil {
+			return err
+		}
+	}
+	if o.Network == "" {
+		o.Network = eth2_key_manager_core.MainNetwork
+	}
+	if o.Validators == 0 {
+		o.Validators = 1
+	}
+	if o.Validators < 0 || o.Validators > MaxValidators {
+		return fmt.Errorf("amount of generated validators should be 1 to %d", MaxValidators)
+	}
+	if o.Threshold != 0 && o.ThresholdPolicy != spec.CustomThresholdPolicy {
+		return fmt.Errorf("threshold can be set only at custom threshold policy")
+	}
+	if o.WithdrawalPrefix == 0 && len(o.WithdrawPubKey) == 0 {
+		o.WithdrawalPrefix = crypto.ETH1WithdrawalPrefixByte
+	}
+	if o.DepositAmount == 0 {
+		o.DepositAmount = crypto.MaxEffectiveBalanceInGwei
+	}
+	if err := spec.ValidateDepositParams(o.WithdrawalPrefix, o.DepositAmount); err != nil {
+		return err
+	}
+	if o.MaxConcurrency <= 0 {
+		o.MaxConcurrency = DefaultMaxConcurrency
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	return nil
+}
+
+// withdraw returns the BLS withdrawal public key if set, or the withdrawal address
+func (o *Options) withdraw() []byte {
+	if len(o.WithdrawPubKey) != 0 {
+		return o.WithdrawPubKey
+	}
+	return o.WithdrawAddress.Bytes()
+}
+
+// Result of a ceremony of the batch
+type Result struct {
+	RequestID     [24]byte
+	Nonce         uint64
+	OperatorIDs   []uint64 // operators of the ceremony, differ from Options.OperatorIDs if operators were substituted
+	DepositData   *wire.DepositDataCLI
+	KeyShares     *wire.KeySharesCLI
+	Proofs        []*wire.SignedProof
+	Substitutions []wire.Substitution // offline operators replaced by standby operators, RequestID is the ID of the last attempt
+	Err           error               // error of a failed ceremony at a partially successful batch
+}
+
+// Batch holds results of all ceremonies of a batch ordered by nonce
+type Batch struct {
+	Owner           common.Address
+	WithdrawAddress common.Address
+	WithdrawPubKey  []byte // BLS withdrawal public key of validators with 0x00 withdrawal credentials
+	Results         []*Result
+}
+
+// Withdraw returns the BLS withdrawal public key of the batch if set, or the withdrawal address
+func (b *Batch) Withdraw() []byte {
+	if len(b.WithdrawPubKey) != 0 {
+		return b.WithdrawPubKey
+	}
+	return b.WithdrawAddress.Bytes()
+}
+
+// Succeeded returns results of successful ceremonies
+func (b *Batch) Succeeded() []*Result {
+	var results []*Result
+	for _, res := range b.Results {
+		if res.Err == nil {
+			results = append(results, res)
+		}
+	}
+	return results
+}
+
+// Contiguous returns results of successful ceremonies from the first nonce of the batch up to the first failed
+// ceremony. Validators have to be registered in order of owner nonces, so only these results can be used right away
+func (b *Batch) Contiguous() []*Result {
+	var results []*Result
+	for _, res := range b.Results {
+		if res.Err != nil {
+			break
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// Pending returns results of successful ceremonies after the first failed ceremony of the batch
+func (b *Batch) Pending() []*Result {
+	return b.Succeeded()[len(b.Contiguous()):]
+}
+
+// Substitutions returns operators replaced by standby operators at ceremonies of the batch
+func (b *Batch) Substitutions() []wire.Substitution {
+	var substitutions []wire.Substitution
+	for _, res := range b.Results {
+		substitutions = append(substitutions, res.Substitutions...)
+	}
+	return substitutions
+}
+
+// Failed returns results of failed ceremonies
+func (b *Batch) Failed() []*Result {
+	var results []*Result
+	for _, res := range b.Results {
+		if res.Err != nil {
+			results = append(results, res)
+		}
+	}
+	return results
+}
+
+// Run runs a batch of DKG ceremonies and writes results to sinks. Ceremonies are aborted when ctx is done.
+// Without PartialSuccess the first failed ceremony aborts the rest of the batch and an error is returned,
+// otherwise results of failed ceremonies carry their errors and only a batch where all ceremonies failed is an error.
+// The journal is removed after results of a fully successful batch are written.
+// With KeysRegistry set, the batch is refused if an operator public key differs from the SSV network registry.
+// Operators which dont respond to the init message of a ceremony are replaced by StandbyOperators, if set,
+// and the ceremony is started again with a new request ID.
+func Run(ctx context.Context, registry OperatorRegistry, opts Options, sinks ...Sink) (*Batch, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	operators, err := registry.Operators(ctx, append(slices.Clone(opts.OperatorIDs), opts.StandbyOperators...))
+	if err != nil {
+		return nil, err
+	}
+	if opts.KeysRegistry != nil {
+		// verified once for the whole batch, a mismatch aborts the batch before any ceremony starts
+		ops, err := initiator.ValidatedOperatorDataWithPolicy(opts.OperatorIDs, operators, opts.ThresholdPolicy)
+		if err != nil {
+			return nil, err
+		}
+		standby, err := initiator.OperatorData(opts.StandbyOperators, operators)
+		if err != nil {
+			return nil, err
+		}
+		if err := initiator.VerifyOperatorKeys(ctx, opts.KeysRegistry, append(ops, standby...)); err != nil {
+			return nil, err
+		}
+		opts.Logger.Info("✅ verified operator public keys against the SSV network registry")
+	}
+	logger := opts.Logger
+	p := pool.NewWithResults[*Result]().WithContext(ctx).WithMaxGoroutines(opts.MaxConcurrency)
+	if !opts.PartialSuccess {
+		// a single failed ceremony fails the whole batch and aborts the rest of ceremonies
+		p = p.WithFirstError().WithCancelOnError()
+	}
+	var resumed []*Result
+	for i := 0; i < opts.Validators; i++ {
+		nonce := opts.Nonce + uint64(i)
+		if opts.Journal != nil {
+			if entry, ok := opts.Journal.Completed(nonce); ok {
+				id, err := entry.ID()
+				if err != nil {
+					return nil, fmt.Errorf("failed to load ceremony from the journal: %w", err)
+				}
+				logger.Info("⏭️ ceremony is already completed, skipping", zap.Uint64("nonce", nonce), zap.String("id", entry.RequestID))
+				resumed = append(resumed, &Result{
+					RequestID:   id,
+					Nonce:       nonce,
+					OperatorIDs: entry.OperatorIDs,
+					DepositData: entry.DepositData,
+					KeyShares:   entry.KeyShares,
+					Proofs:      entry.Proofs,
+				})
+				continue
+			}
+		}
+		p.Go(func(ctx context.Context) (*Result, error) {
+			res := runCeremony(ctx, operators, &opts, nonce)
+			if res.Err != nil && !opts.PartialSuccess {
+				return nil, res.Err
+			}
+			return res, nil
+		})
+	}
+	results, err := p.Wait()
+	if err != nil {
+		return nil, err
+	}
+	batch := &Batch{
+		Owner:           opts.Owner,
+		WithdrawAddress: opts.WithdrawAddress,
+		WithdrawPubKey:  opts.WithdrawPubKey,
+		Results:         append(resumed, results...),
+	}
+	sort.Slice(batch.Results, func(i, j int) bool { return batch.Results[i].Nonce < batch.Results[j].Nonce })
+	if len(batch.Succeeded()) == 0 {
+		return batch, ErrAllFailed
+	}
+	for _, sink := range sinks {
+		if err := sink.Write(ctx, batch); err != nil {
+			return batch, err
+		}
+	}
+	if opts.Journal != nil && len(batch.Failed()) == 0 {
+		if err := opts.Journal.Remove(); err != nil {
+			logger.Error("failed to remove ceremony journal", zap.Error(err))
+		}
+	}
+	return batch, nil
+}
+
+// runCeremony runs a DKG ceremony for the nonce and records it at the journal. If operators dont respond
+// to the init message, they are replaced by standby operators and the ceremony is started again with a new request ID.
+// An error of the ceremony is returned at the result.
+func runCeremony(ctx context.Context, operators wire.OperatorsCLI, opts *Options, nonce uint64) *Result {
+	ids := opts.OperatorIDs
+	standby := opts.StandbyOperators
+	var substitutions []wire.Substitution
+	for {
+		res := runAttempt(ctx, operators, opts, nonce, ids)
+		res.Substitutions = substitutions
+		var initErr *initiator.InitError
+		if res.Err == nil || len(standby) == 0 || !errors.As(res.Err, &initErr) {
+			return res
+		}
+		next, left, subs, err := initiator.SubstituteOperators(res.RequestID, nonce, ids, initErr.Offline, standby)
+		if err != nil {
+			res.Err = errors.Join(res.Err, err)
+			return res
+		}
+		for _, sub := range subs {
+			opts.Logger.Warn("🔄 operator didnt respond to the init message, replacing it with a standby operator",
+				zap.Uint64("nonce", nonce),
+				zap.String("id", sub.RequestID),
+				zap.Uint64("offline", sub.Offline),
+				zap.Uint64("standby", sub.Standby),
+			)
+		}
+		ids, standby = next, left
+		substitutions = append(substitutions, subs...)
+	}
+}
+
+// runAttempt runs a DKG ceremony for the nonce with the operators with a new initiator and request ID
+func runAttempt(ctx context.Context, operators wire.OperatorsCLI, opts *Options, nonce uint64, ids []uint64) *Result {
+	id := crypto.NewID()
+	res := &Result{RequestID: id, Nonce: nonce, OperatorIDs: ids}
+	if opts.OperatorLimiter != nil {
+		if err := opts.OperatorLimiter.Acquire(ctx, ids); err != nil {
+			res.Err = err
+			return res
+		}
+		defer opts.OperatorLimiter.Release(ids)
+	}
+	dkgInitiator, err := initiator.New(operators.Clone(), opts.Logger, opts.Version, opts.CACertPaths)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	dkgInitiator.ThresholdPolicy = opts.ThresholdPolicy
+	dkgInitiator.Threshold = opts.Threshold
+	dkgInitiator.WithdrawalPrefix = opts.WithdrawalPrefix
+	dkgInitiator.DepositAmount = opts.DepositAmount
+	dkgInitiator.PhaseTimeout = opts.PhaseTimeout
+	dkgInitiator.CeremonyTimeout = opts.CeremonyTimeout
+	if opts.Journal != nil {
+		if err := opts.Journal.Start(nonce, id, ids); err != nil {
+			res.Err = err
+			return res
+		}
+	}
+	depositData, keyShares, proofs, err := dkgInitiator.StartDKGWithContext(ctx, id, opts.withdraw(), ids, opts.Network, opts.Owner, nonce)
+	if err != nil {
+		if opts.Journal != nil {
+			if err := opts.Journal.Fail(nonce, id, ids, err); err != nil {
+				opts.Logger.Error("failed to record failed ceremony at the journal", zap.Uint64("nonce", nonce), zap.Error(err))
+			}
+		}
+		res.Err = err
+		return res
+	}
+	opts.Logger.Debug("DKG ceremony completed",
+		zap.String("id", hex.EncodeToString(id[:])),
+		zap.Uint64("nonce", nonce),
+		zap.String("pubkey", depositData.PubKey),
+	)
+	if opts.Journal != nil {
+		if err := opts.Journal.Complete(nonce, id, ids, depositData, keyShares, proofs); err != nil {
+			res.Err = err
+			return res
+		}
+	}
+	res.DepositData = depositData
+	res.KeyShares = keyShares
+	res.Proofs = proofs
+	return res
+}
@@ -0,0 +1,489 @@
+package sdk_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/initiator"
+	"github.com/bloxapp/ssv-dkg/pkgs/sdk"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils/test_utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/validator"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec"
+	"github.com/bloxapp/ssv/logging"
+)
+
+var (
+	rootCert     = []string{"../../integration_test/certs/rootCA.crt"}
+	operatorCert = "../../integration_test/certs/localhost.crt"
+	operatorKey  = "../../integration_test/certs/localhost.key"
+)
+
+const examplePath = "../../examples/"
+
+func TestStaticRegistry(t *testing.T) {
+	ops := wire.OperatorsCLI{
+		{Addr: "https://localhost:3030", ID: 1},
+		{Addr: "https://localhost:3031", ID: 2},
+	}
+	registry, err := sdk.NewStaticRegistry(ops)
+	require.NoError(t, err)
+	t.Run("test operators by IDs", func(t *testing.T) {
+		res, err := registry.Operators(context.Background(), []uint64{2})
+		require.NoError(t, err)
+		require.Equal(t, wire.OperatorsCLI{ops[1]}, res)
+	})
+	t.Run("test unknown operator", func(t *testing.T) {
+		_, err := registry.Operators(context.Background(), []uint64{1, 3})
+		require.ErrorContains(t, err, "operator 3 is not in the registry")
+	})
+	t.Run("test http operator", func(t *testing.T) {
+		_, err := sdk.NewStaticRegistry(wire.OperatorsCLI{{Addr: "http://localhost:3030", ID: 1}})
+		require.ErrorContains(t, err, "only HTTPS scheme is allowed")
+	})
+	t.Run("test operators file", func(t *testing.T) {
+		registry, err := sdk.LoadOperatorsFile(filepath.Join(examplePath, "initiator", "operators_info.json"))
+		require.NoError(t, err)
+		res, err := registry.Operators(context.Background(), []uint64{1, 22, 33, 44})
+		require.NoError(t, err)
+		require.Len(t, res, 4)
+		_, err = sdk.LoadOperatorsJSON([]byte(`[]`))
+		require.ErrorContains(t, err, "no information about operators")
+	})
+}
+
+// newAPIStub serves operators in the ssv network API format and counts requests
+func newAPIStub(t *testing.T, operators wire.OperatorsCLI, dkgAddresses map[uint64]string) (*httptest.Server, *atomic.Int32) {
+	requests := &atomic.Int32{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var id uint64
+		if _, err := fmt.Sscanf(r.URL.Path, "/api/v4/holesky/operators/%d", &id); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		op := operators.ByID(id)
+		if op == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		pk, err := wire.EncodeRSAPublicKey(op.PubKey)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"id":          op.ID,
+			"name":        fmt.Sprintf("operator %d", op.ID),
+			"public_key":  string(pk),
+			"dkg_address": dkgAddresses[op.ID],
+		}))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func TestAPIRegistry(t *testing.T) {
+	operators, err := sdk.LoadOperatorsFile(filepath.Join(examplePath, "initiator", "operators_info.json"))
+	require.NoError(t, err)
+	srv, _ := newAPIStub(t, wire.OperatorsCLI(operators), map[uint64]string{
+		1:  "https://localhost:3030/",
+		22: "https://localhost:3031",
+		33: "http://localhost:3032",
+	})
+	registry := &sdk.APIRegistry{URL: srv.URL + "/api/v4", Network: "holesky"}
+	t.Run("test operators by IDs", func(t *testing.T) {
+		res, err := registry.Operators(context.Background(), []uint64{22, 1})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, "https://localhost:3031", res[0].Addr)
+		require.Equal(t, "https://localhost:3030", res[1].Addr)
+		require.True(t, res[1].PubKey.Equal(wire.OperatorsCLI(operators).ByID(1).PubKey))
+	})
+	t.Run("test unknown operator", func(t *testing.T) {
+		_, err := registry.Operators(context.Background(), []uint64{1, 2})
+		require.ErrorContains(t, err, "operator 2 is not in the registry")
+	})
+	t.Run("test operator without DKG endpoint", func(t *testing.T) {
+		_, err := registry.Operators(context.Background(), []uint64{44})
+		require.ErrorContains(t, err, "operator 44 has no DKG endpoint registered")
+	})
+	t.Run("test http operator", func(t *testing.T) {
+		_, err := registry.Operators(context.Background(), []uint64{33})
+		require.ErrorContains(t, err, "only HTTPS scheme is allowed")
+	})
+	t.Run("test canceled request", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := registry.Operators(ctx, []uint64{1})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestSnapshotRegistry(t *testing.T) {
+	operators, err := sdk.LoadOperatorsFile(filepath.Join(examplePath, "initiator", "operators_info.json"))
+	require.NoError(t, err)
+	srv, requests := newAPIStub(t, wire.OperatorsCLI(operators), map[uint64]string{
+		1:  "https://localhost:3030",
+		22: "https://localhost:3031",
+		33: "https://localhost:3032",
+	})
+	path := filepath.Join(t.TempDir(), "operators_snapshot.json")
+	registry := &sdk.SnapshotRegistry{
+		Source: &sdk.APIRegistry{URL: srv.URL + "/api/v4", Network: "holesky"},
+		Path:   path,
+		MaxAge: time.Hour,
+	}
+	t.Run("test operators fetched to the snapshot", func(t *testing.T) {
+		res, err := registry.Operators(context.Background(), []uint64{1, 22})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, int32(2), requests.Load())
+		// the snapshot is an operators info file
+		snapshot, err := sdk.LoadOperatorsFile(path)
+		require.NoError(t, err)
+		require.Len(t, snapshot, 2)
+	})
+	t.Run("test only missing operators fetched", func(t *testing.T) {
+		requests.Store(0)
+		res, err := registry.Operators(context.Background(), []uint64{22, 1, 33})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{22, 1, 33}, []uint64{res[0].ID, res[1].ID, res[2].ID})
+		require.Equal(t, int32(1), requests.Load())
+		requests.Store(0)
+		_, err = registry.Operators(context.Background(), []uint64{1, 22, 33})
+		require.NoError(t, err)
+		require.Equal(t, int32(0), requests.Load())
+	})
+	t.Run("test stale snapshot refreshed", func(t *testing.T) {
+		stale := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, os.Chtimes(path, stale, stale))
+		requests.Store(0)
+		_, err := registry.Operators(context.Background(), []uint64{1, 22})
+		require.NoError(t, err)
+		require.Equal(t, int32(2), requests.Load())
+	})
+	t.Run("test stale snapshot used when the source is down", func(t *testing.T) {
+		stale := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, os.Chtimes(path, stale, stale))
+		down := &sdk.SnapshotRegistry{
+			Source: &sdk.APIRegistry{URL: "http://127.0.0.1:0", Network: "holesky"},
+			Path:   path,
+			MaxAge: time.Hour,
+		}
+		res, err := down.Operators(context.Background(), []uint64{33})
+		require.NoError(t, err)
+		require.Equal(t, "https://localhost:3032", res[0].Addr)
+		_, err = down.Operators(context.Background(), []uint64{44})
+		require.ErrorContains(t, err, "failed to fetch operator 44")
+	})
+	t.Run("test invalid snapshot", func(t *testing.T) {
+		invalid := filepath.Join(t.TempDir(), "operators_snapshot.json")
+		require.NoError(t, os.WriteFile(invalid, []byte(strings.Repeat("{", 3)), 0o600))
+		_, err := (&sdk.SnapshotRegistry{Source: registry.Source, Path: invalid}).Operators(context.Background(), []uint64{1})
+		require.ErrorContains(t, err, "failed to load operators snapshot")
+	})
+}
+
+func TestRun(t *testing.T) {
+	err := logging.SetGlobalLogger("info", "capital", "console", nil)
+	require.NoError(t, err)
+	logger := zap.L().Named("sdk-tests")
+	version := "test.version"
+	srv1 := test_utils.CreateTestOperatorFromFile(t, 1, examplePath, version, operatorCert, operatorKey)
+	srv2 := test_utils.CreateTestOperatorFromFile(t, 2, examplePath, version, operatorCert, operatorKey)
+	srv3 := test_utils.CreateTestOperatorFromFile(t, 3, examplePath, version, operatorCert, operatorKey)
+	srv4 := test_utils.CreateTestOperatorFromFile(t, 4, examplePath, version, operatorCert, operatorKey)
+	defer func() {
+		srv1.HttpSrv.Close()
+		srv2.HttpSrv.Close()
+		srv3.HttpSrv.Close()
+		srv4.HttpSrv.Close()
+	}()
+	registry, err := sdk.NewStaticRegistry(wire.OperatorsCLI{
+		{Addr: srv1.HttpSrv.URL, ID: 1, PubKey: &srv1.PrivKey.PublicKey},
+		{Addr: srv2.HttpSrv.URL, ID: 2, PubKey: &srv2.PrivKey.PublicKey},
+		{Addr: srv3.HttpSrv.URL, ID: 3, PubKey: &srv3.PrivKey.PublicKey},
+		{Addr: srv4.HttpSrv.URL, ID: 4, PubKey: &srv4.PrivKey.PublicKey},
+	})
+	require.NoError(t, err)
+	owner := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	withdraw := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	opts := sdk.Options{
+		OperatorIDs:     []uint64{1, 2, 3, 4},
+		Owner:           owner,
+		WithdrawAddress: withdraw,
+		Network:         "holesky",
+		Nonce:           5,
+		Validators:      2,
+		CACertPaths:     rootCert,
+		Version:         version,
+		Logger:          logger,
+	}
+	t.Run("test batch written to sinks", func(t *testing.T) {
+		dir := t.TempDir()
+		memory := &sdk.MemorySink{}
+		batch, err := sdk.Run(context.Background(), registry, opts, memory, &sdk.DirSink{Dir: dir, Logger: logger})
+		require.NoError(t, err)
+		require.Len(t, batch.Results, 2)
+		require.Empty(t, batch.Failed())
+		require.Equal(t, uint64(5), batch.Results[0].Nonce)
+		require.Equal(t, uint64(6), batch.Results[1].Nonce)
+		require.Equal(t, []*sdk.Batch{batch}, memory.Batches())
+		ceremonyDirs, err := filepath.Glob(filepath.Join(dir, "ceremony-*"))
+		require.NoError(t, err)
+		require.Len(t, ceremonyDirs, 1)
+		require.NoError(t, validator.ValidateResultsDir(ceremonyDirs[0], 2, owner, 5, withdraw.Bytes()))
+	})
+	t.Run("test BLS withdrawal credentials", func(t *testing.T) {
+		withdrawSK := &bls.SecretKey{}
+		withdrawSK.SetByCSPRNG()
+		blsOpts := opts
+		blsOpts.WithdrawAddress = common.Address{}
+		blsOpts.WithdrawPubKey = withdrawSK.GetPublicKey().Serialize()
+		blsOpts.Validators = 1
+		dir := t.TempDir()
+		batch, err := sdk.Run(context.Background(), registry, blsOpts, &sdk.DirSink{Dir: dir, Logger: logger})
+		require.NoError(t, err)
+		require.Equal(t, "00", batch.Results[0].DepositData.WithdrawalCredentials[:2])
+		ceremonyDirs, err := filepath.Glob(filepath.Join(dir, "ceremony-*"))
+		require.NoError(t, err)
+		require.Len(t, ceremonyDirs, 1)
+		require.NoError(t, validator.ValidateResultsDir(ceremonyDirs[0], 1, owner, 5, blsOpts.WithdrawPubKey))
+		require.ErrorContains(t, validator.ValidateResultsDir(ceremonyDirs[0], 1, owner, 5, withdraw.Bytes()), "failed to verify withdrawal address")
+	})
+	t.Run("test canceled batch", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		memory := &sdk.MemorySink{}
+		_, err := sdk.Run(ctx, registry, opts, memory)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Empty(t, memory.Batches())
+	})
+	t.Run("test all ceremonies failed", func(t *testing.T) {
+		partialOpts := opts
+		partialOpts.PartialSuccess = true
+		// threshold below majority of operators is rejected by every ceremony
+		partialOpts.ThresholdPolicy = spec.CustomThresholdPolicy
+		partialOpts.Threshold = 1
+		memory := &sdk.MemorySink{}
+		batch, err := sdk.Run(context.Background(), registry, partialOpts, memory)
+		require.ErrorIs(t, err, sdk.ErrAllFailed)
+		require.Len(t, batch.Failed(), 2)
+		require.Empty(t, memory.Batches())
+	})
+	t.Run("test operator keys mismatch", func(t *testing.T) {
+		snapshot := &initiator.KeysSnapshot{Network: "holesky"}
+		for _, srv := range []*test_utils.TestOperator{srv1, srv2, srv3, srv1} {
+			pk, err := crypto.EncodeRSAPublicKey(&srv.PrivKey.PublicKey)
+			require.NoError(t, err)
+			snapshot.Operators = append(snapshot.Operators, initiator.SnapshotOperator{ID: uint64(len(snapshot.Operators) + 1), PubKey: string(pk)})
+		}
+		verifiedOpts := opts
+		verifiedOpts.KeysRegistry = snapshot
+		memory := &sdk.MemorySink{}
+		_, err := sdk.Run(context.Background(), registry, verifiedOpts, memory)
+		require.ErrorContains(t, err, "public key of operator 4 doesnt match the key registered at the SSV network")
+		require.Empty(t, memory.Batches())
+	})
+	t.Run("test offline operator replaced by standby operator", func(t *testing.T) {
+		srv5 := test_utils.CreateTestOperatorFromFile(t, 5, examplePath, version, operatorCert, operatorKey)
+		srv6 := test_utils.CreateTestOperatorFromFile(t, 6, examplePath, version, operatorCert, operatorKey)
+		defer srv6.HttpSrv.Close()
+		// operator 5 is offline
+		srv5.HttpSrv.Close()
+		standbyRegistry, err := sdk.NewStaticRegistry(wire.OperatorsCLI{
+			{Addr: srv1.HttpSrv.URL, ID: 1, PubKey: &srv1.PrivKey.PublicKey},
+			{Addr: srv2.HttpSrv.URL, ID: 2, PubKey: &srv2.PrivKey.PublicKey},
+			{Addr: srv3.HttpSrv.URL, ID: 3, PubKey: &srv3.PrivKey.PublicKey},
+			{Addr: srv5.HttpSrv.URL, ID: 5, PubKey: &srv5.PrivKey.PublicKey},
+			{Addr: srv6.HttpSrv.URL, ID: 6, PubKey: &srv6.PrivKey.PublicKey},
+		})
+		require.NoError(t, err)
+		standbyOpts := opts
+		standbyOpts.OperatorIDs = []uint64{1, 2, 3, 5}
+		standbyOpts.StandbyOperators = []uint64{6}
+		standbyOpts.Validators = 1
+		dir := t.TempDir()
+		batch, err := sdk.Run(context.Background(), standbyRegistry, standbyOpts, &sdk.DirSink{Dir: dir, Logger: logger})
+		require.NoError(t, err)
+		subs := batch.Substitutions()
+		require.Len(t, subs, 1)
+		require.Equal(t, uint64(5), subs[0].Offline)
+		require.Equal(t, uint64(6), subs[0].Standby)
+		require.Equal(t, []uint64{1, 2, 3, 6}, subs[0].OperatorIDs)
+		require.Equal(t, []uint64{1, 2, 3, 6}, batch.Results[0].OperatorIDs)
+		// ceremony is started again with a new request ID
+		require.NotEqual(t, hex.EncodeToString(batch.Results[0].RequestID[:]), subs[0].RequestID)
+		ceremonyDirs, err := filepath.Glob(filepath.Join(dir, "ceremony-*"))
+		require.NoError(t, err)
+		require.Len(t, ceremonyDirs, 1)
+		require.NoError(t, validator.ValidateResultsDir(ceremonyDirs[0], 1, owner, 5, withdraw.Bytes()))
+		summary := &validator.Summary{}
+		data, err := os.ReadFile(filepath.Join(ceremonyDirs[0], validator.SummaryFile))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, summary))
+		require.Equal(t, subs, summary.Substitutions)
+
+		standbyOpts.StandbyOperators = nil
+		_, err = sdk.Run(context.Background(), standbyRegistry, standbyOpts)
+		require.ErrorContains(t, err, "operator ID: 5")
+		standbyOpts.StandbyOperators = []uint64{6, 6}
+		_, err = sdk.Run(context.Background(), standbyRegistry, standbyOpts)
+		require.ErrorContains(t, err, "standby operator 6 is duplicated")
+	})
+	t.Run("test unknown operator", func(t *testing.T) {
+		unknownOpts := opts
+		unknownOpts.OperatorIDs = []uint64{1, 2, 3, 5}
+		_, err := sdk.Run(context.Background(), registry, unknownOpts)
+		require.ErrorContains(t, err, "operator 5 is not in the registry")
+	})
+	t.Run("test manifest", func(t *testing.T) {
+		otherOwner := common.HexToAddress("0x0000000000000000000000000000000000000008")
+		entries := []sdk.ManifestEntry{
+			{OperatorIDs: []uint64{1, 2, 3, 4}, Owner: owner, WithdrawAddress: withdraw, Nonce: 0, Validators: 1},
+			{OperatorIDs: []uint64{1, 2, 3, 4}, Owner: otherOwner, WithdrawAddress: withdraw, Nonce: 3, Validators: 1},
+			{OperatorIDs: []uint64{1, 2, 3, 5}, Owner: owner, WithdrawAddress: withdraw, Nonce: 2, Validators: 1},
+		}
+		dirs := make(map[int]string)
+		sinks := func(row int, entry sdk.ManifestEntry) ([]sdk.Sink, error) {
+			dirs[row] = t.TempDir()
+			return []sdk.Sink{&sdk.DirSink{Dir: dirs[row], Logger: logger}}, nil
+		}
+		results, err := sdk.RunManifest(context.Background(), registry, entries, opts, sinks)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.NoError(t, results[0].Err)
+		require.NoError(t, results[1].Err)
+		require.ErrorContains(t, results[2].Err, "operator 5 is not in the registry")
+		for row, entry := range entries[:2] {
+			ceremonyDirs, err := filepath.Glob(filepath.Join(dirs[row+1], "ceremony-*"))
+			require.NoError(t, err)
+			require.Len(t, ceremonyDirs, 1)
+			require.NoError(t, validator.ValidateResultsDir(ceremonyDirs[0], entry.Validators, entry.Owner, entry.Nonce, withdraw.Bytes()))
+		}
+		report := sdk.NewManifestReport(results, dirs)
+		require.Equal(t, 2, report.Succeeded)
+		require.Equal(t, 1, report.Failed)
+		require.Equal(t, []uint64{0}, report.Entries[0].Nonces)
+		require.Equal(t, []uint64{3}, report.Entries[1].Nonces)
+		require.Empty(t, report.Entries[2].Nonces)
+		require.Empty(t, report.Entries[2].Dir)
+		require.Contains(t, report.Entries[2].Error, "operator 5 is not in the registry")
+	})
+	t.Run("test invalid options", func(t *testing.T) {
+		invalidOpts := opts
+		invalidOpts.Validators = sdk.MaxValidators + 1
+		_, err := sdk.Run(context.Background(), registry, invalidOpts)
+		require.ErrorContains(t, err, "amount of generated validators")
+		invalidOpts = opts
+		invalidOpts.Owner = common.Address{}
+		_, err = sdk.Run(context.Background(), registry, invalidOpts)
+		require.ErrorContains(t, err, "owner address is empty")
+		invalidOpts = opts
+		invalidOpts.WithdrawPubKey = make([]byte, 48)
+		_, err = sdk.Run(context.Background(), registry, invalidOpts)
+		require.ErrorContains(t, err, "withdrawal address and BLS withdrawal public key are both set")
+	})
+}
+
+func TestBatchContiguous(t *testing.T) {
+	failed := fmt.Errorf("operator timeout")
+	batch := &sdk.Batch{Results: []*sdk.Result{{Nonce: 1}, {Nonce: 2}, {Nonce: 3, Err: failed}, {Nonce: 4}, {Nonce: 5, Err: failed}, {Nonce: 6}}}
+	nonces := func(results []*sdk.Result) []uint64 {
+		var nonces []uint64
+		for _, res := range results {
+			nonces = append(nonces, res.Nonce)
+		}
+		return nonces
+	}
+	require.Equal(t, []uint64{1, 2}, nonces(batch.Contiguous()))
+	require.Equal(t, []uint64{4, 6}, nonces(batch.Pending()))
+	// no results can be written when the first nonce failed
+	batch.Results[0].Err = failed
+	require.Empty(t, batch.Contiguous())
+	require.Equal(t, []uint64{2, 4, 6}, nonces(batch.Pending()))
+	require.NoError(t, (&sdk.DirSink{Dir: t.TempDir()}).Write(context.Background(), batch))
+}
+
+func TestLoadManifest(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+		return path
+	}
+	expected := []sdk.ManifestEntry{
+		{OperatorIDs: []uint64{1, 2, 3, 4}, Owner: common.HexToAddress("0x81592c3de184a3e2c0dcb5a261bc107bfa91f494"), WithdrawAddress: common.HexToAddress("0x81592c3de184a3e2c0dcb5a261bc107bfa91f494"), Nonce: 0, Validators: 10},
+		{OperatorIDs: []uint64{5, 6, 7, 8}, Owner: common.HexToAddress("0x81592c3de184a3e2c0dcb5a261bc107bfa91f494"), WithdrawAddress: common.HexToAddress("0xdcc846fa10c7cfce9e6eb37e06ed93b666cfc5e9"), Nonce: 10, Validators: 2},
+	}
+	t.Run("test csv manifest", func(t *testing.T) {
+		entries, err := sdk.LoadManifest(write("manifest.csv", `owner,nonce,validators,withdraw_address,operator_ids
+0x81592c3de184a3e2c0dcb5a261bc107bfa91f494,0,10,0x81592c3de184a3e2c0dcb5a261bc107bfa91f494,"1,2,3,4"
+0x81592c3de184a3e2c0dcb5a261bc107bfa91f494,10,2,0xdcc846fa10c7cfce9e6eb37e06ed93b666cfc5e9,5 6 7 8
+`))
+		require.NoError(t, err)
+		require.Equal(t, expected, entries)
+	})
+	t.Run("test json manifest", func(t *testing.T) {
+		data, err := json.Marshal(expected)
+		require.NoError(t, err)
+		entries, err := sdk.LoadManifest(write("manifest.json", string(data)))
+		require.NoError(t, err)
+		require.Equal(t, expected, entries)
+	})
+	t.Run("test invalid manifests", func(t *testing.T) {
+		_, err := sdk.LoadManifest(write("manifest.txt", ""))
+		require.ErrorContains(t, err, "manifest should be a .csv or .json file")
+		_, err = sdk.LoadManifest(write("missing.csv", "owner,nonce,validators,operator_ids\n"))
+		require.ErrorContains(t, err, "column withdraw_address is missing")
+		_, err = sdk.LoadManifest(write("bad_id.csv", `owner,nonce,validators,withdraw_address,operator_ids
+0x81592c3de184a3e2c0dcb5a261bc107bfa91f494,0,1,0x81592c3de184a3e2c0dcb5a261bc107bfa91f494,1;x
+`))
+		require.ErrorContains(t, err, "row 1: failed to parse operator ID x")
+		_, err = sdk.LoadManifest(write("empty.json", "[]"))
+		require.ErrorContains(t, err, "manifest is empty")
+		overlapping := []sdk.ManifestEntry{expected[0], expected[1]}
+		overlapping[1].Nonce = 9
+		require.EqualError(t, sdk.ValidateManifest(overlapping), fmt.Sprintf("row 2: nonces of owner %s overlap with row 1", overlapping[1].Owner.Hex()))
+		invalid := []sdk.ManifestEntry{expected[0]}
+		invalid[0].Validators = sdk.MaxValidators + 1
+		require.ErrorContains(t, sdk.ValidateManifest(invalid), "row 1: amount of generated validators")
+	})
+}
+
+func TestOperatorLimiter(t *testing.T) {
+	limiter := sdk.NewOperatorLimiter(1)
+	require.NoError(t, limiter.Acquire(context.Background(), []uint64{1, 2, 3, 4}))
+	// ceremonies of disjoint clusters run concurrently
+	require.NoError(t, limiter.Acquire(context.Background(), []uint64{5, 6, 7, 8}))
+	// ceremony of an overlapping cluster waits for a free slot of every operator
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.Acquire(ctx, []uint64{4, 5, 9, 10}), context.DeadlineExceeded)
+	acquired := make(chan error)
+	go func() {
+		acquired <- limiter.Acquire(context.Background(), []uint64{4, 5, 9, 10})
+	}()
+	limiter.Release([]uint64{1, 2, 3, 4})
+	select {
+	case <-acquired:
+		t.Fatal("operator 5 has no free slot")
+	case <-time.After(50 * time.Millisecond):
+	}
+	limiter.Release([]uint64{5, 6, 7, 8})
+	require.NoError(t, <-acquired)
+}
@@ -0,0 +1,328 @@
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/initiator"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/validator"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// Sink receives results of a batch of ceremonies, i.e. to store them at a directory, a database or an object storage
+type Sink interface {
+	Write(ctx context.Context, batch *Batch) error
+}
+
+// DirSink writes results of a batch to a ceremony-[timestamp] directory the same way as the initiator CLI does
+type DirSink struct {
+	Dir            string      // output directory where the ceremony directory is created
+	WithRandomness bool        // add a random suffix to the ceremony directory name
+	Logger         *zap.Logger // optional logger
+}
+
+// Write validates results of successful ceremonies of contiguous nonces of the batch and writes them to a new ceremony directory.
+// Failed ceremonies and successful ceremonies after the first failed nonce are listed at the summary file of the directory.
+func (s *DirSink) Write(ctx context.Context, batch *Batch) error {
+	logger := s.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	contiguous := batch.Contiguous()
+	if len(contiguous) == 0 {
+		// results of successful ceremonies are kept at the journal until the first nonce is created
+		logger.Warn("⚠️ ceremony of the first nonce of the batch failed, no results of contiguous nonces to write", zap.Uint64("nonce", batch.Results[0].Nonce))
+		return nil
+	}
+	depositDataArr := make([]*wire.DepositDataCLI, 0, len(contiguous))
+	keySharesArr := make([]*wire.KeySharesCLI, 0, len(contiguous))
+	proofs := make([][]*wire.SignedProof, 0, len(contiguous))
+	nonces := make([]uint64, 0, len(contiguous))
+	for _, res := range contiguous {
+		depositDataArr = append(depositDataArr, res.DepositData)
+		keySharesArr = append(keySharesArr, res.KeyShares)
+		proofs = append(proofs, res.Proofs)
+		nonces = append(nonces, res.Nonce)
+	}
+	var failed []validator.FailedCeremony
+	for _, res := range batch.Failed() {
+		failed = append(failed, validator.FailedCeremony{
+			Nonce:     res.Nonce,
+			RequestID: hex.EncodeToString(res.RequestID[:]),
+			Error:     res.Err.Error(),
+		})
+	}
+	var pending []uint64
+	for _, res := range batch.Pending() {
+		pending = append(pending, res.Nonce)
+	}
+	return WriteCeremonyDir(logger, depositDataArr, keySharesArr, proofs, failed, pending, batch.Substitutions(), s.WithRandomness, nonces, batch.Owner, batch.Withdraw(), s.Dir)
+}
+
+// MemorySink keeps batches in memory, i.e. to process results by the application after the run
+type MemorySink struct {
+	mtx     sync.Mutex
+	batches []*Batch
+}
+
+// Write stores the batch
+func (s *MemorySink) Write(ctx context.Context, batch *Batch) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.batches = append(s.batches, batch)
+	return nil
+}
+
+// Batches returns batches written to the sink
+func (s *MemorySink) Batches() []*Batch {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]*Batch(nil), s.batches...)
+}
+
+// WriteCeremonyDir validates results of successful ceremonies and writes them to a new ceremony directory under outputPath.
+// Results are written for the expected contiguous nonces only, failed ceremonies, pending nonces of successful ceremonies
+// after the first failed nonce and substituted operators are listed at the summary file of the directory.
+func WriteCeremonyDir(
+	logger *zap.Logger,
+	depositDataArr []*wire.DepositDataCLI,
+	keySharesArr []*wire.KeySharesCLI,
+	proofs [][]*wire.SignedProof,
+	failed []validator.FailedCeremony,
+	pending []uint64,
+	substitutions []wire.Substitution,
+	withRandomness bool,
+	expectedNonces []uint64,
+	expectedOwnerAddress common.Address,
+	expectedWithdraw []byte,
+	outputPath string,
+) (err error) {
+	if len(depositDataArr) != len(keySharesArr) || len(depositDataArr) != len(proofs) {
+		return fmt.Errorf("Incoming result arrays have inconsistent length")
+	}
+	if len(depositDataArr) == 0 {
+		return fmt.Errorf("no results to write")
+	}
+	if len(depositDataArr) != len(expectedNonces) {
+		return fmt.Errorf("expectedValidatorCount is not equal to the length of given results")
+	}
+	if !slices.Equal(expectedNonces, validator.ContiguousNonces(expectedNonces[0], len(expectedNonces))) {
+		return fmt.Errorf("results are written only for contiguous nonces, got %v", expectedNonces)
+	}
+	for _, f := range failed {
+		if slices.Contains(expectedNonces, f.Nonce) {
+			return fmt.Errorf("nonce %d is both successful and failed", f.Nonce)
+		}
+	}
+	for _, nonce := range pending {
+		if slices.Contains(expectedNonces, nonce) {
+			return fmt.Errorf("nonce %d is both written and pending", nonce)
+		}
+	}
+
+	// order the keyshares by nonce
+	sort.SliceStable(keySharesArr, func(i, j int) bool {
+		return keySharesArr[i].Shares[0].OwnerNonce < keySharesArr[j].Shares[0].OwnerNonce
+	})
+	sorted := sort.SliceIsSorted(keySharesArr, func(p, q int) bool {
+		return keySharesArr[p].Shares[0].OwnerNonce < keySharesArr[q].Shares[0].OwnerNonce
+	})
+	if !sorted {
+		return fmt.Errorf("slice is not sorted")
+	}
+
+	// check if public keys are unique
+	for i := 0; i < len(keySharesArr)-1; i++ {
+		pk1 := keySharesArr[i].Shares[0].Payload.PublicKey
+		pk2 := keySharesArr[i+1].Shares[0].Payload.PublicKey
+		if pk1 == pk2 {
+			return fmt.Errorf("public key %s is not unique", keySharesArr[i].Shares[0].Payload.PublicKey)
+		}
+	}
+
+	// order deposit data and proofs to match keyshares order
+	sortedDepositData := make([]*wire.DepositDataCLI, len(depositDataArr))
+	sortedProofs := make([][]*wire.SignedProof, len(depositDataArr))
+	for i, keyshare := range keySharesArr {
+		pk := strings.TrimPrefix(keyshare.Shares[0].Payload.PublicKey, "0x")
+		for _, deposit := range depositDataArr {
+			if deposit.PubKey == pk {
+				sortedDepositData[i] = deposit
+				break
+			}
+		}
+		if sortedDepositData[i] == nil {
+			return fmt.Errorf("failed to match deposit data with keyshares")
+		}
+		for _, proof := range proofs {
+			if hex.EncodeToString(proof[0].Proof.ValidatorPubKey) == pk {
+				sortedProofs[i] = proof
+				break
+			}
+		}
+		if sortedProofs[i] == nil {
+			return fmt.Errorf("failed to match proofs with keyshares")
+		}
+	}
+	depositDataArr = sortedDepositData
+	proofs = sortedProofs
+
+	// Validate the results.
+	aggregatedKeyshares := &wire.KeySharesCLI{
+		Version:   keySharesArr[0].Version,
+		CreatedAt: keySharesArr[0].CreatedAt,
+	}
+	for i := 0; i < len(keySharesArr); i++ {
+		aggregatedKeyshares.Shares = append(aggregatedKeyshares.Shares, keySharesArr[i].Shares...)
+	}
+	if err := validator.ValidateResultsWithNonces(depositDataArr, aggregatedKeyshares, proofs, expectedNonces, expectedOwnerAddress, expectedWithdraw); err != nil {
+		return err
+	}
+
+	// Create the ceremony directory.
+	timestamp := time.Now().UTC().Format("2006-01-02--15-04-05.000")
+	dirName := fmt.Sprintf("ceremony-%s", timestamp)
+	if withRandomness {
+		randomness := make([]byte, 4)
+		if _, err := rand.Read(randomness); err != nil {
+			return fmt.Errorf("failed to generate randomness: %w", err)
+		}
+		dirName = fmt.Sprintf("%s--%x", dirName, randomness)
+	}
+	dir := filepath.Join(outputPath, dirName)
+	err = os.Mkdir(dir, os.ModePerm)
+	if os.IsExist(err) {
+		return fmt.Errorf("ceremony directory already exists: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create a ceremony directory: %w", err)
+	}
+
+	// If saving fails, create a "FAILED" file under the ceremony directory.
+	defer func() {
+		if err != nil {
+			if err := os.WriteFile(filepath.Join(dir, "FAILED"), []byte(err.Error()), 0o600); err != nil {
+				logger.Error("failed to write error file", zap.Error(err))
+			}
+		}
+	}()
+
+	for i := 0; i < len(depositDataArr); i++ {
+		nestedDir := fmt.Sprintf("%s/%06d-0x%s", dir, keySharesArr[i].Shares[0].OwnerNonce, depositDataArr[i].PubKey)
+		err := os.Mkdir(nestedDir, os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("failed to create a validator key directory: %w", err)
+		}
+		logger.Info("💾 Writing deposit data json", zap.String("path", nestedDir))
+		err = WriteDepositResult(depositDataArr[i], nestedDir)
+		if err != nil {
+			logger.Error("Failed writing deposit data file: ", zap.Error(err), zap.String("path", nestedDir), zap.Any("deposit", depositDataArr[i]))
+			return fmt.Errorf("failed writing deposit data file: %w", err)
+		}
+		logger.Info("💾 Writing keyshares payload to file", zap.String("path", nestedDir))
+		err = WriteKeysharesResult(keySharesArr[i], nestedDir)
+		if err != nil {
+			logger.Error("Failed writing keyshares file: ", zap.Error(err), zap.String("path", nestedDir), zap.Any("deposit", keySharesArr[i]))
+			return fmt.Errorf("failed writing keyshares file: %w", err)
+		}
+		logger.Info("💾 Writing proofs to file", zap.String("path", nestedDir))
+		err = WriteProofs(proofs[i], nestedDir)
+		if err != nil {
+			logger.Error("Failed writing proofs file: ", zap.Error(err), zap.String("path", nestedDir), zap.Any("proof", proofs[i]))
+			return fmt.Errorf("failed writing proofs file: %w", err)
+		}
+	}
+	// if there is only one Validator, do not create summary files
+	if len(expectedNonces) > 1 {
+		err := WriteAggregatedInitResults(dir, depositDataArr, keySharesArr, proofs, logger)
+		if err != nil {
+			return fmt.Errorf("failed writing aggregated results: %w", err)
+		}
+	}
+	if len(failed) > 0 || len(pending) > 0 || len(substitutions) > 0 {
+		summaryPath := filepath.Join(dir, validator.SummaryFile)
+		logger.Info("💾 Writing summary of failed ceremonies and substituted operators to file", zap.String("path", summaryPath))
+		err := utils.WriteJSON(summaryPath, &validator.Summary{Nonces: expectedNonces, Failed: failed, Pending: pending, Substitutions: substitutions})
+		if err != nil {
+			return fmt.Errorf("failed writing summary file: %w", err)
+		}
+	}
+
+	err = validator.ValidateResultsDirWithNonces(dir, expectedNonces, expectedOwnerAddress, expectedWithdraw)
+	if err != nil {
+		return fmt.Errorf("failed validating results dir: %w", err)
+	}
+
+	return nil
+}
+
+func WriteAggregatedInitResults(dir string, depositDataArr []*wire.DepositDataCLI, keySharesArr []*wire.KeySharesCLI, proofs [][]*wire.SignedProof, logger *zap.Logger) error {
+	// Write all to one JSON file
+	depositFinalPath := fmt.Sprintf("%s/deposit_data.json", dir)
+	logger.Info("💾 Writing deposit data json to file", zap.String("path", depositFinalPath))
+	err := utils.WriteJSON(depositFinalPath, depositDataArr)
+	if err != nil {
+		logger.Error("Failed writing deposit data file: ", zap.Error(err), zap.String("path", depositFinalPath), zap.Any("deposits", depositDataArr))
+		return err
+	}
+	keysharesFinalPath := fmt.Sprintf("%s/keyshares.json", dir)
+	logger.Info("💾 Writing keyshares payload to file", zap.String("path", keysharesFinalPath))
+	aggrKeySharesArr, err := initiator.GenerateAggregatesKeyshares(keySharesArr)
+	if err != nil {
+		return err
+	}
+	err = utils.WriteJSON(keysharesFinalPath, aggrKeySharesArr)
+	if err != nil {
+		logger.Error("Failed writing keyshares to file: ", zap.Error(err), zap.String("path", keysharesFinalPath), zap.Any("keyshares", keySharesArr))
+		return err
+	}
+	proofsFinalPath := fmt.Sprintf("%s/proofs.json", dir)
+	err = utils.WriteJSON(proofsFinalPath, proofs)
+	if err != nil {
+		logger.Error("Failed writing ceremony sig file: ", zap.Error(err), zap.String("path", proofsFinalPath), zap.Any("proofs", proofs))
+		return err
+	}
+
+	return nil
+}
+
+func WriteKeysharesResult(keyShares *wire.KeySharesCLI, dir string) error {
+	keysharesFinalPath := fmt.Sprintf("%s/keyshares.json", dir)
+	err := utils.WriteJSON(keysharesFinalPath, keyShares)
+	if err != nil {
+		return fmt.Errorf("failed writing keyshares file: %w, %v", err, keyShares)
+	}
+	return nil
+}
+
+func WriteDepositResult(depositData *wire.DepositDataCLI, dir string) error {
+	depositFinalPath := fmt.Sprintf("%s/deposit_data.json", dir)
+	err := utils.WriteJSON(depositFinalPath, []*wire.DepositDataCLI{depositData})
+
+	if err != nil {
+		return fmt.Errorf("failed writing deposit data file: %w, %v", err, depositData)
+	}
+	return nil
+}
+
+func WriteProofs(proofs []*wire.SignedProof, dir string) error {
+	finalPath := fmt.Sprintf("%s/proofs.json", dir)
+	err := utils.WriteJSON(finalPath, proofs)
+	if err != nil {
+		return fmt.Errorf("failed writing data file: %w, %v", err, proofs)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	eth2_key_manager_core "github.com/bloxapp/eth2-key-manager/core"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NetworkConfig defines an ethereum network unknown to eth2-key-manager, e.g. a devnet with a custom genesis.
+// Initiator and operators of a ceremony should register the same network config.
+type NetworkConfig struct {
+	Name                  string         `json:"name"`
+	GenesisForkVersion    hexutil.Bytes  `json:"genesis_fork_version"`
+	GenesisValidatorsRoot hexutil.Bytes  `json:"genesis_validators_root"`
+	DepositContract       common.Address `json:"deposit_contract"`
+	// CapellaForkVersion is optional, voluntary exits are signed with it since Deneb (EIP-7044)
+	CapellaForkVersion hexutil.Bytes `json:"capella_fork_version,omitempty"`
+}
+
+// builtinNetworks are networks known to eth2-key-manager
+var builtinNetworks = []eth2_key_manager_core.Network{
+	eth2_key_manager_core.MainNetwork,
+	eth2_key_manager_core.PraterNetwork,
+	eth2_key_manager_core.HoleskyNetwork,
+}
+
+var (
+	customNetworksMtx sync.RWMutex
+	customNetworks    = make(map[eth2_key_manager_core.Network]*NetworkConfig)
+)
+
+// LoadNetworkConfig reads a JSON network config file and registers the network
+func LoadNetworkConfig(path string) (*NetworkConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read network config file: %w", err)
+	}
+	cfg := &NetworkConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal network config file: %w", err)
+	}
+	if err := RegisterNetwork(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// validate checks lengths of the network config fields and that the network doesnt clash with built in networks
+func (cfg *NetworkConfig) validate() error {
+	if cfg.Name == "" {
+		return fmt.Errorf("network name is empty")
+	}
+	if len(cfg.GenesisForkVersion) != len(phase0.Version{}) {
+		return fmt.Errorf("genesis fork version of network %s should be %d bytes", cfg.Name, len(phase0.Version{}))
+	}
+	if len(cfg.GenesisValidatorsRoot) != len(phase0.Root{}) {
+		return fmt.Errorf("genesis validators root of network %s should be %d bytes", cfg.Name, len(phase0.Root{}))
+	}
+	if len(cfg.CapellaForkVersion) != 0 && len(cfg.CapellaForkVersion) != len(phase0.Version{}) {
+		return fmt.Errorf("capella fork version of network %s should be %d bytes", cfg.Name, len(phase0.Version{}))
+	}
+	for _, network := range builtinNetworks {
+		if cfg.Name == string(network) {
+			return fmt.Errorf("network %s is built in", cfg.Name)
+		}
+		if phase0.Version(cfg.GenesisForkVersion) == network.GenesisForkVersion() {
+			return fmt.Errorf("genesis fork version %x of network %s is used by network %s", []byte(cfg.GenesisForkVersion), cfg.Name, network)
+		}
+	}
+	return nil
+}
+
+// RegisterNetwork adds a custom network, so that it is recognized by name and genesis fork version.
+// Registering the same config again is a no-op.
+func RegisterNetwork(cfg *NetworkConfig) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	customNetworksMtx.Lock()
+	defer customNetworksMtx.Unlock()
+	for name, registered := range customNetworks {
+		if name == eth2_key_manager_core.Network(cfg.Name) {
+			if registered.equal(cfg) {
+				return nil
+			}
+			return fmt.Errorf("network %s is already registered with another config", cfg.Name)
+		}
+		if phase0.Version(registered.GenesisForkVersion) == phase0.Version(cfg.GenesisForkVersion) {
+			return fmt.Errorf("genesis fork version %x of network %s is used by network %s", []byte(cfg.GenesisForkVersion), cfg.Name, name)
+		}
+	}
+	customNetworks[eth2_key_manager_core.Network(cfg.Name)] = cfg
+	return nil
+}
+
+func (cfg *NetworkConfig) equal(other *NetworkConfig) bool {
+	return cfg.Name == other.Name &&
+		phase0.Version(cfg.GenesisForkVersion) == phase0.Version(other.GenesisForkVersion) &&
+		phase0.Root(cfg.GenesisValidatorsRoot) == phase0.Root(other.GenesisValidatorsRoot) &&
+		cfg.DepositContract == other.DepositContract &&
+		string(cfg.CapellaForkVersion) == string(other.CapellaForkVersion)
+}
+
+// CustomNetwork returns config of a registered custom network
+func CustomNetwork(network eth2_key_manager_core.Network) (*NetworkConfig, bool) {
+	customNetworksMtx.RLock()
+	defer customNetworksMtx.RUnlock()
+	cfg, ok := customNetworks[network]
+	return cfg, ok
+}
+
+// customNetworkByFork returns a registered custom network by genesis fork version
+func customNetworkByFork(fork [4]byte) (eth2_key_manager_core.Network, bool) {
+	customNetworksMtx.RLock()
+	defer customNetworksMtx.RUnlock()
+	for name, cfg := range customNetworks {
+		if phase0.Version(cfg.GenesisForkVersion) == phase0.Version(fork) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// GetNetworkByName returns a built in or registered custom network by name
+func GetNetworkByName(name string) (eth2_key_manager_core.Network, error) {
+	for _, network := range builtinNetworks {
+		if name == string(network) {
+			return network, nil
+		}
+	}
+	if _, ok := CustomNetwork(eth2_key_manager_core.Network(name)); ok {
+		return eth2_key_manager_core.Network(name), nil
+	}
+	return "", fmt.Errorf("unknown network %s", name)
+}
+
+// IsKnownNetwork returns true for built in and registered custom networks
+func IsKnownNetwork(network eth2_key_manager_core.Network) bool {
+	_, err := GetNetworkByName(string(network))
+	return err == nil
+}
+
+// GenesisForkVersion returns the genesis fork version of a built in or registered custom network
+func GenesisForkVersion(network eth2_key_manager_core.Network) phase0.Version {
+	if cfg, ok := CustomNetwork(network); ok {
+		return phase0.Version(cfg.GenesisForkVersion)
+	}
+	return network.GenesisForkVersion()
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of a built in or registered custom network
+func GenesisValidatorsRoot(network eth2_key_manager_core.Network) phase0.Root {
+	if cfg, ok := CustomNetwork(network); ok {
+		return phase0.Root(cfg.GenesisValidatorsRoot)
+	}
+	return network.GenesisValidatorsRoot()
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	eth2_key_manager_core "github.com/bloxapp/eth2-key-manager/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "network.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "name": "utils-devnet",
+  "genesis_fork_version": "0x10000038",
+  "genesis_validators_root": "0xd61ea484febacfae5298d52a2b581f3e305a51f3112a9241b968dccf019f7b11",
+  "deposit_contract": "0x4242424242424242424242424242424242424242"
+}`), 0o600))
+	t.Run("test load network config", func(t *testing.T) {
+		cfg, err := LoadNetworkConfig(path)
+		require.NoError(t, err)
+		network, err := GetNetworkByName("utils-devnet")
+		require.NoError(t, err)
+		require.Equal(t, eth2_key_manager_core.Network("utils-devnet"), network)
+		require.Equal(t, phase0.Version{0x10, 0x00, 0x00, 0x38}, GenesisForkVersion(network))
+		require.Equal(t, phase0.Root(cfg.GenesisValidatorsRoot), GenesisValidatorsRoot(network))
+		byFork, err := GetNetworkByFork([4]byte{0x10, 0x00, 0x00, 0x38})
+		require.NoError(t, err)
+		require.Equal(t, network, byFork)
+		// loading the same config again is a no-op
+		_, err = LoadNetworkConfig(path)
+		require.NoError(t, err)
+	})
+	t.Run("test built in networks", func(t *testing.T) {
+		network, err := GetNetworkByName("holesky")
+		require.NoError(t, err)
+		require.Equal(t, eth2_key_manager_core.HoleskyNetwork, network)
+		require.Equal(t, phase0.Version{0x01, 0x01, 0x70, 0x00}, GenesisForkVersion(network))
+		_, err = GetNetworkByName("unknown")
+		require.EqualError(t, err, "unknown network unknown")
+		_, err = GetNetworkByFork([4]byte{0x10, 0x00, 0x00, 0x39})
+		require.EqualError(t, err, "unknown network")
+	})
+	t.Run("test invalid configs", func(t *testing.T) {
+		root := make([]byte, 32)
+		err := RegisterNetwork(&NetworkConfig{Name: "holesky", GenesisForkVersion: []byte{0x10, 0, 0, 0x40}, GenesisValidatorsRoot: root})
+		require.EqualError(t, err, "network holesky is built in")
+		err = RegisterNetwork(&NetworkConfig{Name: "devnet-mainnet-fork", GenesisForkVersion: []byte{0, 0, 0, 0}, GenesisValidatorsRoot: root})
+		require.EqualError(t, err, "genesis fork version 00000000 of network devnet-mainnet-fork is used by network mainnet")
+		err = RegisterNetwork(&NetworkConfig{Name: "devnet-same-fork", GenesisForkVersion: []byte{0x10, 0x00, 0x00, 0x38}, GenesisValidatorsRoot: root})
+		require.EqualError(t, err, "genesis fork version 10000038 of network devnet-same-fork is used by network utils-devnet")
+		err = RegisterNetwork(&NetworkConfig{Name: "utils-devnet", GenesisForkVersion: []byte{0x10, 0x00, 0x00, 0x41}, GenesisValidatorsRoot: root})
+		require.EqualError(t, err, "network utils-devnet is already registered with another config")
+		err = RegisterNetwork(&NetworkConfig{Name: "devnet-short-fork", GenesisForkVersion: []byte{0x10}, GenesisValidatorsRoot: root})
+		require.EqualError(t, err, "genesis fork version of network devnet-short-fork should be 4 bytes")
+		err = RegisterNetwork(&NetworkConfig{Name: "devnet-short-root", GenesisForkVersion: []byte{0x10, 0, 0, 0x42}, GenesisValidatorsRoot: root[:31]})
+		require.EqualError(t, err, "genesis validators root of network devnet-short-root should be 32 bytes")
+	})
+}
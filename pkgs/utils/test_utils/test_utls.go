@@ -49,8 +49,11 @@ func CreateTestOperatorFromFile(t *testing.T, id uint64, examplePath, version, o
 	pkBytes, err := crypto.EncodeRSAPublicKey(operatorPubKey)
 	require.NoError(t, err)
 	swtch := operator.NewSwitch(priv, logger, []byte(version), pkBytes, id)
+	swtch.Metrics = operator.NewMetrics(swtch)
 	tempDir, err := os.MkdirTemp("", "dkg")
 	require.NoError(t, err)
+	swtch.Shares, err = operator.NewShareStore(filepath.Join(tempDir, operator.SharesDir))
+	require.NoError(t, err)
 	s := &operator.Server{
 		Logger:     logger,
 		Router:     r,
@@ -82,8 +85,11 @@ func CreateTestOperator(t *testing.T, id uint64, version, operatorCert, operator
 	pkBytes, err := crypto.EncodeRSAPublicKey(operatorPubKey)
 	require.NoError(t, err)
 	swtch := operator.NewSwitch(priv, logger, []byte(version), pkBytes, id)
+	swtch.Metrics = operator.NewMetrics(swtch)
 	tempDir, err := os.MkdirTemp("", "dkg")
 	require.NoError(t, err)
+	swtch.Shares, err = operator.NewShareStore(filepath.Join(tempDir, operator.SharesDir))
+	require.NoError(t, err)
 	s := &operator.Server{
 		Logger:     logger,
 		Router:     r,
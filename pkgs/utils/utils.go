@@ -20,6 +20,7 @@ import (
 var ErrMissingInstance = errors.New("got message to instance that I don't have, send Init first")
 var ErrAlreadyExists = errors.New("got init msg for existing instance")
 var ErrMaxInstances = errors.New("max number of instances ongoing, please wait")
+var ErrShuttingDown = errors.New("operator is shutting down, new ceremonies are not accepted")
 
 type SensitiveError struct {
 	Err          error
@@ -82,7 +83,8 @@ func GetThreshold(ids []uint64) (int, error) {
 	return threshold, nil
 }
 
-// GetNetworkByFork translates the network fork bytes into name
+// GetNetworkByFork translates the network fork bytes into name, custom networks are looked up by
+// genesis fork version of registered network configs
 //
 //	TODO: once eth2_key_manager implements this we can get rid of it and support all networks ekm supports automatically
 func GetNetworkByFork(fork [4]byte) (eth2_key_manager_core.Network, error) {
@@ -93,9 +95,11 @@ func GetNetworkByFork(fork [4]byte) (eth2_key_manager_core.Network, error) {
 		return eth2_key_manager_core.HoleskyNetwork, nil
 	case [4]byte{0, 0, 0, 0}:
 		return eth2_key_manager_core.MainNetwork, nil
-	default:
-		return eth2_key_manager_core.MainNetwork, errors.New("unknown network")
 	}
+	if network, ok := customNetworkByFork(fork); ok {
+		return network, nil
+	}
+	return eth2_key_manager_core.MainNetwork, errors.New("unknown network")
 }
 
 func WriteErrorResponse(logger *zap.Logger, writer http.ResponseWriter, err error, statusCode int) {
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -15,11 +16,32 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// SummaryFile is a file name of the summary written to results directory of a partially successful batch
+const SummaryFile = "summary.json"
+
+// Summary lists nonces of validators written to results directory, nonces of failed ceremonies
+// of a partially successful batch, nonces of successful ceremonies after the first failed nonce which
+// arent written until failed nonces are created, and operators replaced by standby operators
+type Summary struct {
+	Nonces        []uint64            `json:"nonces"`
+	Failed        []FailedCeremony    `json:"failed"`
+	Pending       []uint64            `json:"pending,omitempty"`
+	Substitutions []wire.Substitution `json:"substitutions,omitempty"`
+}
+
+// FailedCeremony is a ceremony of a batch which failed and has no results at results directory
+type FailedCeremony struct {
+	Nonce     uint64 `json:"nonce"`
+	RequestID string `json:"request_id"`
+	Error     string `json:"error"`
+}
+
 type ResultsDir struct {
 	AggregatedDepositData []*wire.DepositDataCLI
 	AggregatedKeyShares   *wire.KeySharesCLI
 	AggregatedProofs      [][]*wire.SignedProof
 	Validators            []ResultsValidatorDir
+	Summary               *Summary
 }
 
 type ResultsValidatorDir struct {
@@ -30,7 +52,18 @@ type ResultsValidatorDir struct {
 	Proofs      []*wire.SignedProof
 }
 
-func ValidateResultsDir(dir string, validatorCount int, ownerAddress common.Address, ownerNonce uint64, withdrawAddress common.Address) error {
+func ValidateResultsDir(dir string, validatorCount int, ownerAddress common.Address, ownerNonce uint64, withdraw []byte) error {
+	if validatorCount < 1 {
+		return fmt.Errorf("validator count is less than 1")
+	}
+	return ValidateResultsDirWithNonces(dir, ContiguousNonces(ownerNonce, validatorCount), ownerAddress, withdraw)
+}
+
+// ValidateResultsDirWithNonces validates results directory containing validators of the explicit list of nonces,
+// i.e. results of a partially successful batch which has gaps at nonces of failed ceremonies.
+// Withdraw is a withdrawal address or a BLS withdrawal public key of validators with 0x00 withdrawal credentials.
+func ValidateResultsDirWithNonces(dir string, nonces []uint64, ownerAddress common.Address, withdraw []byte) error {
+	validatorCount := len(nonces)
 	if validatorCount < 1 {
 		return fmt.Errorf("validator count is less than 1")
 	}
@@ -39,6 +72,21 @@ func ValidateResultsDir(dir string, validatorCount int, ownerAddress common.Addr
 	if err != nil {
 		return fmt.Errorf("failed to open results directory: %w", err)
 	}
+	if results.Summary != nil {
+		if !reflect.DeepEqual(results.Summary.Nonces, nonces) {
+			return fmt.Errorf("summary nonces %v do not match expected nonces %v", results.Summary.Nonces, nonces)
+		}
+		for _, failed := range results.Summary.Failed {
+			if slices.Contains(nonces, failed.Nonce) {
+				return fmt.Errorf("nonce %d is marked as failed at summary", failed.Nonce)
+			}
+		}
+		for _, pending := range results.Summary.Pending {
+			if slices.Contains(nonces, pending) {
+				return fmt.Errorf("nonce %d is marked as pending at summary", pending)
+			}
+		}
+	}
 	if len(results.Validators) != validatorCount {
 		return fmt.Errorf("unexpected number of validators: %d", len(results.Validators))
 	}
@@ -50,9 +98,8 @@ func ValidateResultsDir(dir string, validatorCount int, ownerAddress common.Addr
 	}
 
 	// Load validator data.
-	currentNonce := ownerNonce
 	for i, validator := range results.Validators {
-		if validator.Nonce != currentNonce {
+		if validator.Nonce != nonces[i] {
 			return fmt.Errorf("unexpected nonce: %d", validator.Nonce)
 		}
 		if len(validator.DepositData) != 1 {
@@ -104,8 +151,6 @@ func ValidateResultsDir(dir string, validatorCount int, ownerAddress common.Addr
 				return fmt.Errorf("validator proofs does not match aggregated proofs: %w", err)
 			}
 		}
-
-		currentNonce++
 	}
 
 	// Check that there are no other directories (ignoring the aggregated data).
@@ -119,7 +164,7 @@ func ValidateResultsDir(dir string, validatorCount int, ownerAddress common.Addr
 			if isSystemFile(entry.Name()) {
 				continue
 			}
-			if entry.Name() == "deposit_data.json" || entry.Name() == "keyshares.json" || entry.Name() == "proofs.json" {
+			if entry.Name() == "deposit_data.json" || entry.Name() == "keyshares.json" || entry.Name() == "proofs.json" || entry.Name() == SummaryFile {
 				continue
 			}
 			return fmt.Errorf("unexpected file in directory: %s", entry.Name())
@@ -144,7 +189,7 @@ func ValidateResultsDir(dir string, validatorCount int, ownerAddress common.Addr
 		aggregatedKeyShares.Shares = append(aggregatedKeyShares.Shares, validator.KeyShares.Shares[0])
 		aggregatedProofs = append(aggregatedProofs, validator.Proofs)
 	}
-	return ValidateResults(aggregatedDepositData, aggregatedKeyShares, aggregatedProofs, validatorCount, ownerAddress, ownerNonce, withdrawAddress)
+	return ValidateResultsWithNonces(aggregatedDepositData, aggregatedKeyShares, aggregatedProofs, nonces, ownerAddress, withdraw)
 }
 
 var regexpValidatorDir = regexp.MustCompile(`^(\d+)-0x([0-9a-f]{96})$`)
@@ -166,6 +211,13 @@ func OpenResultsDir(dir string) (*ResultsDir, error) {
 				foundAggregations = true
 				continue
 			}
+			if file.Name() == SummaryFile {
+				results.Summary = &Summary{}
+				if err := loadJSONFile(filepath.Join(dir, SummaryFile), results.Summary); err != nil {
+					return nil, fmt.Errorf("failed to load summary: %w", err)
+				}
+				continue
+			}
 			return nil, fmt.Errorf("unexpected file in directory: %s", file.Name())
 		}
 
@@ -118,7 +118,7 @@ func TestValidateResultsDir(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.path, func(t *testing.T) {
-			err := ValidateResultsDir(test.path, test.validatorCount, test.ownerAddress, test.ownerNonce, test.withdrawAddress)
+			err := ValidateResultsDir(test.path, test.validatorCount, test.ownerAddress, test.ownerNonce, test.withdrawAddress.Bytes())
 			if test.expectedErr != "" {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), test.expectedErr)
@@ -128,3 +128,71 @@ func TestValidateResultsDir(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateResultsDirWithNonces(t *testing.T) {
+	ownerAddress := common.HexToAddress("0x5cc0dde14e7256340cc820415a6022a7d1c93a35")
+	withdrawAddress := common.HexToAddress("0x5cC0DdE14E7256340CC820415a6022a7d1c93A35")
+	tests := []struct {
+		path        string
+		nonces      []uint64
+		expectedErr string
+	}{
+		{
+			path:   "testdata/results--partial",
+			nonces: []uint64{2731, 2733},
+		},
+		{
+			path:   "testdata/results--valid-3",
+			nonces: []uint64{2731, 2732, 2733},
+		},
+		{
+			path:   "testdata/results--contiguous",
+			nonces: []uint64{2731},
+		},
+		{
+			path:        "testdata/results--contiguous",
+			nonces:      []uint64{2731, 2733},
+			expectedErr: "summary nonces [2731] do not match expected nonces [2731 2733]",
+		},
+		{
+			path:        "testdata/results--partial",
+			nonces:      []uint64{2731, 2732},
+			expectedErr: "summary nonces [2731 2733] do not match expected nonces [2731 2732]",
+		},
+		{
+			path:        "testdata/results--valid-3",
+			nonces:      []uint64{2731, 2733},
+			expectedErr: "unexpected number of validators: 3",
+		},
+		{
+			path:        "testdata/results--valid-3",
+			nonces:      []uint64{2731, 2732, 2734},
+			expectedErr: "unexpected nonce: 2733",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			err := ValidateResultsDirWithNonces(test.path, test.nonces, ownerAddress, withdrawAddress.Bytes())
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+	t.Run("test partial results with contiguous nonces", func(t *testing.T) {
+		err := ValidateResultsDir("testdata/results--partial", 2, ownerAddress, 2731, withdrawAddress.Bytes())
+		require.ErrorContains(t, err, "summary nonces [2731 2733] do not match expected nonces [2731 2732]")
+	})
+	t.Run("test summary", func(t *testing.T) {
+		results, err := OpenResultsDir("testdata/results--partial")
+		require.NoError(t, err)
+		require.NotNil(t, results.Summary)
+		require.Len(t, results.Summary.Failed, 1)
+		require.Equal(t, uint64(2732), results.Summary.Failed[0].Nonce)
+		results, err = OpenResultsDir("testdata/results--contiguous")
+		require.NoError(t, err)
+		require.Equal(t, []uint64{2733}, results.Summary.Pending)
+		require.NoError(t, ValidateResultsDir("testdata/results--contiguous", 1, ownerAddress, 2731, withdrawAddress.Bytes()))
+	})
+}
@@ -23,20 +23,45 @@ func ValidateResults(
 	expectedValidatorCount int,
 	expectedOwnerAddress common.Address,
 	expectedOwnerNonce uint64,
-	expectedWithdrawAddress common.Address,
+	expectedWithdraw []byte,
 ) error {
 	if expectedValidatorCount < 1 {
 		return fmt.Errorf("validator count is less than 1")
 	}
+	return ValidateResultsWithNonces(allDepositData, allKeyshares, allProofs, ContiguousNonces(expectedOwnerNonce, expectedValidatorCount), expectedOwnerAddress, expectedWithdraw)
+}
+
+// ValidateResultsWithNonces validates results of validators created with the explicit list of owner nonces,
+// i.e. results of a batch where some of the ceremonies failed. Results should be ordered by nonce.
+// Expected withdraw is a withdrawal address, a BLS withdrawal public key or the exact withdrawal credentials.
+func ValidateResultsWithNonces(
+	allDepositData []*wire.DepositDataCLI,
+	allKeyshares *wire.KeySharesCLI,
+	allProofs [][]*wire.SignedProof,
+	expectedNonces []uint64,
+	expectedOwnerAddress common.Address,
+	expectedWithdraw []byte,
+) error {
+	if len(expectedNonces) < 1 {
+		return fmt.Errorf("validator count is less than 1")
+	}
+	if !sort.SliceIsSorted(expectedNonces, func(i, j int) bool { return expectedNonces[i] < expectedNonces[j] }) {
+		return fmt.Errorf("nonces are not ordered")
+	}
+	for i := 1; i < len(expectedNonces); i++ {
+		if expectedNonces[i] == expectedNonces[i-1] {
+			return fmt.Errorf("duplicate nonce %d", expectedNonces[i])
+		}
+	}
 
 	// check len or files
 	if len(allDepositData) != len(allKeyshares.Shares) || len(allDepositData) != len(allProofs) {
 		return fmt.Errorf("inconsistent number of entries in deposit-data, keyshares and proofs")
 	}
-	if len(allDepositData) != expectedValidatorCount {
+	if len(allDepositData) != len(expectedNonces) {
 		return fmt.Errorf("unexpected number of validators: %d", len(allDepositData))
 	}
-	if expectedWithdrawAddress == (common.Address{}) {
+	if len(bytes.Trim(expectedWithdraw, "\x00")) == 0 {
 		return fmt.Errorf("withdraw address is empty")
 	}
 	if err := checkValidatorsCorrectAtDeposits(allDepositData); err != nil {
@@ -53,8 +78,7 @@ func ValidateResults(
 	}
 
 	// validate crypto and make sure validator correct everywhere
-	nonce := expectedOwnerNonce
-	for i := 0; i < expectedValidatorCount; i++ {
+	for i, nonce := range expectedNonces {
 		// make sure fields are same across files
 		keyshares := allKeyshares.Shares[i]
 		depositData := allDepositData[i]
@@ -62,7 +86,7 @@ func ValidateResults(
 		if depositData.PubKey != strings.TrimPrefix(keyshares.Payload.PublicKey, "0x") {
 			return fmt.Errorf("validator doesnt match: %s in deposit-data, %s in keyshares", depositData.PubKey, strings.TrimPrefix(keyshares.Payload.PublicKey, "0x"))
 		}
-		err := crypto.ValidateDepositDataCLI(depositData, expectedWithdrawAddress)
+		err := crypto.ValidateDepositDataCLIWithdrawal(depositData, expectedWithdraw)
 		if err != nil {
 			return fmt.Errorf("err validating deposit data %w", err)
 		}
@@ -79,11 +103,23 @@ func ValidateResults(
 		if err != nil {
 			return fmt.Errorf("err validating proofs %w", err)
 		}
-		nonce++
+		err = validateSharePubKeys(keyshares.Operators, proofs)
+		if err != nil {
+			return fmt.Errorf("err validating share public keys %w", err)
+		}
 	}
 	return nil
 }
 
+// ContiguousNonces returns a list of count nonces incrementing by 1 starting at the nonce
+func ContiguousNonces(nonce uint64, count int) []uint64 {
+	nonces := make([]uint64, 0, count)
+	for i := 0; i < count; i++ {
+		nonces = append(nonces, nonce+uint64(i))
+	}
+	return nonces
+}
+
 func checkValidatorsCorrectAtDeposits(depositDataArr []*wire.DepositDataCLI) error {
 	pubkeys := map[string]struct{}{}
 	for _, dep := range depositDataArr {
@@ -139,6 +175,24 @@ func validateSignedProofs(keyshare *wire.KeySharesCLI, proofs []*wire.SignedProo
 	return nil
 }
 
+// validateSharePubKeys checks that share public keys at proofs are the public polynomial of the validator key evaluated
+// at operator indexes. The polynomial is recovered from share public keys at the lowest threshold they agree on,
+// which should be a majority of operators.
+func validateSharePubKeys(operators []*wire.Operator, proofs []*wire.SignedProof) error {
+	proofsMap := make(map[*wire.Operator]wire.SignedProof, len(proofs))
+	for i, op := range operators {
+		proofsMap[op] = *proofs[i]
+	}
+	threshold, err := spec.ProofsThreshold(proofs[0].Proof.ValidatorPubKey, proofsMap)
+	if err != nil {
+		return err
+	}
+	if threshold < spec.MinCustomThreshold(len(operators)) {
+		return fmt.Errorf("threshold %d of share public keys is below majority of %d operators", threshold, len(operators))
+	}
+	return nil
+}
+
 func ValidateKeyshare(keyshare *wire.KeySharesCLI, expectedValidatorPubkey, expectedOwnerAddress string, expectedOwnerNonce uint64) error {
 	if keyshare.CreatedAt.String() == "" {
 		return fmt.Errorf("keyshares creation time is empty")
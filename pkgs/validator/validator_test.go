@@ -12,6 +12,7 @@ import (
 
 	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+	"github.com/bloxapp/ssv-dkg/spec/testing/fixtures"
 )
 
 func TestKeysharesJSON(t *testing.T) {
@@ -117,3 +118,28 @@ func TestDepositDataJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSharePubKeys(t *testing.T) {
+	proofs := func() []*wire.SignedProof {
+		return []*wire.SignedProof{
+			&fixtures.TestOperator1Proof4Operators,
+			&fixtures.TestOperator2Proof4Operators,
+			&fixtures.TestOperator3Proof4Operators,
+			&fixtures.TestOperator4Proof4Operators,
+		}
+	}
+	operators := fixtures.GenerateOperators(4)
+	require.NoError(t, validateSharePubKeys(operators, proofs()))
+
+	// share public keys of operators 3 and 4 are swapped, so they are on a polynomial of 4 operators threshold only
+	swapped := proofs()
+	swapped[2], swapped[3] = swapped[3], swapped[2]
+	require.EqualError(t, validateSharePubKeys(operators, swapped), "validator public key doesnt match public polynomial of share public keys")
+
+	// share public keys of all operators are the validator public key
+	same := proofs()
+	for i := range same {
+		same[i] = &wire.SignedProof{Proof: &wire.Proof{ValidatorPubKey: same[0].Proof.ValidatorPubKey, SharePubKey: same[0].Proof.ValidatorPubKey}}
+	}
+	require.EqualError(t, validateSharePubKeys(operators, same), "threshold 1 of share public keys is below majority of 4 operators")
+}
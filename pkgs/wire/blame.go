@@ -0,0 +1,33 @@
+package wire
+
+// Evidence is a message signed by an operator which proves its part in a failed ceremony
+type Evidence struct {
+	OperatorID uint64 `json:"operator_id"` // operator which signed the message
+	Type       string `json:"type"`        // message type
+	Message    []byte `json:"message"`     // signed message, an encoded kyber bundle signed by the operator DKG key or an SSZ encoded signed transport
+}
+
+// Blame is a report of an operator about a failed kyber protocol, it is sent to initiator as BlameMessageType signed transport
+type Blame struct {
+	OperatorID uint64      `json:"operator_id"` // operator which reports the failure
+	Phase      string      `json:"phase"`       // deal, response or justification
+	Offenders  []uint64    `json:"offenders"`   // IDs of operators which caused the failure
+	Reason     string      `json:"reason"`
+	Evidence   []*Evidence `json:"evidence,omitempty"`
+}
+
+// SignedBlame is a blame of an operator received by initiator
+type SignedBlame struct {
+	Blame   *Blame `json:"blame"`
+	Message []byte `json:"message"` // SSZ encoded signed transport carrying the blame, verifiable with the operator public key
+}
+
+// BlameReport of a failed ceremony is saved by initiator next to the ceremony output
+type BlameReport struct {
+	RequestID string         `json:"request_id"` // hex encoded request ID
+	Phase     string         `json:"phase"`      // phase of the ceremony which failed
+	Offenders []uint64       `json:"offenders"`  // IDs of operators which caused the failure
+	Reason    string         `json:"reason"`
+	Evidence  []*Evidence    `json:"evidence,omitempty"` // operator messages relayed by initiator which prove the failure
+	Blames    []*SignedBlame `json:"blames,omitempty"`   // blames of operators which kyber protocol failed
+}
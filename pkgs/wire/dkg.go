@@ -2,7 +2,6 @@ package wire
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/drand/kyber/share/dkg"
 	"go.uber.org/zap"
@@ -28,13 +27,17 @@ func (l *LogWrapper) Error(vals ...interface{}) {
 }
 
 // NewDKGProtocol initializes and starts phases of the DKG protocol
-func NewDKGProtocol(dkgConfig *dkg.Config, b dkg.Board, logger *zap.Logger) (*dkg.Protocol, error) {
+func NewDKGProtocol(dkgConfig *dkg.Config, b dkg.Board, phaser Phaser, logger *zap.Logger) (*dkg.Protocol, error) {
 	dkgLogger := New(logger)
 	dkgConfig.Log = dkgLogger
+	// Initiator relays every round to all operators at once, so operators move to the next phase
+	// as soon as messages of all operators are received. Share holders send responses for valid deals too,
+	// so that each operator answers the deal round with a response bundle.
+	dkgConfig.FastSync = true
 	// Phaser must signal on its channel when the protocol should move to a next
 	// phase. Phase must be sequential: DealPhase (start), ResponsePhase,
-	// JustifPhase and then FinishPhase.
-	phaser := dkg.NewTimePhaser(time.Second * 10)
+	// JustifPhase and then FinishPhase. With FastSync the phaser moves the protocol
+	// on only if messages of some operators are late or invalid, i.e. a late deal results in a complaint.
 	ret, err := dkg.NewProtocol(
 		dkgConfig,
 		b,
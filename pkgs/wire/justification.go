@@ -88,6 +88,6 @@ func DecodeJustificationBundle(byts []byte, suite dkg.Suite) (*dkg.Justification
 		DealerIndex:    res.DealerIndex,
 		Justifications: justifications,
 		SessionID:      res.SessionID,
-		Signature:      res.SessionID,
+		Signature:      res.Signature,
 	}, nil
 }
@@ -0,0 +1,124 @@
+package wire
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/drand/kyber/share/dkg"
+)
+
+// DefaultPhaserTimeout is the default duration of a phase of the kyber protocol. Initiator relays a round
+// after it collects bundles of all operators, so at large batches a phase takes longer than a network round trip.
+const DefaultPhaserTimeout = time.Minute
+
+// PhaserType selects how the kyber protocol moves to the next phase
+type PhaserType string
+
+const (
+	// EventPhaserType moves to the next phase when all bundles of the phase are received, or when the phase times out
+	EventPhaserType PhaserType = "event"
+	// TimePhaserType moves to the next phase when the fixed phase timer fires
+	TimePhaserType PhaserType = "time"
+)
+
+// ParsePhaserType returns a phaser type by name, event phaser is the default
+func ParsePhaserType(name string) (PhaserType, error) {
+	switch PhaserType(name) {
+	case "", EventPhaserType:
+		return EventPhaserType, nil
+	case TimePhaserType:
+		return TimePhaserType, nil
+	default:
+		return EventPhaserType, fmt.Errorf("unknown phaser %s", name)
+	}
+}
+
+// PhaserConfig configures the phaser of the kyber protocol at an operator
+type PhaserConfig struct {
+	Type    PhaserType
+	Timeout time.Duration // DefaultPhaserTimeout if not set
+}
+
+// Phaser signals the kyber protocol to move to a next phase. Phaser is started with the protocol
+// and is notified when all bundles of a phase are received.
+type Phaser interface {
+	dkg.Phaser
+	Start()
+	Received(phase dkg.Phase)
+}
+
+// NewPhaser creates a phaser by the config
+func NewPhaser(cfg PhaserConfig) Phaser {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultPhaserTimeout
+	}
+	if cfg.Type == TimePhaserType {
+		return &timePhaser{dkg.NewTimePhaser(timeout)}
+	}
+	return NewEventPhaser(timeout)
+}
+
+// timePhaser ignores received bundles, phases are moved by the fixed timer
+type timePhaser struct {
+	*dkg.TimePhaser
+}
+
+func (t *timePhaser) Received(dkg.Phase) {}
+
+// EventPhaser moves the protocol to the next phase as soon as all bundles of the current phase are received.
+// If some bundles are late, the phase times out after the timeout measured from the start of the phase.
+type EventPhaser struct {
+	timeout  time.Duration
+	out      chan dkg.Phase
+	received chan dkg.Phase
+}
+
+// NewEventPhaser creates an event phaser with the phase timeout
+func NewEventPhaser(timeout time.Duration) *EventPhaser {
+	return &EventPhaser{
+		timeout:  timeout,
+		out:      make(chan dkg.Phase, 4),
+		received: make(chan dkg.Phase, 4),
+	}
+}
+
+// Start signals the deal phase and then each next phase when the previous one is completed or times out
+func (e *EventPhaser) Start() {
+	completed := dkg.InitPhase
+	e.out <- dkg.DealPhase
+	for _, next := range []dkg.Phase{dkg.ResponsePhase, dkg.JustifPhase, dkg.FinishPhase} {
+		completed = e.wait(next-1, completed)
+		e.out <- next
+	}
+}
+
+// wait returns when the phase is completed or times out, completed is the latest phase which bundles are all received
+func (e *EventPhaser) wait(phase, completed dkg.Phase) dkg.Phase {
+	timer := time.NewTimer(e.timeout)
+	defer timer.Stop()
+	for completed < phase {
+		select {
+		case <-timer.C:
+			return completed
+		case p := <-e.received:
+			if p > completed {
+				completed = p
+			}
+		}
+	}
+	return completed
+}
+
+// NextPhase implements a kyber Phaser interface function
+func (e *EventPhaser) NextPhase() chan dkg.Phase {
+	return e.out
+}
+
+// Received notifies the phaser that all bundles of the phase are received
+func (e *EventPhaser) Received(phase dkg.Phase) {
+	select {
+	case e.received <- phase:
+	default:
+	}
+}
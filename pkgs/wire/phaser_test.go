@@ -0,0 +1,61 @@
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drand/kyber/share/dkg"
+	"github.com/stretchr/testify/require"
+)
+
+func nextPhase(t *testing.T, p Phaser, within time.Duration) dkg.Phase {
+	select {
+	case phase := <-p.NextPhase():
+		return phase
+	case <-time.After(within):
+		t.Fatalf("phaser didnt move to the next phase in %s", within)
+		return dkg.InitPhase
+	}
+}
+
+func TestEventPhaser(t *testing.T) {
+	t.Run("moves when bundles are received", func(t *testing.T) {
+		p := NewEventPhaser(time.Minute)
+		go p.Start()
+		require.Equal(t, dkg.DealPhase, nextPhase(t, p, time.Second))
+		for _, phase := range []dkg.Phase{dkg.DealPhase, dkg.ResponsePhase, dkg.JustifPhase} {
+			p.Received(phase)
+			require.Equal(t, phase+1, nextPhase(t, p, time.Second))
+		}
+	})
+	t.Run("phase times out", func(t *testing.T) {
+		p := NewEventPhaser(50 * time.Millisecond)
+		go p.Start()
+		require.Equal(t, dkg.DealPhase, nextPhase(t, p, time.Second))
+		p.Received(dkg.DealPhase)
+		require.Equal(t, dkg.ResponsePhase, nextPhase(t, p, time.Second))
+		start := time.Now()
+		require.Equal(t, dkg.JustifPhase, nextPhase(t, p, time.Second))
+		require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+		require.Equal(t, dkg.FinishPhase, nextPhase(t, p, time.Second))
+	})
+	t.Run("later phase completes earlier phases", func(t *testing.T) {
+		p := NewEventPhaser(time.Minute)
+		go p.Start()
+		p.Received(dkg.JustifPhase)
+		for _, phase := range []dkg.Phase{dkg.DealPhase, dkg.ResponsePhase, dkg.JustifPhase, dkg.FinishPhase} {
+			require.Equal(t, phase, nextPhase(t, p, time.Second))
+		}
+	})
+}
+
+func TestParsePhaserType(t *testing.T) {
+	phaser, err := ParsePhaserType("")
+	require.NoError(t, err)
+	require.Equal(t, EventPhaserType, phaser)
+	phaser, err = ParsePhaserType("time")
+	require.NoError(t, err)
+	require.Equal(t, TimePhaserType, phaser)
+	_, err = ParsePhaserType("block")
+	require.EqualError(t, err, "unknown phaser block")
+}
@@ -0,0 +1,16 @@
+package wire
+
+import "time"
+
+// CeremonyStatus is a state of a ceremony instance at operator returned by the ceremonies status API
+type CeremonyStatus struct {
+	ID           string    `json:"id"`           // hex encoded request ID
+	Type         string    `json:"type"`         // dkg or reshare
+	Phase        string    `json:"phase"`        // init, exchange, deal, response, justification, result, completed or failed
+	Participants []uint64  `json:"participants"` // IDs of operators participating in the ceremony
+	Exchanges    []uint64  `json:"exchanges"`    // IDs of operators which exchange messages were received
+	StartedDKG   bool      `json:"started_dkg"`
+	LastError    string    `json:"last_error,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	Age          string    `json:"age"`
+}
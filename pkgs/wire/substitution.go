@@ -0,0 +1,11 @@
+package wire
+
+// Substitution of an operator which didnt respond to the init message of a DKG ceremony by a standby operator
+type Substitution struct {
+	Nonce     uint64 `json:"nonce"`      // owner nonce of the ceremony
+	RequestID string `json:"request_id"` // hex encoded request ID of the failed attempt, the ceremony is started again with a new request ID
+	Offline   uint64 `json:"offline"`    // ID of the operator which didnt respond
+	Standby   uint64 `json:"standby"`    // ID of the standby operator which replaced it
+	// OperatorIDs of the ceremony started again, key shares of the validator belong to operators of the last substitution of the nonce
+	OperatorIDs []uint64 `json:"operator_ids"`
+}
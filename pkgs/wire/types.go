@@ -13,7 +13,7 @@ type SSZMarshaller interface {
 }
 type MultipleSignedTransports struct {
 	Identifier [24]byte           `ssz-size:"24"` // this is kinda wasteful, maybe take it out of the msgs?
-	Messages   []*SignedTransport `ssz-max:"13"`  // max num of operators
+	Messages   []*SignedTransport `ssz-max:"128"` // max num of operators at InitV2, or old and new operators at resharing
 	Signature  []byte             `ssz-max:"2048"`
 }
 
@@ -36,6 +36,16 @@ const (
 	PingMessageType
 	PongMessageType
 	ResultMessageType
+	ReshareMessageType
+	ReshareAckMessageType
+	ResignMessageType
+	ExitMessageType
+	PartialExitMessageType
+	InitV2MessageType
+	ReshareV2MessageType
+	ResignV2MessageType
+	ExitV2MessageType
+	BlameMessageType
 )
 
 func (t TransportType) String() string {
@@ -64,6 +74,26 @@ func (t TransportType) String() string {
 		return "PongMessageType"
 	case ResultMessageType:
 		return "ResultMessageType"
+	case ReshareMessageType:
+		return "ReshareMessageType"
+	case ReshareAckMessageType:
+		return "ReshareAckMessageType"
+	case ResignMessageType:
+		return "ResignMessageType"
+	case ExitMessageType:
+		return "ExitMessageType"
+	case PartialExitMessageType:
+		return "PartialExitMessageType"
+	case InitV2MessageType:
+		return "InitV2MessageType"
+	case ReshareV2MessageType:
+		return "ReshareV2MessageType"
+	case ResignV2MessageType:
+		return "ResignV2MessageType"
+	case ExitV2MessageType:
+		return "ExitV2MessageType"
+	case BlameMessageType:
+		return "BlameMessageType"
 	default:
 		return "no type impl"
 	}
@@ -84,7 +114,7 @@ type SignedTransport struct {
 
 type KyberMessage struct {
 	Type TransportType
-	Data []byte `ssz-max:"4096"`
+	Data []byte `ssz-max:"65536"` // 2^16, deal bundles grow with the number of operators
 }
 
 type Operator struct {
@@ -92,12 +122,42 @@ type Operator struct {
 	PubKey []byte `ssz-max:"2048"`
 }
 
+const (
+	// MaxInitOperators is the max number of operators at Init message
+	MaxInitOperators = 13
+	// MaxInitV2Operators is the max number of operators at InitV2 message and V2 reshare, resign and exit messages
+	MaxInitV2Operators = 64
+)
+
 type Init struct {
 	// Operators involved in the DKG
 	Operators []*Operator `ssz-max:"13"`
 	// T is the threshold for signing
 	T uint64
-	// WithdrawalCredentials for deposit data
+	// WithdrawalCredentials is the withdrawal address for 0x01 and 0x02 withdrawal credentials,
+	// or full 0x00 withdrawal credentials of a BLS withdrawal key
+	WithdrawalCredentials []byte `ssz-max:"32"`
+	// Fork ethereum fork for signing
+	Fork [4]byte `ssz-size:"4"`
+	// Owner address
+	Owner [20]byte `ssz-size:"20"`
+	// Owner nonce
+	Nonce uint64
+	// WithdrawalPrefix is the withdrawal credentials type: 0x00 for a BLS withdrawal key, 0x01, or 0x02 for compounding validators
+	WithdrawalPrefix uint8
+	// Amount to deposit in Gwei
+	Amount uint64
+}
+
+// InitV2 is an Init message for clusters larger than MaxInitOperators. It is sent
+// as InitV2MessageType, so operators which dont support larger clusters reject it by type.
+type InitV2 struct {
+	// Operators involved in the DKG
+	Operators []*Operator `ssz-max:"64"`
+	// T is the threshold for signing
+	T uint64
+	// WithdrawalCredentials is the withdrawal address for 0x01 and 0x02 withdrawal credentials,
+	// or full 0x00 withdrawal credentials of a BLS withdrawal key
 	WithdrawalCredentials []byte `ssz-max:"32"`
 	// Fork ethereum fork for signing
 	Fork [4]byte `ssz-size:"4"`
@@ -105,6 +165,38 @@ type Init struct {
 	Owner [20]byte `ssz-size:"20"`
 	// Owner nonce
 	Nonce uint64
+	// WithdrawalPrefix is the withdrawal credentials type: 0x00 for a BLS withdrawal key, 0x01, or 0x02 for compounding validators
+	WithdrawalPrefix uint8
+	// Amount to deposit in Gwei
+	Amount uint64
+}
+
+// ToV2 converts init message to InitV2
+func (i *Init) ToV2() *InitV2 {
+	return &InitV2{
+		Operators:             i.Operators,
+		T:                     i.T,
+		WithdrawalCredentials: i.WithdrawalCredentials,
+		Fork:                  i.Fork,
+		Owner:                 i.Owner,
+		Nonce:                 i.Nonce,
+		WithdrawalPrefix:      i.WithdrawalPrefix,
+		Amount:                i.Amount,
+	}
+}
+
+// ToInit converts InitV2 message to Init, the operators list isnt limited by MaxInitOperators in memory
+func (i *InitV2) ToInit() *Init {
+	return &Init{
+		Operators:             i.Operators,
+		T:                     i.T,
+		WithdrawalCredentials: i.WithdrawalCredentials,
+		Fork:                  i.Fork,
+		Owner:                 i.Owner,
+		Nonce:                 i.Nonce,
+		WithdrawalPrefix:      i.WithdrawalPrefix,
+		Amount:                i.Amount,
+	}
 }
 
 type Reshare struct {
@@ -130,6 +222,382 @@ type SignedReshare struct {
 	Signature []byte `ssz-max:"1536"` // 64 * 24
 }
 
+// ReshareMessage is sent by initiator to old and new operators to start a resharing ceremony
+type ReshareMessage struct {
+	// SignedReshare is a reshare message signed by the validator owner
+	SignedReshare *SignedReshare
+	// Proofs of the previous ceremony ordered the same way as old operators
+	Proofs []*SignedProof `ssz-max:"13"`
+	// WithdrawalCredentials for deposit data
+	WithdrawalCredentials []byte `ssz-max:"32"`
+	// Fork ethereum fork for signing
+	Fork [4]byte `ssz-size:"4"`
+	// WithdrawalPrefix is the withdrawal credentials type: 0x01, or 0x02 for compounding validators
+	WithdrawalPrefix uint8
+	// Amount to deposit in Gwei
+	Amount uint64
+}
+
+// ReshareV2 is a Reshare message for clusters larger than MaxInitOperators
+type ReshareV2 struct {
+	// ValidatorPubKey public key corresponding to the shared private key
+	ValidatorPubKey []byte `ssz-size:"48"`
+	// Operators involved in the DKG
+	OldOperators []*Operator `ssz-max:"64"`
+	// Operators involved in the resharing
+	NewOperators []*Operator `ssz-max:"64"`
+	// OldT is the old threshold for signing
+	OldT uint64
+	// NewT is the old threshold for signing
+	NewT uint64
+	// Owner address
+	Owner [20]byte `ssz-size:"20"`
+	// Owner nonce
+	Nonce uint64
+}
+
+type SignedReshareV2 struct {
+	Reshare ReshareV2
+	// Signature is an ECDSA signature over proof
+	Signature []byte `ssz-max:"1536"` // 64 * 24
+}
+
+// ReshareMessageV2 is a ReshareMessage for clusters larger than MaxInitOperators. It is sent
+// as ReshareV2MessageType, so operators which dont support larger clusters reject it by type.
+type ReshareMessageV2 struct {
+	// SignedReshare is a reshare message signed by the validator owner
+	SignedReshare *SignedReshareV2
+	// Proofs of the previous ceremony ordered the same way as old operators
+	Proofs []*SignedProof `ssz-max:"64"`
+	// WithdrawalCredentials for deposit data
+	WithdrawalCredentials []byte `ssz-max:"32"`
+	// Fork ethereum fork for signing
+	Fork [4]byte `ssz-size:"4"`
+	// WithdrawalPrefix is the withdrawal credentials type: 0x01, or 0x02 for compounding validators
+	WithdrawalPrefix uint8
+	// Amount to deposit in Gwei
+	Amount uint64
+}
+
+// IsV2 returns true if the reshare message has more operators than MaxInitOperators and is sent as ReshareMessageV2
+func (r *Reshare) IsV2() bool {
+	return len(r.OldOperators) > MaxInitOperators || len(r.NewOperators) > MaxInitOperators
+}
+
+// SigningRoot returns the root signed by the validator owner, the root of ReshareV2 for larger clusters
+func (r *Reshare) SigningRoot() ([32]byte, error) {
+	if r.IsV2() {
+		return r.ToV2().HashTreeRoot()
+	}
+	return r.HashTreeRoot()
+}
+
+// ToV2 converts reshare message to ReshareV2
+func (r *Reshare) ToV2() *ReshareV2 {
+	return &ReshareV2{
+		ValidatorPubKey: r.ValidatorPubKey,
+		OldOperators:    r.OldOperators,
+		NewOperators:    r.NewOperators,
+		OldT:            r.OldT,
+		NewT:            r.NewT,
+		Owner:           r.Owner,
+		Nonce:           r.Nonce,
+	}
+}
+
+// ToReshare converts ReshareV2 message to Reshare, the operators lists arent limited by MaxInitOperators in memory
+func (r *ReshareV2) ToReshare() *Reshare {
+	return &Reshare{
+		ValidatorPubKey: r.ValidatorPubKey,
+		OldOperators:    r.OldOperators,
+		NewOperators:    r.NewOperators,
+		OldT:            r.OldT,
+		NewT:            r.NewT,
+		Owner:           r.Owner,
+		Nonce:           r.Nonce,
+	}
+}
+
+// ToV2 converts reshare message sent to operators to ReshareMessageV2
+func (m *ReshareMessage) ToV2() *ReshareMessageV2 {
+	msg := &ReshareMessageV2{
+		Proofs:                m.Proofs,
+		WithdrawalCredentials: m.WithdrawalCredentials,
+		Fork:                  m.Fork,
+		WithdrawalPrefix:      m.WithdrawalPrefix,
+		Amount:                m.Amount,
+	}
+	if m.SignedReshare != nil {
+		msg.SignedReshare = &SignedReshareV2{Reshare: *m.SignedReshare.Reshare.ToV2(), Signature: m.SignedReshare.Signature}
+	}
+	return msg
+}
+
+// ToReshareMessage converts ReshareMessageV2 to ReshareMessage
+func (m *ReshareMessageV2) ToReshareMessage() *ReshareMessage {
+	msg := &ReshareMessage{
+		Proofs:                m.Proofs,
+		WithdrawalCredentials: m.WithdrawalCredentials,
+		Fork:                  m.Fork,
+		WithdrawalPrefix:      m.WithdrawalPrefix,
+		Amount:                m.Amount,
+	}
+	if m.SignedReshare != nil {
+		msg.SignedReshare = &SignedReshare{Reshare: *m.SignedReshare.Reshare.ToReshare(), Signature: m.SignedReshare.Signature}
+	}
+	return msg
+}
+
+// Resign is a request to sign a new owner and nonce with an existing validator key
+type Resign struct {
+	// ValidatorPubKey public key corresponding to the shared private key
+	ValidatorPubKey []byte `ssz-size:"48"`
+	// Operators involved in the DKG
+	Operators []*Operator `ssz-max:"13"`
+	// Owner address
+	Owner [20]byte `ssz-size:"20"`
+	// Owner nonce
+	Nonce uint64
+}
+
+type SignedResign struct {
+	Resign Resign
+	// Signature is an ECDSA signature over resign message by the owner at ceremony proofs
+	Signature []byte `ssz-max:"1536"` // 64 * 24
+}
+
+// ResignMessage is sent by initiator to operators to sign a new owner and nonce with their key shares
+type ResignMessage struct {
+	// SignedResign is a resign message signed by the validator owner
+	SignedResign *SignedResign
+	// Proofs of the previous ceremony ordered the same way as operators
+	Proofs []*SignedProof `ssz-max:"13"`
+	// WithdrawalCredentials for deposit data
+	WithdrawalCredentials []byte `ssz-max:"32"`
+	// Fork ethereum fork for signing
+	Fork [4]byte `ssz-size:"4"`
+	// WithdrawalPrefix is the withdrawal credentials type: 0x01, or 0x02 for compounding validators
+	WithdrawalPrefix uint8
+	// Amount to deposit in Gwei
+	Amount uint64
+}
+
+// ResignV2 is a Resign message for clusters larger than MaxInitOperators
+type ResignV2 struct {
+	// ValidatorPubKey public key corresponding to the shared private key
+	ValidatorPubKey []byte `ssz-size:"48"`
+	// Operators involved in the DKG
+	Operators []*Operator `ssz-max:"64"`
+	// Owner address
+	Owner [20]byte `ssz-size:"20"`
+	// Owner nonce
+	Nonce uint64
+}
+
+type SignedResignV2 struct {
+	Resign ResignV2
+	// Signature is an ECDSA signature over resign message by the owner at ceremony proofs
+	Signature []byte `ssz-max:"1536"` // 64 * 24
+}
+
+// ResignMessageV2 is a ResignMessage for clusters larger than MaxInitOperators, sent as ResignV2MessageType
+type ResignMessageV2 struct {
+	// SignedResign is a resign message signed by the validator owner
+	SignedResign *SignedResignV2
+	// Proofs of the previous ceremony ordered the same way as operators
+	Proofs []*SignedProof `ssz-max:"64"`
+	// WithdrawalCredentials for deposit data
+	WithdrawalCredentials []byte `ssz-max:"32"`
+	// Fork ethereum fork for signing
+	Fork [4]byte `ssz-size:"4"`
+	// WithdrawalPrefix is the withdrawal credentials type: 0x01, or 0x02 for compounding validators
+	WithdrawalPrefix uint8
+	// Amount to deposit in Gwei
+	Amount uint64
+}
+
+// IsV2 returns true if the resign message has more operators than MaxInitOperators and is sent as ResignMessageV2
+func (r *Resign) IsV2() bool {
+	return len(r.Operators) > MaxInitOperators
+}
+
+// SigningRoot returns the root signed by the validator owner, the root of ResignV2 for larger clusters
+func (r *Resign) SigningRoot() ([32]byte, error) {
+	if r.IsV2() {
+		return r.ToV2().HashTreeRoot()
+	}
+	return r.HashTreeRoot()
+}
+
+// ToV2 converts resign message to ResignV2
+func (r *Resign) ToV2() *ResignV2 {
+	return &ResignV2{
+		ValidatorPubKey: r.ValidatorPubKey,
+		Operators:       r.Operators,
+		Owner:           r.Owner,
+		Nonce:           r.Nonce,
+	}
+}
+
+// ToResign converts ResignV2 message to Resign, the operators list isnt limited by MaxInitOperators in memory
+func (r *ResignV2) ToResign() *Resign {
+	return &Resign{
+		ValidatorPubKey: r.ValidatorPubKey,
+		Operators:       r.Operators,
+		Owner:           r.Owner,
+		Nonce:           r.Nonce,
+	}
+}
+
+// ToV2 converts resign message sent to operators to ResignMessageV2
+func (m *ResignMessage) ToV2() *ResignMessageV2 {
+	msg := &ResignMessageV2{
+		Proofs:                m.Proofs,
+		WithdrawalCredentials: m.WithdrawalCredentials,
+		Fork:                  m.Fork,
+		WithdrawalPrefix:      m.WithdrawalPrefix,
+		Amount:                m.Amount,
+	}
+	if m.SignedResign != nil {
+		msg.SignedResign = &SignedResignV2{Resign: *m.SignedResign.Resign.ToV2(), Signature: m.SignedResign.Signature}
+	}
+	return msg
+}
+
+// ToResignMessage converts ResignMessageV2 to ResignMessage
+func (m *ResignMessageV2) ToResignMessage() *ResignMessage {
+	msg := &ResignMessage{
+		Proofs:                m.Proofs,
+		WithdrawalCredentials: m.WithdrawalCredentials,
+		Fork:                  m.Fork,
+		WithdrawalPrefix:      m.WithdrawalPrefix,
+		Amount:                m.Amount,
+	}
+	if m.SignedResign != nil {
+		msg.SignedResign = &SignedResign{Resign: *m.SignedResign.Resign.ToResign(), Signature: m.SignedResign.Signature}
+	}
+	return msg
+}
+
+// Exit is a request to sign a voluntary exit of the validator with operators key shares
+type Exit struct {
+	// ValidatorPubKey public key corresponding to the shared private key
+	ValidatorPubKey []byte `ssz-size:"48"`
+	// Operators involved in the DKG
+	Operators []*Operator `ssz-max:"13"`
+	// ValidatorIndex index of the validator at the beacon chain
+	ValidatorIndex uint64
+	// Epoch earliest epoch when the exit can be processed
+	Epoch uint64
+	// Fork ethereum fork for signing
+	Fork [4]byte `ssz-size:"4"`
+}
+
+type SignedExit struct {
+	Exit Exit
+	// Signature is an ECDSA signature over exit message by the owner at ceremony proofs
+	Signature []byte `ssz-max:"1536"` // 64 * 24
+}
+
+// ExitMessage is sent by initiator to operators to sign a voluntary exit with their key shares
+type ExitMessage struct {
+	// SignedExit is an exit message signed by the validator owner
+	SignedExit *SignedExit
+	// Proofs of the previous ceremony ordered the same way as operators
+	Proofs []*SignedProof `ssz-max:"13"`
+}
+
+// ExitV2 is an Exit message for clusters larger than MaxInitOperators
+type ExitV2 struct {
+	// ValidatorPubKey public key corresponding to the shared private key
+	ValidatorPubKey []byte `ssz-size:"48"`
+	// Operators involved in the DKG
+	Operators []*Operator `ssz-max:"64"`
+	// ValidatorIndex index of the validator at the beacon chain
+	ValidatorIndex uint64
+	// Epoch earliest epoch when the exit can be processed
+	Epoch uint64
+	// Fork ethereum fork for signing
+	Fork [4]byte `ssz-size:"4"`
+}
+
+type SignedExitV2 struct {
+	Exit ExitV2
+	// Signature is an ECDSA signature over exit message by the owner at ceremony proofs
+	Signature []byte `ssz-max:"1536"` // 64 * 24
+}
+
+// ExitMessageV2 is an ExitMessage for clusters larger than MaxInitOperators, sent as ExitV2MessageType
+type ExitMessageV2 struct {
+	// SignedExit is an exit message signed by the validator owner
+	SignedExit *SignedExitV2
+	// Proofs of the previous ceremony ordered the same way as operators
+	Proofs []*SignedProof `ssz-max:"64"`
+}
+
+// IsV2 returns true if the exit message has more operators than MaxInitOperators and is sent as ExitMessageV2
+func (e *Exit) IsV2() bool {
+	return len(e.Operators) > MaxInitOperators
+}
+
+// SigningRoot returns the root signed by the validator owner, the root of ExitV2 for larger clusters
+func (e *Exit) SigningRoot() ([32]byte, error) {
+	if e.IsV2() {
+		return e.ToV2().HashTreeRoot()
+	}
+	return e.HashTreeRoot()
+}
+
+// ToV2 converts exit message to ExitV2
+func (e *Exit) ToV2() *ExitV2 {
+	return &ExitV2{
+		ValidatorPubKey: e.ValidatorPubKey,
+		Operators:       e.Operators,
+		ValidatorIndex:  e.ValidatorIndex,
+		Epoch:           e.Epoch,
+		Fork:            e.Fork,
+	}
+}
+
+// ToExit converts ExitV2 message to Exit, the operators list isnt limited by MaxInitOperators in memory
+func (e *ExitV2) ToExit() *Exit {
+	return &Exit{
+		ValidatorPubKey: e.ValidatorPubKey,
+		Operators:       e.Operators,
+		ValidatorIndex:  e.ValidatorIndex,
+		Epoch:           e.Epoch,
+		Fork:            e.Fork,
+	}
+}
+
+// ToV2 converts exit message sent to operators to ExitMessageV2
+func (m *ExitMessage) ToV2() *ExitMessageV2 {
+	msg := &ExitMessageV2{Proofs: m.Proofs}
+	if m.SignedExit != nil {
+		msg.SignedExit = &SignedExitV2{Exit: *m.SignedExit.Exit.ToV2(), Signature: m.SignedExit.Signature}
+	}
+	return msg
+}
+
+// ToExitMessage converts ExitMessageV2 to ExitMessage
+func (m *ExitMessageV2) ToExitMessage() *ExitMessage {
+	msg := &ExitMessage{Proofs: m.Proofs}
+	if m.SignedExit != nil {
+		msg.SignedExit = &SignedExit{Exit: *m.SignedExit.Exit.ToExit(), Signature: m.SignedExit.Signature}
+	}
+	return msg
+}
+
+// PartialExit is an operator's partial signature over the voluntary exit
+type PartialExit struct {
+	// Operator ID
+	OperatorID uint64
+	// RequestID for the exit request
+	RequestID [24]byte `ssz-size:"24"`
+	// PartialSignature of the voluntary exit signing root by the operator's key share
+	PartialSignature []byte `ssz-size:"96"`
+}
+
 // Result is the last message in every DKG which marks a specific node's end of process
 type Result struct {
 	// Operator ID
@@ -142,6 +610,8 @@ type Result struct {
 	OwnerNoncePartialSignature []byte `ssz-size:"96"`
 	// Signed proof for the ceremony
 	SignedProof SignedProof
+	// Commits of the public polynomial of the distributed key, compressed G1 points ordered by coefficient
+	Commits []byte `ssz-max:"3072"` // 64 * 48
 }
 
 // Proof for a DKG ceremony
@@ -182,12 +652,12 @@ type Pong struct {
 
 type ResultData struct {
 	// Operators involved in the DKG
-	Operators []*Operator `ssz-max:"13"`
+	Operators []*Operator `ssz-max:"64"`
 	// Initiator public key
 	Identifier    [24]byte `ssz-size:"24"`
 	DepositData   []byte   `ssz-max:"8192"`
-	KeysharesData []byte   `ssz-max:"32768"`
-	Proofs        []byte   `ssz-max:"32768"`
+	KeysharesData []byte   `ssz-max:"262144"` // 2^18
+	Proofs        []byte   `ssz-max:"262144"` // 2^18
 }
 
 // DepositDataCLI  is a deposit structure from the eth2 deposit CLI (https://github.com/ethereum/staking-deposit-cli).
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 8561b624d417b4c2886869ef8647e0899e33e0f0810b9ae8f9d94c5e2014589e
+// Hash: 43bd07b81af6bf53013bf817fd50a4d8fc714f086f61ee970cc3e9a0baa1e555
 // Version: 0.1.3
 package wire
 
@@ -32,8 +32,8 @@ func (m *MultipleSignedTransports) MarshalSSZTo(buf []byte) (dst []byte, err err
 	offset += len(m.Signature)
 
 	// Field (1) 'Messages'
-	if size := len(m.Messages); size > 13 {
-		err = ssz.ErrListTooBigFn("MultipleSignedTransports.Messages", size, 13)
+	if size := len(m.Messages); size > 128 {
+		err = ssz.ErrListTooBigFn("MultipleSignedTransports.Messages", size, 128)
 		return
 	}
 	{
@@ -90,7 +90,7 @@ func (m *MultipleSignedTransports) UnmarshalSSZ(buf []byte) error {
 	// Field (1) 'Messages'
 	{
 		buf = tail[o1:o2]
-		num, err := ssz.DecodeDynamicLength(buf, 13)
+		num, err := ssz.DecodeDynamicLength(buf, 128)
 		if err != nil {
 			return err
 		}
@@ -155,7 +155,7 @@ func (m *MultipleSignedTransports) HashTreeRootWith(hh ssz.HashWalker) (err erro
 	{
 		subIndx := hh.Index()
 		num := uint64(len(m.Messages))
-		if num > 13 {
+		if num > 128 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
@@ -164,7 +164,7 @@ func (m *MultipleSignedTransports) HashTreeRootWith(hh ssz.HashWalker) (err erro
 				return
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 13)
+		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
 
 	// Field (2) 'Signature'
@@ -643,8 +643,8 @@ func (k *KyberMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	offset += len(k.Data)
 
 	// Field (1) 'Data'
-	if size := len(k.Data); size > 4096 {
-		err = ssz.ErrBytesLengthFn("KyberMessage.Data", size, 4096)
+	if size := len(k.Data); size > 65536 {
+		err = ssz.ErrBytesLengthFn("KyberMessage.Data", size, 65536)
 		return
 	}
 	dst = append(dst, k.Data...)
@@ -678,7 +678,7 @@ func (k *KyberMessage) UnmarshalSSZ(buf []byte) error {
 	// Field (1) 'Data'
 	{
 		buf = tail[o1:]
-		if len(buf) > 4096 {
+		if len(buf) > 65536 {
 			return ssz.ErrBytesLength
 		}
 		if cap(k.Data) == 0 {
@@ -715,12 +715,12 @@ func (k *KyberMessage) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	{
 		elemIndx := hh.Index()
 		byteLen := uint64(len(k.Data))
-		if byteLen > 4096 {
+		if byteLen > 65536 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
 		hh.Append(k.Data)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (4096+31)/32)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (65536+31)/32)
 	}
 
 	hh.Merkleize(indx)
@@ -847,7 +847,7 @@ func (i *Init) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the Init object to a target array
 func (i *Init) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(48)
+	offset := int(57)
 
 	// Offset (0) 'Operators'
 	dst = ssz.WriteOffset(dst, offset)
@@ -872,6 +872,12 @@ func (i *Init) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	// Field (5) 'Nonce'
 	dst = ssz.MarshalUint64(dst, i.Nonce)
 
+	// Field (6) 'WithdrawalPrefix'
+	dst = ssz.MarshalUint8(dst, i.WithdrawalPrefix)
+
+	// Field (7) 'Amount'
+	dst = ssz.MarshalUint64(dst, i.Amount)
+
 	// Field (0) 'Operators'
 	if size := len(i.Operators); size > 13 {
 		err = ssz.ErrListTooBigFn("Init.Operators", size, 13)
@@ -904,7 +910,7 @@ func (i *Init) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (i *Init) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 48 {
+	if size < 57 {
 		return ssz.ErrSize
 	}
 
@@ -916,7 +922,7 @@ func (i *Init) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 48 {
+	if o0 < 57 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -937,6 +943,12 @@ func (i *Init) UnmarshalSSZ(buf []byte) error {
 	// Field (5) 'Nonce'
 	i.Nonce = ssz.UnmarshallUint64(buf[40:48])
 
+	// Field (6) 'WithdrawalPrefix'
+	i.WithdrawalPrefix = ssz.UnmarshallUint8(buf[48:49])
+
+	// Field (7) 'Amount'
+	i.Amount = ssz.UnmarshallUint64(buf[49:57])
+
 	// Field (0) 'Operators'
 	{
 		buf = tail[o0:o2]
@@ -975,7 +987,7 @@ func (i *Init) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the Init object
 func (i *Init) SizeSSZ() (size int) {
-	size = 48
+	size = 57
 
 	// Field (0) 'Operators'
 	for ii := 0; ii < len(i.Operators); ii++ {
@@ -1038,6 +1050,12 @@ func (i *Init) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (5) 'Nonce'
 	hh.PutUint64(i.Nonce)
 
+	// Field (6) 'WithdrawalPrefix'
+	hh.PutUint8(i.WithdrawalPrefix)
+
+	// Field (7) 'Amount'
+	hh.PutUint64(i.Amount)
+
 	hh.Merkleize(indx)
 	return
 }
@@ -1047,6 +1065,232 @@ func (i *Init) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
 
+// MarshalSSZ ssz marshals the InitV2 object
+func (i *InitV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(i)
+}
+
+// MarshalSSZTo ssz marshals the InitV2 object to a target array
+func (i *InitV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(57)
+
+	// Offset (0) 'Operators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(i.Operators); ii++ {
+		offset += 4
+		offset += i.Operators[ii].SizeSSZ()
+	}
+
+	// Field (1) 'T'
+	dst = ssz.MarshalUint64(dst, i.T)
+
+	// Offset (2) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(i.WithdrawalCredentials)
+
+	// Field (3) 'Fork'
+	dst = append(dst, i.Fork[:]...)
+
+	// Field (4) 'Owner'
+	dst = append(dst, i.Owner[:]...)
+
+	// Field (5) 'Nonce'
+	dst = ssz.MarshalUint64(dst, i.Nonce)
+
+	// Field (6) 'WithdrawalPrefix'
+	dst = ssz.MarshalUint8(dst, i.WithdrawalPrefix)
+
+	// Field (7) 'Amount'
+	dst = ssz.MarshalUint64(dst, i.Amount)
+
+	// Field (0) 'Operators'
+	if size := len(i.Operators); size > 64 {
+		err = ssz.ErrListTooBigFn("InitV2.Operators", size, 64)
+		return
+	}
+	{
+		offset = 4 * len(i.Operators)
+		for ii := 0; ii < len(i.Operators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += i.Operators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(i.Operators); ii++ {
+		if dst, err = i.Operators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	if size := len(i.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("InitV2.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, i.WithdrawalCredentials...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the InitV2 object
+func (i *InitV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 57 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o2 uint64
+
+	// Offset (0) 'Operators'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 57 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'T'
+	i.T = ssz.UnmarshallUint64(buf[4:12])
+
+	// Offset (2) 'WithdrawalCredentials'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o0 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Fork'
+	copy(i.Fork[:], buf[16:20])
+
+	// Field (4) 'Owner'
+	copy(i.Owner[:], buf[20:40])
+
+	// Field (5) 'Nonce'
+	i.Nonce = ssz.UnmarshallUint64(buf[40:48])
+
+	// Field (6) 'WithdrawalPrefix'
+	i.WithdrawalPrefix = ssz.UnmarshallUint8(buf[48:49])
+
+	// Field (7) 'Amount'
+	i.Amount = ssz.UnmarshallUint64(buf[49:57])
+
+	// Field (0) 'Operators'
+	{
+		buf = tail[o0:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		i.Operators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if i.Operators[indx] == nil {
+				i.Operators[indx] = new(Operator)
+			}
+			if err = i.Operators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(i.WithdrawalCredentials) == 0 {
+			i.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		i.WithdrawalCredentials = append(i.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the InitV2 object
+func (i *InitV2) SizeSSZ() (size int) {
+	size = 57
+
+	// Field (0) 'Operators'
+	for ii := 0; ii < len(i.Operators); ii++ {
+		size += 4
+		size += i.Operators[ii].SizeSSZ()
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	size += len(i.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the InitV2 object
+func (i *InitV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
+}
+
+// HashTreeRootWith ssz hashes the InitV2 object with a hasher
+func (i *InitV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Operators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(i.Operators))
+		if num > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range i.Operators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 64)
+	}
+
+	// Field (1) 'T'
+	hh.PutUint64(i.T)
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(i.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(i.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (3) 'Fork'
+	hh.PutBytes(i.Fork[:])
+
+	// Field (4) 'Owner'
+	hh.PutBytes(i.Owner[:])
+
+	// Field (5) 'Nonce'
+	hh.PutUint64(i.Nonce)
+
+	// Field (6) 'WithdrawalPrefix'
+	hh.PutUint8(i.WithdrawalPrefix)
+
+	// Field (7) 'Amount'
+	hh.PutUint64(i.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the InitV2 object
+func (i *InitV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(i)
+}
+
 // MarshalSSZ ssz marshals the Reshare object
 func (r *Reshare) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(r)
@@ -1434,70 +1678,3039 @@ func (s *SignedReshare) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
-// MarshalSSZ ssz marshals the Result object
-func (r *Result) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the ReshareMessage object
+func (r *ReshareMessage) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(r)
 }
 
-// MarshalSSZTo ssz marshals the Result object to a target array
-func (r *Result) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the ReshareMessage object to a target array
+func (r *ReshareMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(228)
+	offset := int(25)
 
-	// Field (0) 'OperatorID'
-	dst = ssz.MarshalUint64(dst, r.OperatorID)
+	// Offset (0) 'SignedReshare'
+	dst = ssz.WriteOffset(dst, offset)
+	if r.SignedReshare == nil {
+		r.SignedReshare = new(SignedReshare)
+	}
+	offset += r.SignedReshare.SizeSSZ()
 
-	// Field (1) 'RequestID'
-	dst = append(dst, r.RequestID[:]...)
+	// Offset (1) 'Proofs'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		offset += 4
+		offset += r.Proofs[ii].SizeSSZ()
+	}
 
-	// Field (2) 'DepositPartialSignature'
-	if size := len(r.DepositPartialSignature); size != 96 {
-		err = ssz.ErrBytesLengthFn("Result.DepositPartialSignature", size, 96)
+	// Offset (2) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.WithdrawalCredentials)
+
+	// Field (3) 'Fork'
+	dst = append(dst, r.Fork[:]...)
+
+	// Field (4) 'WithdrawalPrefix'
+	dst = ssz.MarshalUint8(dst, r.WithdrawalPrefix)
+
+	// Field (5) 'Amount'
+	dst = ssz.MarshalUint64(dst, r.Amount)
+
+	// Field (0) 'SignedReshare'
+	if dst, err = r.SignedReshare.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	dst = append(dst, r.DepositPartialSignature...)
 
-	// Field (3) 'OwnerNoncePartialSignature'
-	if size := len(r.OwnerNoncePartialSignature); size != 96 {
-		err = ssz.ErrBytesLengthFn("Result.OwnerNoncePartialSignature", size, 96)
+	// Field (1) 'Proofs'
+	if size := len(r.Proofs); size > 13 {
+		err = ssz.ErrListTooBigFn("ReshareMessage.Proofs", size, 13)
 		return
 	}
-	dst = append(dst, r.OwnerNoncePartialSignature...)
-
-	// Offset (4) 'SignedProof'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += r.SignedProof.SizeSSZ()
+	{
+		offset = 4 * len(r.Proofs)
+		for ii := 0; ii < len(r.Proofs); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.Proofs[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		if dst, err = r.Proofs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (4) 'SignedProof'
-	if dst, err = r.SignedProof.MarshalSSZTo(dst); err != nil {
+	// Field (2) 'WithdrawalCredentials'
+	if size := len(r.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("ReshareMessage.WithdrawalCredentials", size, 32)
 		return
 	}
+	dst = append(dst, r.WithdrawalCredentials...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Result object
-func (r *Result) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the ReshareMessage object
+func (r *ReshareMessage) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 228 {
+	if size < 25 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o4 uint64
-
-	// Field (0) 'OperatorID'
-	r.OperatorID = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'RequestID'
-	copy(r.RequestID[:], buf[8:32])
+	var o0, o1, o2 uint64
 
-	// Field (2) 'DepositPartialSignature'
-	if cap(r.DepositPartialSignature) == 0 {
-		r.DepositPartialSignature = make([]byte, 0, len(buf[32:128]))
+	// Offset (0) 'SignedReshare'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
-	r.DepositPartialSignature = append(r.DepositPartialSignature, buf[32:128]...)
+
+	if o0 < 25 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Proofs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'WithdrawalCredentials'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Fork'
+	copy(r.Fork[:], buf[12:16])
+
+	// Field (4) 'WithdrawalPrefix'
+	r.WithdrawalPrefix = ssz.UnmarshallUint8(buf[16:17])
+
+	// Field (5) 'Amount'
+	r.Amount = ssz.UnmarshallUint64(buf[17:25])
+
+	// Field (0) 'SignedReshare'
+	{
+		buf = tail[o0:o1]
+		if r.SignedReshare == nil {
+			r.SignedReshare = new(SignedReshare)
+		}
+		if err = r.SignedReshare.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Proofs'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		r.Proofs = make([]*SignedProof, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.Proofs[indx] == nil {
+				r.Proofs[indx] = new(SignedProof)
+			}
+			if err = r.Proofs[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.WithdrawalCredentials) == 0 {
+			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ReshareMessage object
+func (r *ReshareMessage) SizeSSZ() (size int) {
+	size = 25
+
+	// Field (0) 'SignedReshare'
+	if r.SignedReshare == nil {
+		r.SignedReshare = new(SignedReshare)
+	}
+	size += r.SignedReshare.SizeSSZ()
+
+	// Field (1) 'Proofs'
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		size += 4
+		size += r.Proofs[ii].SizeSSZ()
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	size += len(r.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ReshareMessage object
+func (r *ReshareMessage) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ReshareMessage object with a hasher
+func (r *ReshareMessage) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedReshare'
+	if err = r.SignedReshare.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.Proofs))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.Proofs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (3) 'Fork'
+	hh.PutBytes(r.Fork[:])
+
+	// Field (4) 'WithdrawalPrefix'
+	hh.PutUint8(r.WithdrawalPrefix)
+
+	// Field (5) 'Amount'
+	hh.PutUint64(r.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ReshareMessage object
+func (r *ReshareMessage) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the ReshareV2 object
+func (r *ReshareV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ReshareV2 object to a target array
+func (r *ReshareV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ReshareV2.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, r.ValidatorPubKey...)
+
+	// Offset (1) 'OldOperators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		offset += 4
+		offset += r.OldOperators[ii].SizeSSZ()
+	}
+
+	// Offset (2) 'NewOperators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		offset += 4
+		offset += r.NewOperators[ii].SizeSSZ()
+	}
+
+	// Field (3) 'OldT'
+	dst = ssz.MarshalUint64(dst, r.OldT)
+
+	// Field (4) 'NewT'
+	dst = ssz.MarshalUint64(dst, r.NewT)
+
+	// Field (5) 'Owner'
+	dst = append(dst, r.Owner[:]...)
+
+	// Field (6) 'Nonce'
+	dst = ssz.MarshalUint64(dst, r.Nonce)
+
+	// Field (1) 'OldOperators'
+	if size := len(r.OldOperators); size > 64 {
+		err = ssz.ErrListTooBigFn("ReshareV2.OldOperators", size, 64)
+		return
+	}
+	{
+		offset = 4 * len(r.OldOperators)
+		for ii := 0; ii < len(r.OldOperators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.OldOperators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		if dst, err = r.OldOperators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'NewOperators'
+	if size := len(r.NewOperators); size > 64 {
+		err = ssz.ErrListTooBigFn("ReshareV2.NewOperators", size, 64)
+		return
+	}
+	{
+		offset = 4 * len(r.NewOperators)
+		for ii := 0; ii < len(r.NewOperators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.NewOperators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		if dst, err = r.NewOperators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ReshareV2 object
+func (r *ReshareV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(r.ValidatorPubKey) == 0 {
+		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'OldOperators'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 100 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (2) 'NewOperators'
+	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'OldT'
+	r.OldT = ssz.UnmarshallUint64(buf[56:64])
+
+	// Field (4) 'NewT'
+	r.NewT = ssz.UnmarshallUint64(buf[64:72])
+
+	// Field (5) 'Owner'
+	copy(r.Owner[:], buf[72:92])
+
+	// Field (6) 'Nonce'
+	r.Nonce = ssz.UnmarshallUint64(buf[92:100])
+
+	// Field (1) 'OldOperators'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		r.OldOperators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.OldOperators[indx] == nil {
+				r.OldOperators[indx] = new(Operator)
+			}
+			if err = r.OldOperators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'NewOperators'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		r.NewOperators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.NewOperators[indx] == nil {
+				r.NewOperators[indx] = new(Operator)
+			}
+			if err = r.NewOperators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ReshareV2 object
+func (r *ReshareV2) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (1) 'OldOperators'
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		size += 4
+		size += r.OldOperators[ii].SizeSSZ()
+	}
+
+	// Field (2) 'NewOperators'
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		size += 4
+		size += r.NewOperators[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ReshareV2 object
+func (r *ReshareV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ReshareV2 object with a hasher
+func (r *ReshareV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ReshareV2.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(r.ValidatorPubKey)
+
+	// Field (1) 'OldOperators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.OldOperators))
+		if num > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.OldOperators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 64)
+	}
+
+	// Field (2) 'NewOperators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.NewOperators))
+		if num > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.NewOperators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 64)
+	}
+
+	// Field (3) 'OldT'
+	hh.PutUint64(r.OldT)
+
+	// Field (4) 'NewT'
+	hh.PutUint64(r.NewT)
+
+	// Field (5) 'Owner'
+	hh.PutBytes(r.Owner[:])
+
+	// Field (6) 'Nonce'
+	hh.PutUint64(r.Nonce)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ReshareV2 object
+func (r *ReshareV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the SignedReshareV2 object
+func (s *SignedReshareV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedReshareV2 object to a target array
+func (s *SignedReshareV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Reshare'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += s.Reshare.SizeSSZ()
+
+	// Offset (1) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Signature)
+
+	// Field (0) 'Reshare'
+	if dst, err = s.Reshare.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size > 1536 {
+		err = ssz.ErrBytesLengthFn("SignedReshareV2.Signature", size, 1536)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedReshareV2 object
+func (s *SignedReshareV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Reshare'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signature'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Reshare'
+	{
+		buf = tail[o0:o1]
+		if err = s.Reshare.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf = tail[o1:]
+		if len(buf) > 1536 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedReshareV2 object
+func (s *SignedReshareV2) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Reshare'
+	size += s.Reshare.SizeSSZ()
+
+	// Field (1) 'Signature'
+	size += len(s.Signature)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedReshareV2 object
+func (s *SignedReshareV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedReshareV2 object with a hasher
+func (s *SignedReshareV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Reshare'
+	if err = s.Reshare.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 1536 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedReshareV2 object
+func (s *SignedReshareV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the ReshareMessageV2 object
+func (r *ReshareMessageV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ReshareMessageV2 object to a target array
+func (r *ReshareMessageV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(25)
+
+	// Offset (0) 'SignedReshare'
+	dst = ssz.WriteOffset(dst, offset)
+	if r.SignedReshare == nil {
+		r.SignedReshare = new(SignedReshareV2)
+	}
+	offset += r.SignedReshare.SizeSSZ()
+
+	// Offset (1) 'Proofs'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		offset += 4
+		offset += r.Proofs[ii].SizeSSZ()
+	}
+
+	// Offset (2) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.WithdrawalCredentials)
+
+	// Field (3) 'Fork'
+	dst = append(dst, r.Fork[:]...)
+
+	// Field (4) 'WithdrawalPrefix'
+	dst = ssz.MarshalUint8(dst, r.WithdrawalPrefix)
+
+	// Field (5) 'Amount'
+	dst = ssz.MarshalUint64(dst, r.Amount)
+
+	// Field (0) 'SignedReshare'
+	if dst, err = r.SignedReshare.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	if size := len(r.Proofs); size > 64 {
+		err = ssz.ErrListTooBigFn("ReshareMessageV2.Proofs", size, 64)
+		return
+	}
+	{
+		offset = 4 * len(r.Proofs)
+		for ii := 0; ii < len(r.Proofs); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.Proofs[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		if dst, err = r.Proofs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	if size := len(r.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("ReshareMessageV2.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, r.WithdrawalCredentials...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ReshareMessageV2 object
+func (r *ReshareMessageV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 25 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'SignedReshare'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 25 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Proofs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'WithdrawalCredentials'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Fork'
+	copy(r.Fork[:], buf[12:16])
+
+	// Field (4) 'WithdrawalPrefix'
+	r.WithdrawalPrefix = ssz.UnmarshallUint8(buf[16:17])
+
+	// Field (5) 'Amount'
+	r.Amount = ssz.UnmarshallUint64(buf[17:25])
+
+	// Field (0) 'SignedReshare'
+	{
+		buf = tail[o0:o1]
+		if r.SignedReshare == nil {
+			r.SignedReshare = new(SignedReshareV2)
+		}
+		if err = r.SignedReshare.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Proofs'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		r.Proofs = make([]*SignedProof, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.Proofs[indx] == nil {
+				r.Proofs[indx] = new(SignedProof)
+			}
+			if err = r.Proofs[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.WithdrawalCredentials) == 0 {
+			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ReshareMessageV2 object
+func (r *ReshareMessageV2) SizeSSZ() (size int) {
+	size = 25
+
+	// Field (0) 'SignedReshare'
+	if r.SignedReshare == nil {
+		r.SignedReshare = new(SignedReshareV2)
+	}
+	size += r.SignedReshare.SizeSSZ()
+
+	// Field (1) 'Proofs'
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		size += 4
+		size += r.Proofs[ii].SizeSSZ()
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	size += len(r.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ReshareMessageV2 object
+func (r *ReshareMessageV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ReshareMessageV2 object with a hasher
+func (r *ReshareMessageV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedReshare'
+	if err = r.SignedReshare.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.Proofs))
+		if num > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.Proofs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 64)
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (3) 'Fork'
+	hh.PutBytes(r.Fork[:])
+
+	// Field (4) 'WithdrawalPrefix'
+	hh.PutUint8(r.WithdrawalPrefix)
+
+	// Field (5) 'Amount'
+	hh.PutUint64(r.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ReshareMessageV2 object
+func (r *ReshareMessageV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the Resign object
+func (r *Resign) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the Resign object to a target array
+func (r *Resign) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(80)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Resign.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, r.ValidatorPubKey...)
+
+	// Offset (1) 'Operators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.Operators); ii++ {
+		offset += 4
+		offset += r.Operators[ii].SizeSSZ()
+	}
+
+	// Field (2) 'Owner'
+	dst = append(dst, r.Owner[:]...)
+
+	// Field (3) 'Nonce'
+	dst = ssz.MarshalUint64(dst, r.Nonce)
+
+	// Field (1) 'Operators'
+	if size := len(r.Operators); size > 13 {
+		err = ssz.ErrListTooBigFn("Resign.Operators", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(r.Operators)
+		for ii := 0; ii < len(r.Operators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.Operators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.Operators); ii++ {
+		if dst, err = r.Operators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Resign object
+func (r *Resign) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 80 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(r.ValidatorPubKey) == 0 {
+		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'Operators'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 80 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'Owner'
+	copy(r.Owner[:], buf[52:72])
+
+	// Field (3) 'Nonce'
+	r.Nonce = ssz.UnmarshallUint64(buf[72:80])
+
+	// Field (1) 'Operators'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		r.Operators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.Operators[indx] == nil {
+				r.Operators[indx] = new(Operator)
+			}
+			if err = r.Operators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Resign object
+func (r *Resign) SizeSSZ() (size int) {
+	size = 80
+
+	// Field (1) 'Operators'
+	for ii := 0; ii < len(r.Operators); ii++ {
+		size += 4
+		size += r.Operators[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Resign object
+func (r *Resign) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the Resign object with a hasher
+func (r *Resign) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Resign.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(r.ValidatorPubKey)
+
+	// Field (1) 'Operators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.Operators))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.Operators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (2) 'Owner'
+	hh.PutBytes(r.Owner[:])
+
+	// Field (3) 'Nonce'
+	hh.PutUint64(r.Nonce)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Resign object
+func (r *Resign) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the SignedResign object
+func (s *SignedResign) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedResign object to a target array
+func (s *SignedResign) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Resign'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += s.Resign.SizeSSZ()
+
+	// Offset (1) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Signature)
+
+	// Field (0) 'Resign'
+	if dst, err = s.Resign.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size > 1536 {
+		err = ssz.ErrBytesLengthFn("SignedResign.Signature", size, 1536)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedResign object
+func (s *SignedResign) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Resign'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signature'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Resign'
+	{
+		buf = tail[o0:o1]
+		if err = s.Resign.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf = tail[o1:]
+		if len(buf) > 1536 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedResign object
+func (s *SignedResign) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Resign'
+	size += s.Resign.SizeSSZ()
+
+	// Field (1) 'Signature'
+	size += len(s.Signature)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedResign object
+func (s *SignedResign) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedResign object with a hasher
+func (s *SignedResign) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Resign'
+	if err = s.Resign.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 1536 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedResign object
+func (s *SignedResign) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the ResignMessage object
+func (r *ResignMessage) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ResignMessage object to a target array
+func (r *ResignMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(25)
+
+	// Offset (0) 'SignedResign'
+	dst = ssz.WriteOffset(dst, offset)
+	if r.SignedResign == nil {
+		r.SignedResign = new(SignedResign)
+	}
+	offset += r.SignedResign.SizeSSZ()
+
+	// Offset (1) 'Proofs'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		offset += 4
+		offset += r.Proofs[ii].SizeSSZ()
+	}
+
+	// Offset (2) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.WithdrawalCredentials)
+
+	// Field (3) 'Fork'
+	dst = append(dst, r.Fork[:]...)
+
+	// Field (4) 'WithdrawalPrefix'
+	dst = ssz.MarshalUint8(dst, r.WithdrawalPrefix)
+
+	// Field (5) 'Amount'
+	dst = ssz.MarshalUint64(dst, r.Amount)
+
+	// Field (0) 'SignedResign'
+	if dst, err = r.SignedResign.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	if size := len(r.Proofs); size > 13 {
+		err = ssz.ErrListTooBigFn("ResignMessage.Proofs", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(r.Proofs)
+		for ii := 0; ii < len(r.Proofs); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.Proofs[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		if dst, err = r.Proofs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	if size := len(r.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("ResignMessage.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, r.WithdrawalCredentials...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ResignMessage object
+func (r *ResignMessage) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 25 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'SignedResign'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 25 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Proofs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'WithdrawalCredentials'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Fork'
+	copy(r.Fork[:], buf[12:16])
+
+	// Field (4) 'WithdrawalPrefix'
+	r.WithdrawalPrefix = ssz.UnmarshallUint8(buf[16:17])
+
+	// Field (5) 'Amount'
+	r.Amount = ssz.UnmarshallUint64(buf[17:25])
+
+	// Field (0) 'SignedResign'
+	{
+		buf = tail[o0:o1]
+		if r.SignedResign == nil {
+			r.SignedResign = new(SignedResign)
+		}
+		if err = r.SignedResign.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Proofs'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		r.Proofs = make([]*SignedProof, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.Proofs[indx] == nil {
+				r.Proofs[indx] = new(SignedProof)
+			}
+			if err = r.Proofs[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.WithdrawalCredentials) == 0 {
+			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ResignMessage object
+func (r *ResignMessage) SizeSSZ() (size int) {
+	size = 25
+
+	// Field (0) 'SignedResign'
+	if r.SignedResign == nil {
+		r.SignedResign = new(SignedResign)
+	}
+	size += r.SignedResign.SizeSSZ()
+
+	// Field (1) 'Proofs'
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		size += 4
+		size += r.Proofs[ii].SizeSSZ()
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	size += len(r.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ResignMessage object
+func (r *ResignMessage) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ResignMessage object with a hasher
+func (r *ResignMessage) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedResign'
+	if err = r.SignedResign.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.Proofs))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.Proofs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (3) 'Fork'
+	hh.PutBytes(r.Fork[:])
+
+	// Field (4) 'WithdrawalPrefix'
+	hh.PutUint8(r.WithdrawalPrefix)
+
+	// Field (5) 'Amount'
+	hh.PutUint64(r.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ResignMessage object
+func (r *ResignMessage) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the ResignV2 object
+func (r *ResignV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ResignV2 object to a target array
+func (r *ResignV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(80)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ResignV2.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, r.ValidatorPubKey...)
+
+	// Offset (1) 'Operators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.Operators); ii++ {
+		offset += 4
+		offset += r.Operators[ii].SizeSSZ()
+	}
+
+	// Field (2) 'Owner'
+	dst = append(dst, r.Owner[:]...)
+
+	// Field (3) 'Nonce'
+	dst = ssz.MarshalUint64(dst, r.Nonce)
+
+	// Field (1) 'Operators'
+	if size := len(r.Operators); size > 64 {
+		err = ssz.ErrListTooBigFn("ResignV2.Operators", size, 64)
+		return
+	}
+	{
+		offset = 4 * len(r.Operators)
+		for ii := 0; ii < len(r.Operators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.Operators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.Operators); ii++ {
+		if dst, err = r.Operators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ResignV2 object
+func (r *ResignV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 80 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(r.ValidatorPubKey) == 0 {
+		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'Operators'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 80 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'Owner'
+	copy(r.Owner[:], buf[52:72])
+
+	// Field (3) 'Nonce'
+	r.Nonce = ssz.UnmarshallUint64(buf[72:80])
+
+	// Field (1) 'Operators'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		r.Operators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.Operators[indx] == nil {
+				r.Operators[indx] = new(Operator)
+			}
+			if err = r.Operators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ResignV2 object
+func (r *ResignV2) SizeSSZ() (size int) {
+	size = 80
+
+	// Field (1) 'Operators'
+	for ii := 0; ii < len(r.Operators); ii++ {
+		size += 4
+		size += r.Operators[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ResignV2 object
+func (r *ResignV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ResignV2 object with a hasher
+func (r *ResignV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ResignV2.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(r.ValidatorPubKey)
+
+	// Field (1) 'Operators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.Operators))
+		if num > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.Operators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 64)
+	}
+
+	// Field (2) 'Owner'
+	hh.PutBytes(r.Owner[:])
+
+	// Field (3) 'Nonce'
+	hh.PutUint64(r.Nonce)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ResignV2 object
+func (r *ResignV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the SignedResignV2 object
+func (s *SignedResignV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedResignV2 object to a target array
+func (s *SignedResignV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Resign'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += s.Resign.SizeSSZ()
+
+	// Offset (1) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Signature)
+
+	// Field (0) 'Resign'
+	if dst, err = s.Resign.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size > 1536 {
+		err = ssz.ErrBytesLengthFn("SignedResignV2.Signature", size, 1536)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedResignV2 object
+func (s *SignedResignV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Resign'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signature'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Resign'
+	{
+		buf = tail[o0:o1]
+		if err = s.Resign.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf = tail[o1:]
+		if len(buf) > 1536 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedResignV2 object
+func (s *SignedResignV2) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Resign'
+	size += s.Resign.SizeSSZ()
+
+	// Field (1) 'Signature'
+	size += len(s.Signature)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedResignV2 object
+func (s *SignedResignV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedResignV2 object with a hasher
+func (s *SignedResignV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Resign'
+	if err = s.Resign.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 1536 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedResignV2 object
+func (s *SignedResignV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the ResignMessageV2 object
+func (r *ResignMessageV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ResignMessageV2 object to a target array
+func (r *ResignMessageV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(25)
+
+	// Offset (0) 'SignedResign'
+	dst = ssz.WriteOffset(dst, offset)
+	if r.SignedResign == nil {
+		r.SignedResign = new(SignedResignV2)
+	}
+	offset += r.SignedResign.SizeSSZ()
+
+	// Offset (1) 'Proofs'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		offset += 4
+		offset += r.Proofs[ii].SizeSSZ()
+	}
+
+	// Offset (2) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.WithdrawalCredentials)
+
+	// Field (3) 'Fork'
+	dst = append(dst, r.Fork[:]...)
+
+	// Field (4) 'WithdrawalPrefix'
+	dst = ssz.MarshalUint8(dst, r.WithdrawalPrefix)
+
+	// Field (5) 'Amount'
+	dst = ssz.MarshalUint64(dst, r.Amount)
+
+	// Field (0) 'SignedResign'
+	if dst, err = r.SignedResign.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	if size := len(r.Proofs); size > 64 {
+		err = ssz.ErrListTooBigFn("ResignMessageV2.Proofs", size, 64)
+		return
+	}
+	{
+		offset = 4 * len(r.Proofs)
+		for ii := 0; ii < len(r.Proofs); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.Proofs[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		if dst, err = r.Proofs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	if size := len(r.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("ResignMessageV2.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, r.WithdrawalCredentials...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ResignMessageV2 object
+func (r *ResignMessageV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 25 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'SignedResign'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 25 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Proofs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'WithdrawalCredentials'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Fork'
+	copy(r.Fork[:], buf[12:16])
+
+	// Field (4) 'WithdrawalPrefix'
+	r.WithdrawalPrefix = ssz.UnmarshallUint8(buf[16:17])
+
+	// Field (5) 'Amount'
+	r.Amount = ssz.UnmarshallUint64(buf[17:25])
+
+	// Field (0) 'SignedResign'
+	{
+		buf = tail[o0:o1]
+		if r.SignedResign == nil {
+			r.SignedResign = new(SignedResignV2)
+		}
+		if err = r.SignedResign.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Proofs'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		r.Proofs = make([]*SignedProof, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.Proofs[indx] == nil {
+				r.Proofs[indx] = new(SignedProof)
+			}
+			if err = r.Proofs[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.WithdrawalCredentials) == 0 {
+			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ResignMessageV2 object
+func (r *ResignMessageV2) SizeSSZ() (size int) {
+	size = 25
+
+	// Field (0) 'SignedResign'
+	if r.SignedResign == nil {
+		r.SignedResign = new(SignedResignV2)
+	}
+	size += r.SignedResign.SizeSSZ()
+
+	// Field (1) 'Proofs'
+	for ii := 0; ii < len(r.Proofs); ii++ {
+		size += 4
+		size += r.Proofs[ii].SizeSSZ()
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	size += len(r.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ResignMessageV2 object
+func (r *ResignMessageV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ResignMessageV2 object with a hasher
+func (r *ResignMessageV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedResign'
+	if err = r.SignedResign.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.Proofs))
+		if num > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.Proofs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 64)
+	}
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (3) 'Fork'
+	hh.PutBytes(r.Fork[:])
+
+	// Field (4) 'WithdrawalPrefix'
+	hh.PutUint8(r.WithdrawalPrefix)
+
+	// Field (5) 'Amount'
+	hh.PutUint64(r.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ResignMessageV2 object
+func (r *ResignMessageV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the Exit object
+func (e *Exit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the Exit object to a target array
+func (e *Exit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(72)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(e.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Exit.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, e.ValidatorPubKey...)
+
+	// Offset (1) 'Operators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(e.Operators); ii++ {
+		offset += 4
+		offset += e.Operators[ii].SizeSSZ()
+	}
+
+	// Field (2) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, e.ValidatorIndex)
+
+	// Field (3) 'Epoch'
+	dst = ssz.MarshalUint64(dst, e.Epoch)
+
+	// Field (4) 'Fork'
+	dst = append(dst, e.Fork[:]...)
+
+	// Field (1) 'Operators'
+	if size := len(e.Operators); size > 13 {
+		err = ssz.ErrListTooBigFn("Exit.Operators", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(e.Operators)
+		for ii := 0; ii < len(e.Operators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += e.Operators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(e.Operators); ii++ {
+		if dst, err = e.Operators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Exit object
+func (e *Exit) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 72 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(e.ValidatorPubKey) == 0 {
+		e.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	e.ValidatorPubKey = append(e.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'Operators'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 72 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'ValidatorIndex'
+	e.ValidatorIndex = ssz.UnmarshallUint64(buf[52:60])
+
+	// Field (3) 'Epoch'
+	e.Epoch = ssz.UnmarshallUint64(buf[60:68])
+
+	// Field (4) 'Fork'
+	copy(e.Fork[:], buf[68:72])
+
+	// Field (1) 'Operators'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		e.Operators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if e.Operators[indx] == nil {
+				e.Operators[indx] = new(Operator)
+			}
+			if err = e.Operators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Exit object
+func (e *Exit) SizeSSZ() (size int) {
+	size = 72
+
+	// Field (1) 'Operators'
+	for ii := 0; ii < len(e.Operators); ii++ {
+		size += 4
+		size += e.Operators[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Exit object
+func (e *Exit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the Exit object with a hasher
+func (e *Exit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(e.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Exit.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(e.ValidatorPubKey)
+
+	// Field (1) 'Operators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Operators))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Operators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (2) 'ValidatorIndex'
+	hh.PutUint64(e.ValidatorIndex)
+
+	// Field (3) 'Epoch'
+	hh.PutUint64(e.Epoch)
+
+	// Field (4) 'Fork'
+	hh.PutBytes(e.Fork[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Exit object
+func (e *Exit) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// MarshalSSZ ssz marshals the SignedExit object
+func (s *SignedExit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedExit object to a target array
+func (s *SignedExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Exit'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += s.Exit.SizeSSZ()
+
+	// Offset (1) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Signature)
+
+	// Field (0) 'Exit'
+	if dst, err = s.Exit.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size > 1536 {
+		err = ssz.ErrBytesLengthFn("SignedExit.Signature", size, 1536)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedExit object
+func (s *SignedExit) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Exit'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signature'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Exit'
+	{
+		buf = tail[o0:o1]
+		if err = s.Exit.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf = tail[o1:]
+		if len(buf) > 1536 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedExit object
+func (s *SignedExit) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Exit'
+	size += s.Exit.SizeSSZ()
+
+	// Field (1) 'Signature'
+	size += len(s.Signature)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedExit object
+func (s *SignedExit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedExit object with a hasher
+func (s *SignedExit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Exit'
+	if err = s.Exit.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 1536 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedExit object
+func (s *SignedExit) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the ExitMessage object
+func (e *ExitMessage) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExitMessage object to a target array
+func (e *ExitMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'SignedExit'
+	dst = ssz.WriteOffset(dst, offset)
+	if e.SignedExit == nil {
+		e.SignedExit = new(SignedExit)
+	}
+	offset += e.SignedExit.SizeSSZ()
+
+	// Offset (1) 'Proofs'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(e.Proofs); ii++ {
+		offset += 4
+		offset += e.Proofs[ii].SizeSSZ()
+	}
+
+	// Field (0) 'SignedExit'
+	if dst, err = e.SignedExit.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	if size := len(e.Proofs); size > 13 {
+		err = ssz.ErrListTooBigFn("ExitMessage.Proofs", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(e.Proofs)
+		for ii := 0; ii < len(e.Proofs); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += e.Proofs[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(e.Proofs); ii++ {
+		if dst, err = e.Proofs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ExitMessage object
+func (e *ExitMessage) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'SignedExit'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Proofs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'SignedExit'
+	{
+		buf = tail[o0:o1]
+		if e.SignedExit == nil {
+			e.SignedExit = new(SignedExit)
+		}
+		if err = e.SignedExit.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Proofs'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		e.Proofs = make([]*SignedProof, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if e.Proofs[indx] == nil {
+				e.Proofs[indx] = new(SignedProof)
+			}
+			if err = e.Proofs[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExitMessage object
+func (e *ExitMessage) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'SignedExit'
+	if e.SignedExit == nil {
+		e.SignedExit = new(SignedExit)
+	}
+	size += e.SignedExit.SizeSSZ()
+
+	// Field (1) 'Proofs'
+	for ii := 0; ii < len(e.Proofs); ii++ {
+		size += 4
+		size += e.Proofs[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ExitMessage object
+func (e *ExitMessage) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExitMessage object with a hasher
+func (e *ExitMessage) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedExit'
+	if err = e.SignedExit.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Proofs))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Proofs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExitMessage object
+func (e *ExitMessage) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// MarshalSSZ ssz marshals the ExitV2 object
+func (e *ExitV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExitV2 object to a target array
+func (e *ExitV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(72)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(e.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ExitV2.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, e.ValidatorPubKey...)
+
+	// Offset (1) 'Operators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(e.Operators); ii++ {
+		offset += 4
+		offset += e.Operators[ii].SizeSSZ()
+	}
+
+	// Field (2) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, e.ValidatorIndex)
+
+	// Field (3) 'Epoch'
+	dst = ssz.MarshalUint64(dst, e.Epoch)
+
+	// Field (4) 'Fork'
+	dst = append(dst, e.Fork[:]...)
+
+	// Field (1) 'Operators'
+	if size := len(e.Operators); size > 64 {
+		err = ssz.ErrListTooBigFn("ExitV2.Operators", size, 64)
+		return
+	}
+	{
+		offset = 4 * len(e.Operators)
+		for ii := 0; ii < len(e.Operators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += e.Operators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(e.Operators); ii++ {
+		if dst, err = e.Operators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ExitV2 object
+func (e *ExitV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 72 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(e.ValidatorPubKey) == 0 {
+		e.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	e.ValidatorPubKey = append(e.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'Operators'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 72 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'ValidatorIndex'
+	e.ValidatorIndex = ssz.UnmarshallUint64(buf[52:60])
+
+	// Field (3) 'Epoch'
+	e.Epoch = ssz.UnmarshallUint64(buf[60:68])
+
+	// Field (4) 'Fork'
+	copy(e.Fork[:], buf[68:72])
+
+	// Field (1) 'Operators'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		e.Operators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if e.Operators[indx] == nil {
+				e.Operators[indx] = new(Operator)
+			}
+			if err = e.Operators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExitV2 object
+func (e *ExitV2) SizeSSZ() (size int) {
+	size = 72
+
+	// Field (1) 'Operators'
+	for ii := 0; ii < len(e.Operators); ii++ {
+		size += 4
+		size += e.Operators[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ExitV2 object
+func (e *ExitV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExitV2 object with a hasher
+func (e *ExitV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(e.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ExitV2.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(e.ValidatorPubKey)
+
+	// Field (1) 'Operators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Operators))
+		if num > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Operators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 64)
+	}
+
+	// Field (2) 'ValidatorIndex'
+	hh.PutUint64(e.ValidatorIndex)
+
+	// Field (3) 'Epoch'
+	hh.PutUint64(e.Epoch)
+
+	// Field (4) 'Fork'
+	hh.PutBytes(e.Fork[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExitV2 object
+func (e *ExitV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// MarshalSSZ ssz marshals the SignedExitV2 object
+func (s *SignedExitV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedExitV2 object to a target array
+func (s *SignedExitV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Exit'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += s.Exit.SizeSSZ()
+
+	// Offset (1) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Signature)
+
+	// Field (0) 'Exit'
+	if dst, err = s.Exit.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size > 1536 {
+		err = ssz.ErrBytesLengthFn("SignedExitV2.Signature", size, 1536)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedExitV2 object
+func (s *SignedExitV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Exit'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signature'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Exit'
+	{
+		buf = tail[o0:o1]
+		if err = s.Exit.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf = tail[o1:]
+		if len(buf) > 1536 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedExitV2 object
+func (s *SignedExitV2) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Exit'
+	size += s.Exit.SizeSSZ()
+
+	// Field (1) 'Signature'
+	size += len(s.Signature)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedExitV2 object
+func (s *SignedExitV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedExitV2 object with a hasher
+func (s *SignedExitV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Exit'
+	if err = s.Exit.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 1536 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedExitV2 object
+func (s *SignedExitV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the ExitMessageV2 object
+func (e *ExitMessageV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExitMessageV2 object to a target array
+func (e *ExitMessageV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'SignedExit'
+	dst = ssz.WriteOffset(dst, offset)
+	if e.SignedExit == nil {
+		e.SignedExit = new(SignedExitV2)
+	}
+	offset += e.SignedExit.SizeSSZ()
+
+	// Offset (1) 'Proofs'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(e.Proofs); ii++ {
+		offset += 4
+		offset += e.Proofs[ii].SizeSSZ()
+	}
+
+	// Field (0) 'SignedExit'
+	if dst, err = e.SignedExit.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	if size := len(e.Proofs); size > 64 {
+		err = ssz.ErrListTooBigFn("ExitMessageV2.Proofs", size, 64)
+		return
+	}
+	{
+		offset = 4 * len(e.Proofs)
+		for ii := 0; ii < len(e.Proofs); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += e.Proofs[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(e.Proofs); ii++ {
+		if dst, err = e.Proofs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ExitMessageV2 object
+func (e *ExitMessageV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'SignedExit'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Proofs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'SignedExit'
+	{
+		buf = tail[o0:o1]
+		if e.SignedExit == nil {
+			e.SignedExit = new(SignedExitV2)
+		}
+		if err = e.SignedExit.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Proofs'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 64)
+		if err != nil {
+			return err
+		}
+		e.Proofs = make([]*SignedProof, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if e.Proofs[indx] == nil {
+				e.Proofs[indx] = new(SignedProof)
+			}
+			if err = e.Proofs[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExitMessageV2 object
+func (e *ExitMessageV2) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'SignedExit'
+	if e.SignedExit == nil {
+		e.SignedExit = new(SignedExitV2)
+	}
+	size += e.SignedExit.SizeSSZ()
+
+	// Field (1) 'Proofs'
+	for ii := 0; ii < len(e.Proofs); ii++ {
+		size += 4
+		size += e.Proofs[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ExitMessageV2 object
+func (e *ExitMessageV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExitMessageV2 object with a hasher
+func (e *ExitMessageV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedExit'
+	if err = e.SignedExit.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Proofs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Proofs))
+		if num > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Proofs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 64)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExitMessageV2 object
+func (e *ExitMessageV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// MarshalSSZ ssz marshals the PartialExit object
+func (p *PartialExit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PartialExit object to a target array
+func (p *PartialExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, p.OperatorID)
+
+	// Field (1) 'RequestID'
+	dst = append(dst, p.RequestID[:]...)
+
+	// Field (2) 'PartialSignature'
+	if size := len(p.PartialSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("PartialExit.PartialSignature", size, 96)
+		return
+	}
+	dst = append(dst, p.PartialSignature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PartialExit object
+func (p *PartialExit) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 128 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'OperatorID'
+	p.OperatorID = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'RequestID'
+	copy(p.RequestID[:], buf[8:32])
+
+	// Field (2) 'PartialSignature'
+	if cap(p.PartialSignature) == 0 {
+		p.PartialSignature = make([]byte, 0, len(buf[32:128]))
+	}
+	p.PartialSignature = append(p.PartialSignature, buf[32:128]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PartialExit object
+func (p *PartialExit) SizeSSZ() (size int) {
+	size = 128
+	return
+}
+
+// HashTreeRoot ssz hashes the PartialExit object
+func (p *PartialExit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PartialExit object with a hasher
+func (p *PartialExit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'OperatorID'
+	hh.PutUint64(p.OperatorID)
+
+	// Field (1) 'RequestID'
+	hh.PutBytes(p.RequestID[:])
+
+	// Field (2) 'PartialSignature'
+	if size := len(p.PartialSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("PartialExit.PartialSignature", size, 96)
+		return
+	}
+	hh.PutBytes(p.PartialSignature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the PartialExit object
+func (p *PartialExit) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the Result object
+func (r *Result) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the Result object to a target array
+func (r *Result) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(232)
+
+	// Field (0) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, r.OperatorID)
+
+	// Field (1) 'RequestID'
+	dst = append(dst, r.RequestID[:]...)
+
+	// Field (2) 'DepositPartialSignature'
+	if size := len(r.DepositPartialSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Result.DepositPartialSignature", size, 96)
+		return
+	}
+	dst = append(dst, r.DepositPartialSignature...)
+
+	// Field (3) 'OwnerNoncePartialSignature'
+	if size := len(r.OwnerNoncePartialSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Result.OwnerNoncePartialSignature", size, 96)
+		return
+	}
+	dst = append(dst, r.OwnerNoncePartialSignature...)
+
+	// Offset (4) 'SignedProof'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += r.SignedProof.SizeSSZ()
+
+	// Offset (5) 'Commits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.Commits)
+
+	// Field (4) 'SignedProof'
+	if dst, err = r.SignedProof.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'Commits'
+	if size := len(r.Commits); size > 3072 {
+		err = ssz.ErrBytesLengthFn("Result.Commits", size, 3072)
+		return
+	}
+	dst = append(dst, r.Commits...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Result object
+func (r *Result) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 232 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4, o5 uint64
+
+	// Field (0) 'OperatorID'
+	r.OperatorID = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'RequestID'
+	copy(r.RequestID[:], buf[8:32])
+
+	// Field (2) 'DepositPartialSignature'
+	if cap(r.DepositPartialSignature) == 0 {
+		r.DepositPartialSignature = make([]byte, 0, len(buf[32:128]))
+	}
+	r.DepositPartialSignature = append(r.DepositPartialSignature, buf[32:128]...)
 
 	// Field (3) 'OwnerNoncePartialSignature'
 	if cap(r.OwnerNoncePartialSignature) == 0 {
@@ -1510,27 +4723,47 @@ func (r *Result) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 228 {
+	if o4 < 232 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
+	// Offset (5) 'Commits'
+	if o5 = ssz.ReadOffset(buf[228:232]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
 	// Field (4) 'SignedProof'
 	{
-		buf = tail[o4:]
+		buf = tail[o4:o5]
 		if err = r.SignedProof.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
+
+	// Field (5) 'Commits'
+	{
+		buf = tail[o5:]
+		if len(buf) > 3072 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.Commits) == 0 {
+			r.Commits = make([]byte, 0, len(buf))
+		}
+		r.Commits = append(r.Commits, buf...)
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the Result object
 func (r *Result) SizeSSZ() (size int) {
-	size = 228
+	size = 232
 
 	// Field (4) 'SignedProof'
 	size += r.SignedProof.SizeSSZ()
 
+	// Field (5) 'Commits'
+	size += len(r.Commits)
+
 	return
 }
 
@@ -1568,6 +4801,18 @@ func (r *Result) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		return
 	}
 
+	// Field (5) 'Commits'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.Commits))
+		if byteLen > 3072 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.Commits)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (3072+31)/32)
+	}
+
 	hh.Merkleize(indx)
 	return
 }
@@ -2291,8 +5536,8 @@ func (r *ResultData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	offset += len(r.Proofs)
 
 	// Field (0) 'Operators'
-	if size := len(r.Operators); size > 13 {
-		err = ssz.ErrListTooBigFn("ResultData.Operators", size, 13)
+	if size := len(r.Operators); size > 64 {
+		err = ssz.ErrListTooBigFn("ResultData.Operators", size, 64)
 		return
 	}
 	{
@@ -2316,15 +5561,15 @@ func (r *ResultData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = append(dst, r.DepositData...)
 
 	// Field (3) 'KeysharesData'
-	if size := len(r.KeysharesData); size > 32768 {
-		err = ssz.ErrBytesLengthFn("ResultData.KeysharesData", size, 32768)
+	if size := len(r.KeysharesData); size > 262144 {
+		err = ssz.ErrBytesLengthFn("ResultData.KeysharesData", size, 262144)
 		return
 	}
 	dst = append(dst, r.KeysharesData...)
 
 	// Field (4) 'Proofs'
-	if size := len(r.Proofs); size > 32768 {
-		err = ssz.ErrBytesLengthFn("ResultData.Proofs", size, 32768)
+	if size := len(r.Proofs); size > 262144 {
+		err = ssz.ErrBytesLengthFn("ResultData.Proofs", size, 262144)
 		return
 	}
 	dst = append(dst, r.Proofs...)
@@ -2373,7 +5618,7 @@ func (r *ResultData) UnmarshalSSZ(buf []byte) error {
 	// Field (0) 'Operators'
 	{
 		buf = tail[o0:o2]
-		num, err := ssz.DecodeDynamicLength(buf, 13)
+		num, err := ssz.DecodeDynamicLength(buf, 64)
 		if err != nil {
 			return err
 		}
@@ -2407,7 +5652,7 @@ func (r *ResultData) UnmarshalSSZ(buf []byte) error {
 	// Field (3) 'KeysharesData'
 	{
 		buf = tail[o3:o4]
-		if len(buf) > 32768 {
+		if len(buf) > 262144 {
 			return ssz.ErrBytesLength
 		}
 		if cap(r.KeysharesData) == 0 {
@@ -2419,7 +5664,7 @@ func (r *ResultData) UnmarshalSSZ(buf []byte) error {
 	// Field (4) 'Proofs'
 	{
 		buf = tail[o4:]
-		if len(buf) > 32768 {
+		if len(buf) > 262144 {
 			return ssz.ErrBytesLength
 		}
 		if cap(r.Proofs) == 0 {
@@ -2465,7 +5710,7 @@ func (r *ResultData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	{
 		subIndx := hh.Index()
 		num := uint64(len(r.Operators))
-		if num > 13 {
+		if num > 64 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
@@ -2474,7 +5719,7 @@ func (r *ResultData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 				return
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 13)
+		hh.MerkleizeWithMixin(subIndx, num, 64)
 	}
 
 	// Field (1) 'Identifier'
@@ -2496,24 +5741,24 @@ func (r *ResultData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	{
 		elemIndx := hh.Index()
 		byteLen := uint64(len(r.KeysharesData))
-		if byteLen > 32768 {
+		if byteLen > 262144 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
 		hh.Append(r.KeysharesData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32768+31)/32)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (262144+31)/32)
 	}
 
 	// Field (4) 'Proofs'
 	{
 		elemIndx := hh.Index()
 		byteLen := uint64(len(r.Proofs))
-		if byteLen > 32768 {
+		if byteLen > 262144 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
 		hh.Append(r.Proofs)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32768+31)/32)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (262144+31)/32)
 	}
 
 	hh.Merkleize(indx)
@@ -16,6 +16,107 @@ func TestKeysharesJSON(t *testing.T) {
 	require.JSONEq(t, keysharesFixture, string(b))
 }
 
+func TestInitV2SSZ(t *testing.T) {
+	ops := make([]*Operator, MaxInitV2Operators)
+	for i := range ops {
+		ops[i] = &Operator{ID: uint64(i + 1), PubKey: []byte{byte(i)}}
+	}
+	init := &Init{
+		Operators:             ops,
+		T:                     33,
+		WithdrawalCredentials: make([]byte, 32),
+		Fork:                  [4]byte{0, 0, 0, 0},
+		Owner:                 [20]byte{1},
+		Nonce:                 1,
+		WithdrawalPrefix:      2,
+		Amount:                64000000000,
+	}
+	t.Run("init message is limited to 13 operators", func(t *testing.T) {
+		_, err := init.MarshalSSZ()
+		require.Error(t, err)
+	})
+	t.Run("init v2 round trip", func(t *testing.T) {
+		b, err := init.ToV2().MarshalSSZ()
+		require.NoError(t, err)
+		initV2 := &InitV2{}
+		require.NoError(t, initV2.UnmarshalSSZ(b))
+		require.Equal(t, init, initV2.ToInit())
+	})
+	t.Run("init v2 is limited to 64 operators", func(t *testing.T) {
+		tooMany := init.ToV2()
+		tooMany.Operators = append(tooMany.Operators, &Operator{ID: MaxInitV2Operators + 1})
+		_, err := tooMany.MarshalSSZ()
+		require.Error(t, err)
+	})
+	t.Run("init v2 encoding of small clusters equals init", func(t *testing.T) {
+		small := *init
+		small.Operators = ops[:4]
+		b, err := small.MarshalSSZ()
+		require.NoError(t, err)
+		bV2, err := small.ToV2().MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, b, bV2)
+	})
+}
+
+func TestMessagesV2SSZ(t *testing.T) {
+	ops := make([]*Operator, MaxInitV2Operators)
+	for i := range ops {
+		ops[i] = &Operator{ID: uint64(i + 1), PubKey: []byte{byte(i)}}
+	}
+	proofs := make([]*SignedProof, MaxInitV2Operators)
+	for i := range proofs {
+		proofs[i] = &SignedProof{
+			Proof:     &Proof{ValidatorPubKey: make([]byte, 48), EncryptedShare: []byte{byte(i)}, SharePubKey: make([]byte, 48)},
+			Signature: make([]byte, 256),
+		}
+	}
+	reshare := &Reshare{ValidatorPubKey: make([]byte, 48), OldOperators: ops[:4], NewOperators: ops, OldT: 3, NewT: 43, Owner: [20]byte{1}, Nonce: 1}
+	resign := &Resign{ValidatorPubKey: make([]byte, 48), Operators: ops, Owner: [20]byte{1}, Nonce: 1}
+	exit := &Exit{ValidatorPubKey: make([]byte, 48), Operators: ops, ValidatorIndex: 1, Epoch: 2}
+	t.Run("reshare v2 round trip", func(t *testing.T) {
+		require.True(t, reshare.IsV2())
+		_, err := reshare.HashTreeRoot()
+		require.Error(t, err)
+		_, err = reshare.SigningRoot()
+		require.NoError(t, err)
+		msg := &ReshareMessage{SignedReshare: &SignedReshare{Reshare: *reshare, Signature: []byte{1}}, Proofs: proofs[:4], WithdrawalCredentials: make([]byte, 20), WithdrawalPrefix: 1, Amount: 32000000000}
+		b, err := msg.ToV2().MarshalSSZ()
+		require.NoError(t, err)
+		msgV2 := &ReshareMessageV2{}
+		require.NoError(t, msgV2.UnmarshalSSZ(b))
+		require.Equal(t, msg, msgV2.ToReshareMessage())
+	})
+	t.Run("resign v2 round trip", func(t *testing.T) {
+		require.True(t, resign.IsV2())
+		msg := &ResignMessage{SignedResign: &SignedResign{Resign: *resign, Signature: []byte{1}}, Proofs: proofs, WithdrawalCredentials: make([]byte, 20), WithdrawalPrefix: 1, Amount: 32000000000}
+		b, err := msg.ToV2().MarshalSSZ()
+		require.NoError(t, err)
+		msgV2 := &ResignMessageV2{}
+		require.NoError(t, msgV2.UnmarshalSSZ(b))
+		require.Equal(t, msg, msgV2.ToResignMessage())
+	})
+	t.Run("exit v2 round trip", func(t *testing.T) {
+		require.True(t, exit.IsV2())
+		msg := &ExitMessage{SignedExit: &SignedExit{Exit: *exit, Signature: []byte{1}}, Proofs: proofs}
+		b, err := msg.ToV2().MarshalSSZ()
+		require.NoError(t, err)
+		msgV2 := &ExitMessageV2{}
+		require.NoError(t, msgV2.UnmarshalSSZ(b))
+		require.Equal(t, msg, msgV2.ToExitMessage())
+	})
+	t.Run("signing root of small clusters is the root of v1 message", func(t *testing.T) {
+		small := *resign
+		small.Operators = ops[:4]
+		require.False(t, small.IsV2())
+		root, err := small.HashTreeRoot()
+		require.NoError(t, err)
+		signingRoot, err := small.SigningRoot()
+		require.NoError(t, err)
+		require.Equal(t, root, signingRoot)
+	})
+}
+
 const (
 	keysharesFixture = `{
 		"version": "v1.1.0",
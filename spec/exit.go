@@ -0,0 +1,153 @@
+package spec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"golang.org/x/exp/maps"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
+	"github.com/bloxapp/ssv-dkg/pkgs/utils"
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// ValidateExitMessage returns nil if voluntary exit message is valid
+func ValidateExitMessage(
+	exit *wire.Exit,
+	proofs map[*wire.Operator]wire.SignedProof,
+) error {
+	if !UniqueAndOrderedOperators(exit.Operators) {
+		return fmt.Errorf("operators are not unique and ordered")
+	}
+	if !EqualOperators(exit.Operators, OrderOperators(maps.Keys(proofs))) {
+		return fmt.Errorf("missing operator proofs")
+	}
+	if _, err := utils.GetNetworkByFork(exit.Fork); err != nil {
+		return err
+	}
+	owner, err := ProofsOwner(proofs)
+	if err != nil {
+		return err
+	}
+	for operator, proof := range proofs {
+		if err := ValidateCeremonyProof(owner, exit.ValidatorPubKey, operator, proof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExitSigningRoot returns the signing root of the voluntary exit at exit message
+func ExitSigningRoot(exit *wire.Exit) (phase0.Root, error) {
+	network, err := utils.GetNetworkByFork(exit.Fork)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	return crypto.ComputeVoluntaryExitSigningRoot(network, &phase0.VoluntaryExit{
+		Epoch:          phase0.Epoch(exit.Epoch),
+		ValidatorIndex: phase0.ValidatorIndex(exit.ValidatorIndex),
+	})
+}
+
+// ValidateExitResults verifies operators partial signatures over the voluntary exit and reconstructs the
+// validator signature. Invalid partial signatures are skipped, at least threshold of operators partial
+// signatures should be valid. The threshold is recovered from share public keys at the ceremony proofs.
+func ValidateExitResults(
+	exit *wire.Exit,
+	proofs map[*wire.Operator]wire.SignedProof,
+	requestID [24]byte,
+	results []*wire.PartialExit,
+) (*phase0.SignedVoluntaryExit, error) {
+	threshold, err := ProofsThreshold(exit.ValidatorPubKey, proofs)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(results)) < threshold {
+		return nil, fmt.Errorf("not enough partial exit signatures: got %d, threshold %d", len(results), threshold)
+	}
+	signingRoot, err := ExitSigningRoot(exit)
+	if err != nil {
+		return nil, err
+	}
+	var errs error
+	ids := make([]uint64, 0, len(results))
+	sigs := make([]*bls.Sign, 0, len(results))
+	for _, result := range results {
+		if slices.Contains(ids, result.OperatorID) {
+			errs = errors.Join(errs, fmt.Errorf("duplicate partial exit signature of operator %d", result.OperatorID))
+			continue
+		}
+		sig, err := validatePartialExit(exit, proofs, requestID, signingRoot, result)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		ids = append(ids, result.OperatorID)
+		sigs = append(sigs, sig)
+	}
+	if uint64(len(sigs)) < threshold {
+		return nil, errors.Join(fmt.Errorf("not enough valid partial exit signatures: got %d, threshold %d", len(sigs), threshold), errs)
+	}
+	masterSig, err := crypto.RecoverBLSSignature(ids, sigs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover voluntary exit signature: %w", err)
+	}
+	validatorPubKey, err := BLSPKEncode(exit.ValidatorPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !masterSig.VerifyByte(validatorPubKey, signingRoot[:]) {
+		return nil, fmt.Errorf("recovered voluntary exit signature is not valid")
+	}
+	signedExit := &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{
+			Epoch:          phase0.Epoch(exit.Epoch),
+			ValidatorIndex: phase0.ValidatorIndex(exit.ValidatorIndex),
+		},
+	}
+	copy(signedExit.Signature[:], masterSig.Serialize())
+	return signedExit, nil
+}
+
+// validatePartialExit verifies operator's partial signature over the voluntary exit against its share public key
+func validatePartialExit(
+	exit *wire.Exit,
+	proofs map[*wire.Operator]wire.SignedProof,
+	requestID [24]byte,
+	signingRoot phase0.Root,
+	result *wire.PartialExit,
+) (*bls.Sign, error) {
+	if !bytes.Equal(result.RequestID[:], requestID[:]) {
+		return nil, fmt.Errorf("operator %d sent partial exit signature with wrong request ID", result.OperatorID)
+	}
+	operator := GetOperator(exit.Operators, result.OperatorID)
+	if operator == nil {
+		return nil, fmt.Errorf("operator %d not found", result.OperatorID)
+	}
+	var proof *wire.Proof
+	for op, p := range proofs {
+		if op.ID == operator.ID {
+			proof = p.Proof
+			break
+		}
+	}
+	if proof == nil {
+		return nil, fmt.Errorf("missing proof of operator %d", operator.ID)
+	}
+	sharePubKey, err := BLSPKEncode(proof.SharePubKey)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := BLSSignatureEncode(result.PartialSignature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid partial exit signature of operator %d: %w", operator.ID, err)
+	}
+	if err := crypto.VerifyPartialSigs([]*bls.Sign{sig}, []*bls.PublicKey{sharePubKey}, signingRoot[:]); err != nil {
+		return nil, fmt.Errorf("invalid partial exit signature of operator %d", operator.ID)
+	}
+	return sig, nil
+}
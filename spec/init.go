@@ -4,21 +4,143 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
 )
 
+// ThresholdPolicy defines which number of operators and threshold are accepted for a ceremony
+type ThresholdPolicy uint64
+
+const (
+	// SSVThresholdPolicy accepts SSV network clusters only: 4, 7, 10 or 13 operators with 2f+1 threshold
+	SSVThresholdPolicy ThresholdPolicy = iota
+	// CustomThresholdPolicy accepts MinCustomOperators up to wire.MaxInitV2Operators operators
+	// and any threshold from MinCustomThreshold up to the number of operators
+	CustomThresholdPolicy
+)
+
+// MinCustomOperators is the min number of operators at CustomThresholdPolicy
+const MinCustomOperators = 2
+
+func (p ThresholdPolicy) String() string {
+	switch p {
+	case SSVThresholdPolicy:
+		return "ssv"
+	case CustomThresholdPolicy:
+		return "custom"
+	default:
+		return "no policy impl"
+	}
+}
+
+// ParseThresholdPolicy returns threshold policy by its name, empty name is SSVThresholdPolicy
+func ParseThresholdPolicy(name string) (ThresholdPolicy, error) {
+	switch name {
+	case "", SSVThresholdPolicy.String():
+		return SSVThresholdPolicy, nil
+	case CustomThresholdPolicy.String():
+		return CustomThresholdPolicy, nil
+	default:
+		return SSVThresholdPolicy, fmt.Errorf("unknown threshold policy %s", name)
+	}
+}
+
+// ValidThresholdSet returns true if the number of operators and threshold is valid under the policy
+func (p ThresholdPolicy) ValidThresholdSet(t uint64, operators []*wire.Operator) bool {
+	switch p {
+	case SSVThresholdPolicy:
+		return ValidThresholdSet(t, operators)
+	case CustomThresholdPolicy:
+		return ValidCustomThresholdSet(t, operators)
+	default:
+		return false
+	}
+}
+
 // ValidateInitMessage returns nil if init message is valid
 func ValidateInitMessage(init *wire.Init) error {
+	return ValidateInitMessageWithPolicy(init, SSVThresholdPolicy)
+}
+
+// ValidateInitMessageWithPolicy returns nil if init message is valid under the threshold policy
+func ValidateInitMessageWithPolicy(init *wire.Init, policy ThresholdPolicy) error {
 	if !UniqueAndOrderedOperators(init.Operators) {
 		return fmt.Errorf("operators not unique or not ordered")
 	}
-	if !ValidThresholdSet(init.T, init.Operators) {
+	if !policy.ValidThresholdSet(init.T, init.Operators) {
 		return fmt.Errorf("threshold set is invalid")
 	}
+	if err := ValidateDepositParams(init.WithdrawalPrefix, phase0.Gwei(init.Amount)); err != nil {
+		return err
+	}
+	if err := validateInitWithdrawalCredentials(init.WithdrawalPrefix, init.WithdrawalCredentials); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateDepositParams returns nil if withdrawal credentials type is 0x00 (BLS), 0x01 or 0x02 (compounding)
+// and the amount is a valid deposit for it
+func ValidateDepositParams(withdrawalPrefix byte, amount phase0.Gwei) error {
+	switch withdrawalPrefix {
+	case crypto.BLSWithdrawalPrefixByte, crypto.ETH1WithdrawalPrefixByte, crypto.CompoundingWithdrawalPrefixByte:
+	default:
+		return fmt.Errorf("withdrawal credentials type %#x is invalid", withdrawalPrefix)
+	}
+	if err := crypto.ValidateDepositAmount(withdrawalPrefix, amount); err != nil {
+		return fmt.Errorf("deposit amount is invalid: %w", err)
+	}
+	return nil
+}
 
+// validateInitWithdrawalCredentials checks that init message of 0x00 type carries full withdrawal credentials
+// of a BLS withdrawal key. For 0x01 and 0x02 types the field is the withdrawal address as in earlier versions,
+// deposit data gets its first 20 bytes.
+func validateInitWithdrawalCredentials(withdrawalPrefix byte, withdrawalCredentials []byte) error {
+	if withdrawalPrefix != crypto.BLSWithdrawalPrefixByte {
+		return nil
+	}
+	if len(withdrawalCredentials) != 32 || withdrawalCredentials[0] != crypto.BLSWithdrawalPrefixByte {
+		return fmt.Errorf("BLS withdrawal credentials are invalid")
+	}
 	return nil
 }
 
+// InitWithdrawalCredentials returns withdrawal credentials of deposit data created by the init message
+func InitWithdrawalCredentials(init *wire.Init) []byte {
+	if init.WithdrawalPrefix == crypto.BLSWithdrawalPrefixByte {
+		return init.WithdrawalCredentials
+	}
+	return crypto.WithdrawalCredentials(init.WithdrawalPrefix, init.WithdrawalCredentials)
+}
+
+// InitWithdrawalAddress returns the withdrawal address at withdrawal credentials created by the init message
+func InitWithdrawalAddress(init *wire.Init) common.Address {
+	_, withdrawAddress := crypto.ParseWithdrawalCredentials(InitWithdrawalCredentials(init))
+	return common.BytesToAddress(withdrawAddress)
+}
+
+// InitDepositAmount returns the deposit amount of validators created by the init message
+func InitDepositAmount(init *wire.Init) phase0.Gwei {
+	return phase0.Gwei(init.Amount)
+}
+
+// AddressWithdrawalCredentials validates withdrawal credentials type and deposit amount of reshare and resign
+// messages, and returns withdrawal credentials and deposit amount of their deposit data
+func AddressWithdrawalCredentials(withdrawalPrefix byte, amount uint64, withdraw []byte) ([]byte, phase0.Gwei, error) {
+	if withdrawalPrefix == crypto.BLSWithdrawalPrefixByte {
+		return nil, 0, fmt.Errorf("withdrawal credentials type %#x requires a BLS withdrawal key", withdrawalPrefix)
+	}
+	if err := ValidateDepositParams(withdrawalPrefix, phase0.Gwei(amount)); err != nil {
+		return nil, 0, err
+	}
+	return crypto.WithdrawalCredentials(withdrawalPrefix, withdraw), phase0.Gwei(amount), nil
+}
+
 // ValidThresholdSet returns true if the number of operators and threshold is valid
 func ValidThresholdSet(t uint64, operators []*wire.Operator) bool {
 	if len(operators) == 4 && t == 3 { // 2f+1 = 3
@@ -36,6 +158,20 @@ func ValidThresholdSet(t uint64, operators []*wire.Operator) bool {
 	return false
 }
 
+// ValidCustomThresholdSet returns true if the number of operators is within MinCustomOperators and wire.MaxInitV2Operators
+// and threshold is at least MinCustomThreshold, so that two disjoint sets of operators cant both reach it
+func ValidCustomThresholdSet(t uint64, operators []*wire.Operator) bool {
+	if len(operators) < MinCustomOperators || len(operators) > wire.MaxInitV2Operators {
+		return false
+	}
+	return t >= MinCustomThreshold(len(operators)) && t <= uint64(len(operators))
+}
+
+// MinCustomThreshold returns the lowest safe threshold for the number of operators, a majority of them
+func MinCustomThreshold(operators int) uint64 {
+	return uint64(operators/2 + 1)
+}
+
 // UniqueAndOrderedOperators returns true if array of operators are unique and ordered (no duplicate IDs)
 func UniqueAndOrderedOperators(operators []*wire.Operator) bool {
 	highestID := uint64(0)
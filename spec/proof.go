@@ -4,6 +4,11 @@ import (
 	"bytes"
 	"fmt"
 
+	kyber_bls12381 "github.com/drand/kyber-bls12381"
+	kyber_dkg "github.com/drand/kyber/share/dkg"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"golang.org/x/exp/maps"
+
 	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
 )
@@ -57,3 +62,36 @@ func VerifyCeremonyProof(pkBytes []byte, proof wire.SignedProof) error {
 	}
 	return crypto.VerifyRSA(pk, hash[:], proof.Signature)
 }
+
+// ProofsThreshold returns the threshold of the validator key recovered from share public keys at ceremony proofs
+// of operators: the number of coefficients of the lowest degree public polynomial all share public keys are on.
+// The polynomial should be the public polynomial of the validator key.
+func ProofsThreshold(validatorPK []byte, proofs map[*wire.Operator]wire.SignedProof) (uint64, error) {
+	if len(proofs) == 0 {
+		return 0, fmt.Errorf("no proofs")
+	}
+	operators := OrderOperators(maps.Keys(proofs))
+	ids := make([]uint64, 0, len(operators))
+	sharePks := make([]*bls.PublicKey, 0, len(operators))
+	for _, op := range operators {
+		proof := proofs[op]
+		sharePk, err := BLSPKEncode(proof.Proof.SharePubKey)
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode share public key of operator %d: %w", op.ID, err)
+		}
+		ids = append(ids, op.ID)
+		sharePks = append(sharePks, sharePk)
+	}
+	commits, err := crypto.RecoverCommits(ids, sharePks, kyber_bls12381.NewBLS12381Suite().G1().(kyber_dkg.Suite))
+	if err != nil {
+		return 0, err
+	}
+	commit, err := commits[0].MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(commit, validatorPK) {
+		return 0, fmt.Errorf("validator public key doesnt match public polynomial of share public keys")
+	}
+	return uint64(len(commits)), nil
+}
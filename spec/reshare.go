@@ -1,6 +1,7 @@
 package spec
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 
@@ -13,6 +14,15 @@ import (
 func ValidateReshareMessage(
 	reshare *wire.Reshare,
 	proofs map[*wire.Operator]wire.SignedProof,
+) error {
+	return ValidateReshareMessageWithPolicy(reshare, proofs, SSVThresholdPolicy)
+}
+
+// ValidateReshareMessageWithPolicy returns nil if re-share message is valid under the threshold policy
+func ValidateReshareMessageWithPolicy(
+	reshare *wire.Reshare,
+	proofs map[*wire.Operator]wire.SignedProof,
+	policy ThresholdPolicy,
 ) error {
 	if !UniqueAndOrderedOperators(reshare.OldOperators) {
 		return fmt.Errorf("old operators are not unique and ordered")
@@ -32,10 +42,15 @@ func ValidateReshareMessage(
 	if EqualOperators(reshare.OldOperators, reshare.NewOperators) {
 		return fmt.Errorf("old and new operators are the same")
 	}
-	if !ValidThresholdSet(reshare.OldT, reshare.OldOperators) {
+	for _, op := range reshare.NewOperators {
+		if oldOp := GetOperator(reshare.OldOperators, op.ID); oldOp != nil && !bytes.Equal(oldOp.PubKey, op.PubKey) {
+			return fmt.Errorf("operator %d has different public keys at old and new operators", op.ID)
+		}
+	}
+	if !policy.ValidThresholdSet(reshare.OldT, reshare.OldOperators) {
 		return fmt.Errorf("old threshold set is invalid")
 	}
-	if !ValidThresholdSet(reshare.NewT, reshare.NewOperators) {
+	if !policy.ValidThresholdSet(reshare.NewT, reshare.NewOperators) {
 		return fmt.Errorf("new threshold set is invalid")
 	}
 
@@ -48,3 +63,15 @@ func OrderOperators(in []*wire.Operator) []*wire.Operator {
 	})
 	return in
 }
+
+// ReshareOperators returns old and new operators participating in re-share ordered by ID
+func ReshareOperators(reshare *wire.Reshare) []*wire.Operator {
+	ops := make([]*wire.Operator, 0, len(reshare.OldOperators)+len(reshare.NewOperators))
+	ops = append(ops, reshare.OldOperators...)
+	for _, op := range reshare.NewOperators {
+		if GetOperator(ops, op.ID) == nil {
+			ops = append(ops, op)
+		}
+	}
+	return OrderOperators(ops)
+}
@@ -0,0 +1,43 @@
+package spec
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/bloxapp/ssv-dkg/pkgs/wire"
+)
+
+// ValidateResignMessage returns nil if re-sign message is valid
+func ValidateResignMessage(
+	resign *wire.Resign,
+	proofs map[*wire.Operator]wire.SignedProof,
+) error {
+	if !UniqueAndOrderedOperators(resign.Operators) {
+		return fmt.Errorf("operators are not unique and ordered")
+	}
+	if !EqualOperators(resign.Operators, OrderOperators(maps.Keys(proofs))) {
+		return fmt.Errorf("missing operator proofs")
+	}
+	owner, err := ProofsOwner(proofs)
+	if err != nil {
+		return err
+	}
+	for operator, proof := range proofs {
+		if err := ValidateCeremonyProof(owner, resign.ValidatorPubKey, operator, proof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProofsOwner returns the owner of the validator at ceremony proofs
+func ProofsOwner(proofs map[*wire.Operator]wire.SignedProof) ([20]byte, error) {
+	for _, proof := range proofs {
+		if proof.Proof == nil {
+			return [20]byte{}, fmt.Errorf("empty proof")
+		}
+		return proof.Proof.Owner, nil
+	}
+	return [20]byte{}, fmt.Errorf("no proofs provided")
+}
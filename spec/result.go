@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	kyber_bls12381 "github.com/drand/kyber-bls12381"
+	kyber_dkg "github.com/drand/kyber/share/dkg"
 	"github.com/ethereum/go-ethereum/common"
 	eth_crypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/herumi/bls-eth-go-binary/bls"
@@ -14,10 +16,11 @@ import (
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
 )
 
-// ValidateResults returns nil if results array is valid
+// ValidateResults returns nil if results array is valid, withdrawal credentials are the full 32 bytes credentials of deposit data
 func ValidateResults(
 	operators []*wire.Operator,
 	withdrawalCredentials []byte,
+	amount phase0.Gwei,
 	validatorPK []byte,
 	fork [4]byte,
 	ownerAddress [20]byte,
@@ -36,13 +39,16 @@ func ValidateResults(
 	if !bytes.Equal(validatorPK, pk) {
 		return nil, nil, nil, fmt.Errorf("invalid recovered validator pubkey")
 	}
+	if _, err := ValidateCommits(validatorPK, results); err != nil {
+		return nil, nil, nil, err
+	}
 
 	ids := make([]uint64, 0, len(results))
 	sharePubKeys := make([]*bls.PublicKey, 0, len(results))
 	sigsPartialDeposit := make([]*bls.Sign, 0, len(results))
 	sigsPartialOwnerNonce := make([]*bls.Sign, 0, len(results))
 	for _, result := range results {
-		if err := ValidateResult(operators, ownerAddress, requestID, withdrawalCredentials, validatorPK, fork, nonce, result); err != nil {
+		if err := ValidateResult(operators, ownerAddress, requestID, withdrawalCredentials, amount, validatorPK, fork, nonce, result); err != nil {
 			return nil, nil, nil, err
 		}
 		pub, deposit, ownerNonce, err := GetPartialSigsFromResult(result)
@@ -68,8 +74,8 @@ func ValidateResults(
 	}
 	depositData := &phase0.DepositData{
 		PublicKey:             phase0.BLSPubKey(validatorRecoveredPK.Serialize()),
-		Amount:                crypto.MaxEffectiveBalanceInGwei,
-		WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(withdrawalCredentials),
+		Amount:                amount,
+		WithdrawalCredentials: withdrawalCredentials,
 		Signature:             phase0.BLSSignature(masterDepositSig.Serialize()),
 	}
 	err = crypto.VerifyDepositData(network, depositData)
@@ -90,6 +96,7 @@ func ValidateResult(
 	ownerAddress [20]byte,
 	requestID [24]byte,
 	withdrawalCredentials []byte,
+	amount phase0.Gwei,
 	validatorPK []byte,
 	fork [4]byte,
 	nonce uint64,
@@ -108,6 +115,7 @@ func ValidateResult(
 
 	if err := VerifyPartialSignatures(
 		withdrawalCredentials,
+		amount,
 		fork,
 		ownerAddress,
 		nonce,
@@ -129,6 +137,56 @@ func ValidateResult(
 	return nil
 }
 
+// ValidateCommits checks that all operators sent the same commits of the public polynomial, the validator public key
+// is its constant coefficient and share public key of every operator is the polynomial evaluated at the operator index.
+// Returns the threshold of the distributed key, the number of commits. Commits are verified only when every operator
+// sent them, results without commits, e.g. of resigning, return 0. Commits missing at some results are an error,
+// since all operators of the same version send them.
+func ValidateCommits(validatorPK []byte, results []*wire.Result) (uint64, error) {
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no results")
+	}
+	var missing []uint64
+	for _, result := range results {
+		if len(result.Commits) == 0 {
+			missing = append(missing, result.OperatorID)
+		}
+	}
+	if len(missing) == len(results) {
+		return 0, nil
+	}
+	if len(missing) != 0 {
+		return 0, fmt.Errorf("operators %v didnt send commits", missing)
+	}
+	for _, result := range results[1:] {
+		if !bytes.Equal(result.Commits, results[0].Commits) {
+			return 0, fmt.Errorf("commits of operator %d differ from commits of operator %d", result.OperatorID, results[0].OperatorID)
+		}
+	}
+	suite := kyber_bls12381.NewBLS12381Suite().G1().(kyber_dkg.Suite)
+	commits, err := crypto.DecodeCommits(results[0].Commits, suite)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode commits: %w", err)
+	}
+	commit, err := commits[0].MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(commit, validatorPK) {
+		return 0, fmt.Errorf("validator public key doesnt match commits")
+	}
+	for _, result := range results {
+		sharePK, err := BLSPKEncode(result.SignedProof.Proof.SharePubKey)
+		if err != nil {
+			return 0, err
+		}
+		if err := crypto.VerifySharePubKey(commits, result.OperatorID, sharePK, suite); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(commits)), nil
+}
+
 // RecoverValidatorPKFromResults returns validator PK recovered from results
 func RecoverValidatorPKFromResults(results []*wire.Result) ([]byte, error) {
 	ids := make([]uint64, len(results))
@@ -153,6 +211,7 @@ func RecoverValidatorPKFromResults(results []*wire.Result) ([]byte, error) {
 
 func VerifyPartialSignatures(
 	withdrawalCredentials []byte,
+	amount phase0.Gwei,
 	fork [4]byte,
 	ownerAddress [20]byte,
 	nonce uint64,
@@ -175,6 +234,7 @@ func VerifyPartialSignatures(
 
 	if err := VerifyPartialDepositDataSignatures(
 		withdrawalCredentials,
+		amount,
 		fork,
 		result.SignedProof.Proof.ValidatorPubKey,
 		[]*bls.Sign{depositSig},
@@ -209,6 +269,7 @@ func VerifyPartialNonceSignatures(
 
 func VerifyPartialDepositDataSignatures(
 	withdrawalCredentials []byte,
+	amount phase0.Gwei,
 	fork [4]byte,
 	validatorPubKey []byte,
 	sigs []*bls.Sign,
@@ -221,8 +282,8 @@ func VerifyPartialDepositDataSignatures(
 
 	shareRoot, err := crypto.ComputeDepositMessageSigningRoot(network, &phase0.DepositMessage{
 		PublicKey:             phase0.BLSPubKey(validatorPubKey),
-		Amount:                crypto.MaxEffectiveBalanceInGwei,
-		WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(withdrawalCredentials)})
+		Amount:                amount,
+		WithdrawalCredentials: withdrawalCredentials})
 	if err != nil {
 		return fmt.Errorf("failed to compute deposit data root")
 	}
@@ -3,9 +3,11 @@ package spec
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	eth_crypto "github.com/ethereum/go-ethereum/crypto"
@@ -28,7 +30,8 @@ func RunDKG(init *wire.Init) ([]*wire.Result, error) {
 	*/
 	_, _, _, err := ValidateResults(
 		init.Operators,
-		init.WithdrawalCredentials,
+		InitWithdrawalCredentials(init),
+		InitDepositAmount(init),
 		results[0].SignedProof.Proof.ValidatorPubKey,
 		init.Fork,
 		init.Owner,
@@ -41,6 +44,8 @@ func RunDKG(init *wire.Init) ([]*wire.Result, error) {
 func RunReshare(
 	validatorPK []byte,
 	withdrawalCredentials []byte,
+	withdrawalPrefix byte,
+	amount uint64,
 	fork [4]byte,
 	signedReshare *wire.SignedReshare,
 	proofs map[*wire.Operator]wire.SignedProof,
@@ -53,6 +58,10 @@ func RunReshare(
 	if err := ValidateReshareMessage(&signedReshare.Reshare, proofs); err != nil {
 		return nil, err
 	}
+	credentials, gwei, err := AddressWithdrawalCredentials(withdrawalPrefix, amount, withdrawalCredentials)
+	if err != nil {
+		return nil, err
+	}
 
 	id := crypto.NewID()
 
@@ -60,9 +69,10 @@ func RunReshare(
 	/*
 		DKG ceremony ...
 	*/
-	_, _, _, err := ValidateResults(
+	_, _, _, err = ValidateResults(
 		signedReshare.Reshare.NewOperators,
-		withdrawalCredentials,
+		credentials,
+		gwei,
 		validatorPK,
 		fork,
 		signedReshare.Reshare.Owner,
@@ -72,52 +82,156 @@ func RunReshare(
 	return results, err
 }
 
+func RunResign(
+	withdrawalCredentials []byte,
+	withdrawalPrefix byte,
+	amount uint64,
+	fork [4]byte,
+	signedResign *wire.SignedResign,
+	proofs map[*wire.Operator]wire.SignedProof,
+	client eip1271.ETHClient,
+) ([]*wire.Result, error) {
+	if err := ValidateResignMessage(&signedResign.Resign, proofs); err != nil {
+		return nil, err
+	}
+	credentials, gwei, err := AddressWithdrawalCredentials(withdrawalPrefix, amount, withdrawalCredentials)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := ProofsOwner(proofs)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifySignedResign(client, signedResign, owner); err != nil {
+		return nil, err
+	}
+
+	id := crypto.NewID()
+
+	var results []*wire.Result
+	/*
+		Operators sign new owner and nonce with their key shares ...
+	*/
+	_, _, _, err = ValidateResults(
+		signedResign.Resign.Operators,
+		credentials,
+		gwei,
+		signedResign.Resign.ValidatorPubKey,
+		fork,
+		signedResign.Resign.Owner,
+		signedResign.Resign.Nonce,
+		id,
+		results)
+	return results, err
+}
+
+func RunExit(
+	signedExit *wire.SignedExit,
+	proofs map[*wire.Operator]wire.SignedProof,
+	client eip1271.ETHClient,
+) (*phase0.SignedVoluntaryExit, error) {
+	if err := ValidateExitMessage(&signedExit.Exit, proofs); err != nil {
+		return nil, err
+	}
+
+	owner, err := ProofsOwner(proofs)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifySignedExit(client, signedExit, owner); err != nil {
+		return nil, err
+	}
+
+	id := crypto.NewID()
+
+	var results []*wire.PartialExit
+	/*
+		Operators sign voluntary exit with their key shares ...
+	*/
+	return ValidateExitResults(&signedExit.Exit, proofs, id, results)
+}
+
 // VerifySignedReshare returns nil if signature over re-share message is valid
 func VerifySignedReshare(client eip1271.ETHClient, signedReshare *wire.SignedReshare) error {
-	isEOASignature, err := IsEOAAccount(client, signedReshare.Reshare.Owner)
+	hash, err := signedReshare.Reshare.SigningRoot()
 	if err != nil {
 		return err
 	}
+	err = VerifyOwnerSignature(client, signedReshare.Reshare.Owner, hash, signedReshare.Signature)
+	if errors.Is(err, ErrInvalidOwnerSignature) {
+		return fmt.Errorf("invalid signed reshare signature")
+	}
+	return err
+}
+
+// VerifySignedResign returns nil if signature over re-sign message is valid. Re-sign should be signed by the owner at ceremony proofs
+func VerifySignedResign(client eip1271.ETHClient, signedResign *wire.SignedResign, owner [20]byte) error {
+	hash, err := signedResign.Resign.SigningRoot()
+	if err != nil {
+		return err
+	}
+	return VerifyOwnerSignature(client, owner, hash, signedResign.Signature)
+}
 
-	hash, err := signedReshare.Reshare.HashTreeRoot()
+// VerifySignedExit returns nil if signature over voluntary exit message is valid. Exit should be signed by the owner at ceremony proofs
+func VerifySignedExit(client eip1271.ETHClient, signedExit *wire.SignedExit, owner [20]byte) error {
+	hash, err := signedExit.Exit.SigningRoot()
 	if err != nil {
 		return err
 	}
+	return VerifyOwnerSignature(client, owner, hash, signedExit.Signature)
+}
 
+// VerifyOwnerSignature returns nil if the owner signature over hash is valid, supports EOA and EIP-1271 signatures
+func VerifyOwnerSignature(client eip1271.ETHClient, owner [20]byte, hash [32]byte, signature []byte) error {
+	isEOASignature, err := IsEOAAccount(client, owner)
+	if err != nil {
+		return err
+	}
 	if isEOASignature {
-		pk, err := eth_crypto.SigToPub(hash[:], signedReshare.Signature)
-		if err != nil {
-			return err
-		}
-
-		address := eth_crypto.PubkeyToAddress(*pk)
-
-		if common.Address(signedReshare.Reshare.Owner).Cmp(address) != 0 {
-			return fmt.Errorf("invalid signed reshare signature")
-		}
-	} else {
-		// EIP 1271 signature
-		// gnosis implementation https://github.com/safe-global/safe-smart-account/blob/2278f7ccd502878feb5cec21dd6255b82df374b5/contracts/Safe.sol#L265
-		// https://github.com/safe-global/safe-smart-account/blob/main/docs/signatures.md
-		// ... verify via contract call
-		signerVerification, err := eip1271.NewEip1271(signedReshare.Reshare.Owner, client)
-		if err != nil {
-			return err
-		}
-		res, err := signerVerification.IsValidSignature(&bind.CallOpts{
-			Context: context.Background(),
-		}, hash[:], signedReshare.Signature)
-		if err != nil {
-			return err
-		}
-		if !bytes.Equal(eip1271.MagicValue[:], res[:]) {
-			return fmt.Errorf("signature invalid")
-		}
+		return VerifyEOASignature(owner, hash, signature)
+	}
+
+	// EIP 1271 signature
+	// gnosis implementation https://github.com/safe-global/safe-smart-account/blob/2278f7ccd502878feb5cec21dd6255b82df374b5/contracts/Safe.sol#L265
+	// https://github.com/safe-global/safe-smart-account/blob/main/docs/signatures.md
+	// ... verify via contract call
+	signerVerification, err := eip1271.NewEip1271(owner, client)
+	if err != nil {
+		return err
+	}
+	res, err := signerVerification.IsValidSignature(&bind.CallOpts{
+		Context: context.Background(),
+	}, hash[:], signature)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(eip1271.MagicValue[:], res[:]) {
+		return fmt.Errorf("signature invalid")
 	}
 
 	return nil
 }
 
+// ErrInvalidOwnerSignature is returned when a message isn't signed by the owner's externally owned account
+var ErrInvalidOwnerSignature = errors.New("invalid owner signature")
+
+// VerifyEOASignature returns nil if hash is signed by the owner's externally owned account
+func VerifyEOASignature(owner [20]byte, hash [32]byte, signature []byte) error {
+	pk, err := eth_crypto.SigToPub(hash[:], signature)
+	if err != nil {
+		return err
+	}
+
+	address := eth_crypto.PubkeyToAddress(*pk)
+
+	if common.Address(owner).Cmp(address) != 0 {
+		return ErrInvalidOwnerSignature
+	}
+	return nil
+}
+
 func IsEOAAccount(client eip1271.ETHClient, address common.Address) (bool, error) {
 	block, err := client.BlockNumber(context.Background())
 	if err != nil {
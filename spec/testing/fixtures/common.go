@@ -14,11 +14,13 @@ import (
 )
 
 var (
-	TestWithdrawalCred = make([]byte, 40)
-	TestFork           = [4]byte{0, 0, 0, 0}
-	TestNonce          = uint64(0)
-	TestOwnerAddress   = common.Address{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
-	TestRequestID      = [24]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}
+	TestWithdrawalCred        = make([]byte, 40)
+	TestWithdrawalCredentials = crypto.ETH1WithdrawalCredentials(TestWithdrawalCred)
+	TestAmount                = crypto.MaxEffectiveBalanceInGwei
+	TestFork                  = [4]byte{0, 0, 0, 0}
+	TestNonce                 = uint64(0)
+	TestOwnerAddress          = common.Address{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	TestRequestID             = [24]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}
 )
 
 func GenerateOperators(amount int) []*wire.Operator {
@@ -10,6 +10,7 @@ func Results4Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator1DepositSignature4Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator1NonceSignature4Operators),
 			SignedProof:                TestOperator1Proof4Operators,
+			Commits:                    DecodeHexNoError(TestCommits4Operators),
 		},
 		{
 			OperatorID:                 2,
@@ -17,6 +18,7 @@ func Results4Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator2DepositSignature4Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator2NonceSignature4Operators),
 			SignedProof:                TestOperator2Proof4Operators,
+			Commits:                    DecodeHexNoError(TestCommits4Operators),
 		},
 		{
 			OperatorID:                 3,
@@ -24,6 +26,7 @@ func Results4Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator3DepositSignature4Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator3NonceSignature4Operators),
 			SignedProof:                TestOperator3Proof4Operators,
+			Commits:                    DecodeHexNoError(TestCommits4Operators),
 		},
 		{
 			OperatorID:                 4,
@@ -31,6 +34,7 @@ func Results4Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator4DepositSignature4Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator4NonceSignature4Operators),
 			SignedProof:                TestOperator4Proof4Operators,
+			Commits:                    DecodeHexNoError(TestCommits4Operators),
 		},
 	}
 }
@@ -43,6 +47,7 @@ func Results7Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator1DepositSignature7Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator1NonceSignature7Operators),
 			SignedProof:                TestOperator1Proof7Operators,
+			Commits:                    DecodeHexNoError(TestCommits7Operators),
 		},
 		{
 			OperatorID:                 2,
@@ -50,6 +55,7 @@ func Results7Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator2DepositSignature7Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator2NonceSignature7Operators),
 			SignedProof:                TestOperator2Proof7Operators,
+			Commits:                    DecodeHexNoError(TestCommits7Operators),
 		},
 		{
 			OperatorID:                 3,
@@ -57,6 +63,7 @@ func Results7Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator3DepositSignature7Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator3NonceSignature7Operators),
 			SignedProof:                TestOperator3Proof7Operators,
+			Commits:                    DecodeHexNoError(TestCommits7Operators),
 		},
 		{
 			OperatorID:                 4,
@@ -64,6 +71,7 @@ func Results7Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator4DepositSignature7Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator4NonceSignature7Operators),
 			SignedProof:                TestOperator4Proof7Operators,
+			Commits:                    DecodeHexNoError(TestCommits7Operators),
 		},
 		{
 			OperatorID:                 5,
@@ -71,6 +79,7 @@ func Results7Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator5DepositSignature7Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator5NonceSignature7Operators),
 			SignedProof:                TestOperator5Proof7Operators,
+			Commits:                    DecodeHexNoError(TestCommits7Operators),
 		},
 		{
 			OperatorID:                 6,
@@ -78,6 +87,7 @@ func Results7Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator6DepositSignature7Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator6NonceSignature7Operators),
 			SignedProof:                TestOperator6Proof7Operators,
+			Commits:                    DecodeHexNoError(TestCommits7Operators),
 		},
 		{
 			OperatorID:                 7,
@@ -85,6 +95,7 @@ func Results7Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator7DepositSignature7Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator7NonceSignature7Operators),
 			SignedProof:                TestOperator7Proof7Operators,
+			Commits:                    DecodeHexNoError(TestCommits7Operators),
 		},
 	}
 }
@@ -97,6 +108,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator1DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator1NonceSignature10Operators),
 			SignedProof:                TestOperator1Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 2,
@@ -104,6 +116,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator2DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator2NonceSignature10Operators),
 			SignedProof:                TestOperator2Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 3,
@@ -111,6 +124,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator3DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator3NonceSignature10Operators),
 			SignedProof:                TestOperator3Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 4,
@@ -118,6 +132,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator4DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator4NonceSignature10Operators),
 			SignedProof:                TestOperator4Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 5,
@@ -125,6 +140,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator5DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator5NonceSignature10Operators),
 			SignedProof:                TestOperator5Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 6,
@@ -132,6 +148,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator6DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator6NonceSignature10Operators),
 			SignedProof:                TestOperator6Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 7,
@@ -139,6 +156,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator7DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator7NonceSignature10Operators),
 			SignedProof:                TestOperator7Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 8,
@@ -146,6 +164,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator8DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator8NonceSignature10Operators),
 			SignedProof:                TestOperator8Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 9,
@@ -153,6 +172,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator9DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator9NonceSignature10Operators),
 			SignedProof:                TestOperator9Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 		{
 			OperatorID:                 10,
@@ -160,6 +180,7 @@ func Results10Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator10DepositSignature10Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator10NonceSignature10Operators),
 			SignedProof:                TestOperator10Proof10Operators,
+			Commits:                    DecodeHexNoError(TestCommits10Operators),
 		},
 	}
 }
@@ -172,6 +193,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator1DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator1NonceSignature13Operators),
 			SignedProof:                TestOperator1Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 2,
@@ -179,6 +201,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator2DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator2NonceSignature13Operators),
 			SignedProof:                TestOperator2Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 3,
@@ -186,6 +209,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator3DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator3NonceSignature13Operators),
 			SignedProof:                TestOperator3Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 4,
@@ -193,6 +217,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator4DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator4NonceSignature13Operators),
 			SignedProof:                TestOperator4Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 5,
@@ -200,6 +225,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator5DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator5NonceSignature13Operators),
 			SignedProof:                TestOperator5Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 6,
@@ -207,6 +233,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator6DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator6NonceSignature13Operators),
 			SignedProof:                TestOperator6Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 7,
@@ -214,6 +241,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator7DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator7NonceSignature13Operators),
 			SignedProof:                TestOperator7Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 8,
@@ -221,6 +249,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator8DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator8NonceSignature13Operators),
 			SignedProof:                TestOperator8Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 9,
@@ -228,6 +257,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator9DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator9NonceSignature13Operators),
 			SignedProof:                TestOperator9Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 10,
@@ -235,6 +265,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator10DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator10NonceSignature13Operators),
 			SignedProof:                TestOperator10Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 11,
@@ -242,6 +273,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator11DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator11NonceSignature13Operators),
 			SignedProof:                TestOperator11Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 12,
@@ -249,6 +281,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator12DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator12NonceSignature13Operators),
 			SignedProof:                TestOperator12Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 		{
 			OperatorID:                 13,
@@ -256,6 +289,7 @@ func Results13Operators() []*wire.Result {
 			DepositPartialSignature:    DecodeHexNoError(TestOperator13DepositSignature13Operators),
 			OwnerNoncePartialSignature: DecodeHexNoError(TestOperator13NonceSignature13Operators),
 			SignedProof:                TestOperator13Proof13Operators,
+			Commits:                    DecodeHexNoError(TestCommits13Operators),
 		},
 	}
 }
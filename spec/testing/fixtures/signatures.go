@@ -83,3 +83,10 @@ var (
 	TestOperator12NonceSignature13Operators = "afb51afa0673a5d513b5e29a99a91d5fa91fc6368975c79f5625c842a38f5838936a948283e2985d6e88c03ed47557890f9e7d622eb5bef57280a685823996dc4432cc36c9dcb8455627b048cc3530cf61e73f150ae3ad47116a4a3ca37c95d9"
 	TestOperator13NonceSignature13Operators = "97e8ca9ac34b3841878df6774cb700ac2218fe8733409809909d2b022fb790ac89ee1a7faf235b93e69b06c93a97a83d09db2ee44b43048cabe5d37c4dbd90e602544e1620672eaf625623f0ff7c00cca324d6f086ec0cb66b301f8145bb02b1"
 )
+
+var (
+	TestCommits4Operators  = "98c17341aa2f38da6895429bf1cd5b15bcdc9d621fb66632de81062b33b18d4f38941218d01d50580bde0329c7b30f0eacf685bb415c81228c8e0679dac5da3e74e334b4ca16ff73f839eac729c1155f0f8d3f161b2ec6016125af7754c88a4eb9efe80de3b630bffab15db7a85cc78cf11b1be5ce72dd69eba0414c427b7e839168c34f53bc212040c20825cb35890a"
+	TestCommits7Operators  = "8b25eb7b4c222998d1a6325ac16aa5a04e6c00bd42a2649f923c09094e180688d8944d61812fe4005f2a5fab168975c2b2155048238782b9221ed0cc1037813ff77f9850b01090d695bb397937a68ad791b1ad5cc526f82b655e67e8c63b77a1b6d8faa2a15d6594cfcf6b6ddd7fd57483c76abbf8d47d99872cf7f7dec082d8cda6ebd369910c401a357bb788afeb86aac89213f3cc898f85c299aab4b42af62b7c4aa3dda9b2314241f1293029021a20358b0e5f3aa70bbda0a6eb57367b2f8270e0224930dbc079ba5e25ff3b12c66814753e1199a04840c9ce02f22da722fcef97d99336a6a90120258c03276f21"
+	TestCommits10Operators = "b0ac0371342c78a51231a57df5ec8cd5a8abb8bcebfc952b481dc941e6edf841ebd8a79f3d4af0c6ac578a963a7d97f78c9e3cb24b6192802c76486f9d92bd7c51bfa9d7a931ed183b3d22e19667a130ae0405e80f85a60ba1ffbb0d6ebf3aa6a4b54957a604e5966ea496992447e68a6823f546c9fe10a12870cfa3864a1befb88faa0d18fcaa06ed5b7aa6dcce8372816e6db7a88b90f6b0c1b267e3a202e5c9ec379dbe04300da05e5c67bf73f17b44868965832e7ac1a9211ada99892e0fb5e4afca1c5192783223b467a8f4a2bf82f7abf39a12274aae933ec42d846407c1e59fd68fa2c7c4047e44e3e5b6322bae7d114ee4620260a079eab8a5533f075635f77350233a26431259cedaa48a4ef90bea90eb57a50082c20d5b547493d1a02b9af6add73fe260bc9eb20cdbcffcf393b4d673f73daa2589df3090d7e959b04ec3bc88ddd4888ac49a19e8f7fb53"
+	TestCommits13Operators = "94578caa2c37e9044ddcd9f87d54d45f8a849241801d09f2b20228c072631c6978fc1b95df20d04eaa7d91242cea4ed38919fe82a2d498098be9c5ea2aef14032c5ae61eacef20e6e3770ae2e21d2792ba12663ed43e51db0c87173110c891378e993816cdead7639cd821e754715dfae994853c3e269bca42b37226db39d7473c8a1ae55a30f9a7dc0c7cadb63c32bb89d4828dabe43c93092d77243a660c8ec91f2c1fad4b1fc151f102a4cb9d7e3e670ead7816ef52a1b349dc6ba971d83d8fb0cfb4df7e614d0206a78eb680246c49842f8955e47f0bff46a48ff97bfd8f14c93699d55c7591802854e24230cce9ac77c9ad9b7f0a91ac65cf7b1d68eb29a30e4babc46d8f8e4a0968c62c09f501521d3b33a178245d08e64f7fa1b1abee9176e752cbbe3e26234713448bf44f68219d0a5511d55a0095edb836cd69bc4a3c35b593bc866009b5035faf82ea940297b9e6d77bb09c7ad2dbd4911a821f608b1de841933215052fad879ad0e06ac31ae7e906d727cd08590c4d2a2a114aa5b0da9b41632ebc9facb5a14915243e5e616f8b40979a18bae956cded5d852c1af9ad36709800bce025e2a45cc3dfd2f381e29024a112738de7057a56ecf1fb8c7e0801b37d5cbbdc5ec29b2d24d20e96085eab3e4de519f3592b96f9aa7fae15"
+)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/bloxapp/ssv-dkg/pkgs/crypto"
 	"github.com/bloxapp/ssv-dkg/pkgs/wire"
 	"github.com/bloxapp/ssv-dkg/spec"
 	"github.com/bloxapp/ssv-dkg/spec/testing/fixtures"
@@ -16,6 +17,8 @@ func TestValidateInitMessage(t *testing.T) {
 			Operators:             fixtures.GenerateOperators(4),
 			T:                     3,
 			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
 			Fork:                  fixtures.TestFork,
 			Owner:                 fixtures.TestOwnerAddress,
 			Nonce:                 0,
@@ -32,6 +35,8 @@ func TestValidateInitMessage(t *testing.T) {
 			},
 			T:                     3,
 			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
 			Fork:                  fixtures.TestFork,
 			Owner:                 fixtures.TestOwnerAddress,
 			Nonce:                 0,
@@ -47,6 +52,8 @@ func TestValidateInitMessage(t *testing.T) {
 			},
 			T:                     3,
 			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
 			Fork:                  fixtures.TestFork,
 			Owner:                 fixtures.TestOwnerAddress,
 			Nonce:                 0,
@@ -57,6 +64,8 @@ func TestValidateInitMessage(t *testing.T) {
 			Operators:             []*wire.Operator{},
 			T:                     3,
 			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
 			Fork:                  fixtures.TestFork,
 			Owner:                 fixtures.TestOwnerAddress,
 			Nonce:                 0,
@@ -67,6 +76,8 @@ func TestValidateInitMessage(t *testing.T) {
 			Operators:             nil,
 			T:                     3,
 			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
 			Fork:                  fixtures.TestFork,
 			Owner:                 fixtures.TestOwnerAddress,
 			Nonce:                 0,
@@ -81,6 +92,8 @@ func TestValidateInitMessage(t *testing.T) {
 			},
 			T:                     3,
 			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
 			Fork:                  fixtures.TestFork,
 			Owner:                 fixtures.TestOwnerAddress,
 			Nonce:                 0,
@@ -97,6 +110,8 @@ func TestValidateInitMessage(t *testing.T) {
 			},
 			T:                     3,
 			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
 			Fork:                  fixtures.TestFork,
 			Owner:                 fixtures.TestOwnerAddress,
 			Nonce:                 0,
@@ -107,9 +122,147 @@ func TestValidateInitMessage(t *testing.T) {
 			Operators:             fixtures.GenerateOperators(4),
 			T:                     2,
 			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
 			Fork:                  fixtures.TestFork,
 			Owner:                 fixtures.TestOwnerAddress,
 			Nonce:                 0,
 		}), "threshold set is invalid")
 	})
 }
+
+// customOperators returns n ordered operators, public keys are reused from 13 fixture operators
+func customOperators(n int) []*wire.Operator {
+	fixtureOps := fixtures.GenerateOperators(13)
+	ops := make([]*wire.Operator, n)
+	for i := range ops {
+		ops[i] = &wire.Operator{
+			ID:     uint64(i + 1),
+			PubKey: fixtureOps[i%len(fixtureOps)].PubKey,
+		}
+	}
+	return ops
+}
+
+func TestValidateInitMessageWithPolicy(t *testing.T) {
+	initMsg := func(n int, threshold uint64) *wire.Init {
+		return &wire.Init{
+			Operators:             customOperators(n),
+			T:                     threshold,
+			WithdrawalCredentials: fixtures.TestWithdrawalCred,
+			WithdrawalPrefix:      crypto.ETH1WithdrawalPrefixByte,
+			Amount:                uint64(fixtures.TestAmount),
+			Fork:                  fixtures.TestFork,
+			Owner:                 fixtures.TestOwnerAddress,
+			Nonce:                 0,
+		}
+	}
+	tests := []struct {
+		name      string
+		operators int
+		threshold uint64
+		errMsg    string
+	}{
+		{name: "2 operators", operators: 2, threshold: 2},
+		{name: "3 operators", operators: 3, threshold: 2},
+		{name: "5 operators", operators: 5, threshold: 3},
+		{name: "5 operators, all to sign", operators: 5, threshold: 5},
+		{name: "6 operators", operators: 6, threshold: 4},
+		{name: "13 operators, custom threshold", operators: 13, threshold: 11},
+		{name: "16 operators", operators: 16, threshold: 11},
+		{name: "25 operators", operators: 25, threshold: 17},
+		{name: "64 operators", operators: 64, threshold: 33},
+		{name: "1 operator", operators: 1, threshold: 1, errMsg: "threshold set is invalid"},
+		{name: "65 operators", operators: 65, threshold: 44, errMsg: "threshold set is invalid"},
+		{name: "threshold of half operators", operators: 6, threshold: 3, errMsg: "threshold set is invalid"},
+		{name: "threshold below half operators", operators: 16, threshold: 5, errMsg: "threshold set is invalid"},
+		{name: "threshold above operators", operators: 5, threshold: 6, errMsg: "threshold set is invalid"},
+		{name: "zero threshold", operators: 5, threshold: 0, errMsg: "threshold set is invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := spec.ValidateInitMessageWithPolicy(initMsg(tt.operators, tt.threshold), spec.CustomThresholdPolicy)
+			if tt.errMsg != "" {
+				require.EqualError(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+	t.Run("custom sizes rejected by ssv policy", func(t *testing.T) {
+		require.EqualError(t, spec.ValidateInitMessageWithPolicy(initMsg(5, 3), spec.SSVThresholdPolicy), "threshold set is invalid")
+		require.EqualError(t, spec.ValidateInitMessageWithPolicy(initMsg(16, 11), spec.SSVThresholdPolicy), "threshold set is invalid")
+	})
+	t.Run("ssv sizes accepted by custom policy", func(t *testing.T) {
+		for _, n := range []int{4, 7, 10, 13} {
+			require.NoError(t, spec.ValidateInitMessageWithPolicy(initMsg(n, uint64(n-(n-1)/3)), spec.CustomThresholdPolicy))
+		}
+	})
+	t.Run("disordered operators", func(t *testing.T) {
+		init := initMsg(16, 11)
+		init.Operators[0], init.Operators[1] = init.Operators[1], init.Operators[0]
+		require.EqualError(t, spec.ValidateInitMessageWithPolicy(init, spec.CustomThresholdPolicy), "operators not unique or not ordered")
+	})
+	t.Run("parse policy", func(t *testing.T) {
+		policy, err := spec.ParseThresholdPolicy("")
+		require.NoError(t, err)
+		require.Equal(t, spec.SSVThresholdPolicy, policy)
+		policy, err = spec.ParseThresholdPolicy("custom")
+		require.NoError(t, err)
+		require.Equal(t, spec.CustomThresholdPolicy, policy)
+		_, err = spec.ParseThresholdPolicy("any")
+		require.EqualError(t, err, "unknown threshold policy any")
+	})
+}
+
+func TestValidateInitDepositParams(t *testing.T) {
+	blsCreds := crypto.BLSWithdrawalCredentials(fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize())
+	initMsg := func(prefix byte, amount uint64, withdraw []byte) *wire.Init {
+		if withdraw == nil {
+			withdraw = fixtures.TestWithdrawalCred
+		}
+		return &wire.Init{
+			Operators:             fixtures.GenerateOperators(4),
+			T:                     3,
+			WithdrawalCredentials: withdraw,
+			WithdrawalPrefix:      prefix,
+			Amount:                amount,
+			Fork:                  fixtures.TestFork,
+			Owner:                 fixtures.TestOwnerAddress,
+			Nonce:                 0,
+		}
+	}
+	tests := []struct {
+		name     string
+		prefix   byte
+		amount   uint64
+		withdraw []byte
+		errMsg   string
+	}{
+		{name: "0x01 32 ETH", prefix: crypto.ETH1WithdrawalPrefixByte, amount: 32000000000},
+		{name: "0x01 1 ETH", prefix: crypto.ETH1WithdrawalPrefixByte, amount: 1000000000},
+		{name: "0x02 32 ETH", prefix: crypto.CompoundingWithdrawalPrefixByte, amount: 32000000000},
+		{name: "0x02 100 ETH", prefix: crypto.CompoundingWithdrawalPrefixByte, amount: 100000000000},
+		{name: "0x02 2048 ETH", prefix: crypto.CompoundingWithdrawalPrefixByte, amount: 2048000000000},
+		{name: "0x00 32 ETH", prefix: crypto.BLSWithdrawalPrefixByte, amount: 32000000000, withdraw: blsCreds},
+		{name: "0x00 above 32 ETH", prefix: crypto.BLSWithdrawalPrefixByte, amount: 33000000000, withdraw: blsCreds, errMsg: "deposit amount is invalid: deposit amount 33000000000 is out of range [1000000000, 32000000000] for withdrawal prefix 0x0"},
+		{name: "0x00 withdrawal address", prefix: crypto.BLSWithdrawalPrefixByte, amount: 32000000000, errMsg: "BLS withdrawal credentials are invalid"},
+		{name: "0x01 32 bytes credentials", prefix: crypto.ETH1WithdrawalPrefixByte, amount: 32000000000, withdraw: blsCreds},
+		{name: "unknown credentials", prefix: 3, amount: 32000000000, errMsg: "withdrawal credentials type 0x3 is invalid"},
+		{name: "0x00 zero amount", prefix: crypto.BLSWithdrawalPrefixByte, amount: 0, withdraw: blsCreds, errMsg: "deposit amount is invalid: deposit amount 0 is out of range [1000000000, 32000000000] for withdrawal prefix 0x0"},
+		{name: "0x01 zero amount", prefix: crypto.ETH1WithdrawalPrefixByte, amount: 0, errMsg: "deposit amount is invalid: deposit amount 0 is out of range [1000000000, 32000000000] for withdrawal prefix 0x1"},
+		{name: "0x02 zero amount", prefix: crypto.CompoundingWithdrawalPrefixByte, amount: 0, errMsg: "deposit amount is invalid: deposit amount 0 is out of range [1000000000, 2048000000000] for withdrawal prefix 0x2"},
+		{name: "0x01 above 32 ETH", prefix: crypto.ETH1WithdrawalPrefixByte, amount: 33000000000, errMsg: "deposit amount is invalid: deposit amount 33000000000 is out of range [1000000000, 32000000000] for withdrawal prefix 0x1"},
+		{name: "0x02 above 2048 ETH", prefix: crypto.CompoundingWithdrawalPrefixByte, amount: 2049000000000, errMsg: "deposit amount is invalid: deposit amount 2049000000000 is out of range [1000000000, 2048000000000] for withdrawal prefix 0x2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := spec.ValidateInitMessage(initMsg(tt.prefix, tt.amount, tt.withdraw))
+			if tt.errMsg != "" {
+				require.EqualError(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
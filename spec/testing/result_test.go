@@ -15,7 +15,8 @@ func TestValidateResults(t *testing.T) {
 	t.Run("valid 4 operators", func(t *testing.T) {
 		_, _, _, err := spec.ValidateResults(
 			fixtures.GenerateOperators(4),
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestOwnerAddress,
@@ -29,7 +30,8 @@ func TestValidateResults(t *testing.T) {
 	t.Run("valid 7 operators", func(t *testing.T) {
 		_, _, _, err := spec.ValidateResults(
 			fixtures.GenerateOperators(7),
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestOwnerAddress,
@@ -43,7 +45,8 @@ func TestValidateResults(t *testing.T) {
 	t.Run("valid 10 operators", func(t *testing.T) {
 		_, _, _, err := spec.ValidateResults(
 			fixtures.GenerateOperators(10),
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator10Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestOwnerAddress,
@@ -57,7 +60,8 @@ func TestValidateResults(t *testing.T) {
 	t.Run("valid 13 operators", func(t *testing.T) {
 		_, _, _, err := spec.ValidateResults(
 			fixtures.GenerateOperators(13),
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator13Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestOwnerAddress,
@@ -84,7 +88,8 @@ func TestValidateResults(t *testing.T) {
 		})
 		_, _, _, err := spec.ValidateResults(
 			fixtures.GenerateOperators(4),
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestOwnerAddress,
@@ -99,7 +104,8 @@ func TestValidateResults(t *testing.T) {
 		res := fixtures.Results7Operators()
 		_, _, _, err := spec.ValidateResults(
 			fixtures.GenerateOperators(4),
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestOwnerAddress,
@@ -121,7 +127,8 @@ func TestValidateResults(t *testing.T) {
 		})
 		_, _, _, err := spec.ValidateResults(
 			fixtures.GenerateOperators(4),
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestOwnerAddress,
@@ -133,13 +140,64 @@ func TestValidateResults(t *testing.T) {
 	})
 }
 
+func TestValidateCommits(t *testing.T) {
+	validatorPK := fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()
+	t.Run("valid 4 operators", func(t *testing.T) {
+		threshold, err := spec.ValidateCommits(validatorPK, fixtures.Results4Operators())
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), threshold)
+	})
+
+	t.Run("operators disagree on commits", func(t *testing.T) {
+		res := fixtures.Results4Operators()
+		res[2].Commits = fixtures.DecodeHexNoError(fixtures.TestCommits7Operators)
+		_, err := spec.ValidateCommits(validatorPK, res)
+		require.EqualError(t, err, "commits of operator 3 differ from commits of operator 1")
+	})
+
+	t.Run("no operator sent commits", func(t *testing.T) {
+		res := fixtures.Results4Operators()
+		for _, r := range res {
+			r.Commits = nil
+		}
+		threshold, err := spec.ValidateCommits(validatorPK, res)
+		require.NoError(t, err)
+		require.Zero(t, threshold)
+	})
+
+	t.Run("missing commits", func(t *testing.T) {
+		res := fixtures.Results4Operators()
+		res[1].Commits = nil
+		res[3].Commits = nil
+		_, err := spec.ValidateCommits(validatorPK, res)
+		require.EqualError(t, err, "operators [2 4] didnt send commits")
+	})
+
+	t.Run("commits of another validator", func(t *testing.T) {
+		res := fixtures.Results4Operators()
+		for _, r := range res {
+			r.Commits = fixtures.DecodeHexNoError(fixtures.TestCommits7Operators)
+		}
+		_, err := spec.ValidateCommits(validatorPK, res)
+		require.EqualError(t, err, "validator public key doesnt match commits")
+	})
+
+	t.Run("share pub key not on polynomial", func(t *testing.T) {
+		res := fixtures.Results4Operators()
+		res[0].SignedProof, res[1].SignedProof = res[1].SignedProof, res[0].SignedProof
+		_, err := spec.ValidateCommits(validatorPK, res)
+		require.EqualError(t, err, "share public key of operator 1 doesnt match public polynomial")
+	})
+}
+
 func TestValidateResult(t *testing.T) {
 	t.Run("valid 4 operators", func(t *testing.T) {
 		require.NoError(t, spec.ValidateResult(
 			fixtures.GenerateOperators(4),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -158,7 +216,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(7),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -177,7 +236,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(10),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator10Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -196,7 +256,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(13),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator13Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -215,7 +276,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(4),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -234,7 +296,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(4),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -253,7 +316,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(4),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -272,7 +336,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(4),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -291,7 +356,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(4),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -316,7 +382,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(4),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,
@@ -342,7 +409,8 @@ func TestValidateResult(t *testing.T) {
 			fixtures.GenerateOperators(4),
 			fixtures.TestOwnerAddress,
 			fixtures.TestRequestID,
-			fixtures.TestWithdrawalCred,
+			fixtures.TestWithdrawalCredentials,
+			fixtures.TestAmount,
 			fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
 			fixtures.TestNonce,